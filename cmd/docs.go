@@ -0,0 +1,35 @@
+/*
+Copyright © 2023 Nick Godzieba <nick.godzieba@outlook.de>
+*/
+package cmd
+
+import (
+	"github.com/niiigoo/hawk/kit"
+
+	"github.com/spf13/cobra"
+)
+
+var docsOut string
+
+// docsCmd represents the docs command
+var docsCmd = &cobra.Command{
+	Use:   "docs [proto file]",
+	Short: "Generate a Markdown reference of the service",
+	Long: `Generate a Markdown reference (API.md) of the service.
+
+It lists every rpc with its HTTP bindings and WebSocket availability, the messages and the enums.
+The comments of the .proto file are used as descriptions.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		g := kit.NewGenerator()
+		err := g.Docs(docsOut, args...)
+		printError(err)
+		return err
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(docsCmd)
+
+	docsCmd.Flags().StringVarP(&docsOut, "out", "o", "API.md", "output file")
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var generateOptions kit.ServiceOptions
+
 // generateCmd represents the generate command
 var generateCmd = &cobra.Command{
 	Use:     "generate",
@@ -21,8 +23,10 @@ Cobra is a CLI library for Go that empowers applications.
 This application is a tool to generate the needed files
 to quickly create a Cobra application.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		// the arguments are valid, errors of the generation are not caused by the usage
+		cmd.SilenceUsage = true
 		g := kit.NewGenerator()
-		err := g.Service(args...)
+		err := g.Service(generateOptions, args...)
 		printError(err)
 		return err
 	},
@@ -31,6 +35,12 @@ to quickly create a Cobra application.`,
 func init() {
 	rootCmd.AddCommand(generateCmd)
 
+	generateCmd.Flags().BoolVar(&generateOptions.CLI, "cli", false, "Generate the command line client cmd/<name>-cli")
+	generateCmd.Flags().BoolVar(&generateOptions.Force, "force", false, "Overwrite and remove generated files even if they have been edited by hand")
+	generateCmd.Flags().BoolVar(&generateOptions.DryRun, "dry-run", false, "Print the files which would be created or updated without writing them")
+	generateCmd.Flags().BoolVar(&generateOptions.Diff, "diff", false, "Print the unified diff of the changes without writing them")
+	generateCmd.Flags().BoolVar(&generateOptions.Check, "check", false, "Fail if the generated code is stale, nothing is written")
+
 	// Here you will define your flags and configuration settings.
 
 	// Cobra supports Persistent Flags which will work for this command
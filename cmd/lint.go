@@ -0,0 +1,38 @@
+/*
+Copyright © 2023 Nick Godzieba <nick.godzieba@outlook.de>
+*/
+package cmd
+
+import (
+	"github.com/niiigoo/hawk/kit"
+
+	"github.com/spf13/cobra"
+)
+
+var lintFormat string
+
+// lintCmd represents the lint command
+var lintCmd = &cobra.Command{
+	Use:   "lint [proto file]",
+	Short: "Check the proto file against the rules of hawk",
+	Long: `Check the proto file and its imports without generating any file or running protoc.
+
+All issues are reported with their position (file:line:col), e.g. unknown path parameters, HTTP bindings of
+streaming methods, repeated or non-scalar parameters outside the body, duplicate routes, unknown keys of the
+option (config), deprecated options and fields reusing reserved or assigned tags. With --format json the issues are printed as JSON
+array for editor integration. The command fails if an issue is an error, warnings are printed only.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		g := kit.NewGenerator()
+		err := g.Lint(lintFormat, args...)
+		printError(err)
+		return err
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(lintCmd)
+
+	lintCmd.Flags().StringVarP(&lintFormat, "format", "f", kit.LintText, "Output format: text or json")
+}
@@ -0,0 +1,45 @@
+/*
+Copyright © 2023 Nick Godzieba <nick.godzieba@outlook.de>
+*/
+package cmd
+
+import (
+	"github.com/niiigoo/hawk/kit"
+	"github.com/niiigoo/hawk/kit/openapi"
+
+	"github.com/spf13/cobra"
+)
+
+var openapiFlags struct {
+	out     string
+	title   string
+	version string
+}
+
+// openapiCmd represents the openapi command
+var openapiCmd = &cobra.Command{
+	Use:   "openapi [proto file]",
+	Short: "Generate the OpenAPI specification of the HTTP transport",
+	Long: `Generate an OpenAPI 3 specification (openapi.yaml) describing all methods having an HTTP binding.
+
+The paths include the HttpPrefix of the service, additional bindings are added as separate operations.
+Errors are described by the body written by the package pkg/exception.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		g := kit.NewGenerator()
+		err := g.OpenAPI(openapiFlags.out, openapi.Info{
+			Title:   openapiFlags.title,
+			Version: openapiFlags.version,
+		}, args...)
+		printError(err)
+		return err
+	},
+}
+
+func init() {
+	generateCmd.AddCommand(openapiCmd)
+
+	openapiCmd.Flags().StringVarP(&openapiFlags.out, "out", "o", "openapi.yaml", "output file")
+	openapiCmd.Flags().StringVar(&openapiFlags.title, "title", "", "title of the API (default: name of the first service)")
+	openapiCmd.Flags().StringVar(&openapiFlags.version, "api-version", "1.0.0", "version of the API")
+}
@@ -0,0 +1,36 @@
+/*
+Copyright © 2023 Nick Godzieba <nick.godzieba@outlook.de>
+*/
+package cmd
+
+import (
+	"github.com/niiigoo/hawk/kit"
+
+	"github.com/spf13/cobra"
+)
+
+var tsOut string
+
+// tsCmd represents the ts command
+var tsCmd = &cobra.Command{
+	Use:   "ts [proto file]",
+	Short: "Generate a TypeScript client of the HTTP and WebSocket transports",
+	Long: `Generate a TypeScript client (client.ts) of the service.
+
+Messages and enums become interfaces and string unions named like the JSON of the server (proto names).
+Every service gets an HTTP client for its bindings and, if available via WebSocket, a WebSocket client
+correlating the responses to the requests.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		g := kit.NewGenerator()
+		err := g.TypeScript(tsOut, args...)
+		printError(err)
+		return err
+	},
+}
+
+func init() {
+	generateCmd.AddCommand(tsCmd)
+
+	tsCmd.Flags().StringVarP(&tsOut, "out", "o", "client.ts", "output file")
+}
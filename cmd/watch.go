@@ -0,0 +1,48 @@
+/*
+Copyright © 2023 Nick Godzieba <nick.godzieba@outlook.de>
+*/
+package cmd
+
+import (
+	"github.com/niiigoo/hawk/kit"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var watchOptions kit.WatchOptions
+
+// watchCmd represents the watch command
+var watchCmd = &cobra.Command{
+	Use:   "watch [proto file] [-- service args]",
+	Short: "Generate the service whenever the proto file changes",
+	Long: `Watch the proto file, its imports and protoc.yaml and generate the service after every change.
+
+Errors are printed with their position in the proto file, the watch continues until it is interrupted.
+With --run the service (cmd/<name>) is built and restarted after every generation, the arguments
+following "--" are passed to it.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cmd.SilenceUsage = true
+		if dash := cmd.ArgsLenAtDash(); dash >= 0 {
+			watchOptions.Args = args[dash:]
+			args = args[:dash]
+		}
+		if len(args) > 1 {
+			return cobra.MaximumNArgs(1)(cmd, args)
+		}
+
+		g := kit.NewGenerator()
+		err := g.Watch(watchOptions, args...)
+		printError(err)
+		return err
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(watchCmd)
+
+	watchCmd.Flags().BoolVar(&watchOptions.Run, "run", false, "Build and restart the service after every generation")
+	watchCmd.Flags().DurationVar(&watchOptions.Debounce, "debounce", 300*time.Millisecond, "Time waited for further changes before generating")
+	watchCmd.Flags().BoolVar(&watchOptions.CLI, "cli", false, "Generate the command line client cmd/<name>-cli")
+	watchCmd.Flags().BoolVar(&watchOptions.Force, "force", false, "Overwrite and remove generated files even if they have been edited by hand")
+}
@@ -5,6 +5,7 @@ go 1.21.5
 require (
 	github.com/alecthomas/participle/v2 v2.1.1
 	github.com/davecgh/go-spew v1.1.1
+	github.com/fsnotify/fsnotify v1.9.0
 	github.com/iancoleman/strcase v0.3.0
 	github.com/pkg/errors v0.9.1
 	github.com/pmezard/go-difflib v1.0.0
@@ -0,0 +1,95 @@
+// Package diff compares generated files with the files on disk, it is used to
+// preview the changes of a generation without writing them.
+package diff
+
+import (
+	"bytes"
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type Status string
+
+const (
+	Created  Status = "create"
+	Modified Status = "update"
+	Deleted  Status = "delete"
+)
+
+// Change is a generated file differing from the file on disk
+type Change struct {
+	Path   string
+	Status Status
+	Old    []byte
+	New    []byte
+}
+
+// Compare reads the generated files and returns the ones differing from the files in dir, sorted by path
+func Compare(dir string, files map[string]io.Reader) ([]*Change, error) {
+	changes := make([]*Change, 0)
+	for name, r := range files {
+		content, err := io.ReadAll(r)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot read generated file '%s'", name)
+		}
+
+		change := &Change{Path: filepath.ToSlash(filepath.Clean(name)), Status: Modified, New: content}
+		change.Old, err = os.ReadFile(filepath.Join(dir, name))
+		if os.IsNotExist(err) {
+			change.Status = Created
+		} else if err != nil {
+			return nil, errors.Wrapf(err, "cannot read file '%s'", name)
+		} else if bytes.Equal(change.Old, content) {
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+// Remove returns the change deleting the file of dir, nil if the file does not exist
+func Remove(dir, name string) (*Change, error) {
+	content, err := os.ReadFile(filepath.Join(dir, name))
+	if os.IsNotExist(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "cannot read file '%s'", name)
+	}
+	return &Change{Path: filepath.ToSlash(filepath.Clean(name)), Status: Deleted, Old: content}, nil
+}
+
+// Unified returns the unified diff of the change, created and deleted files are compared with /dev/null
+func (c *Change) Unified() (string, error) {
+	from, to := "a/"+c.Path, "b/"+c.Path
+	if c.Status == Created {
+		from = "/dev/null"
+	} else if c.Status == Deleted {
+		to = "/dev/null"
+	}
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        lines(c.Old),
+		B:        lines(c.New),
+		FromFile: from,
+		ToFile:   to,
+		Context:  3,
+	})
+}
+
+// lines splits the content into lines keeping the line breaks, a missing line break at the end is added
+func lines(content []byte) []string {
+	l := strings.SplitAfter(string(content), "\n")
+	if l[len(l)-1] == "" {
+		return l[:len(l)-1]
+	}
+	l[len(l)-1] += "\n"
+	return l
+}
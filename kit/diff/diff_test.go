@@ -0,0 +1,83 @@
+package diff
+
+import (
+	"github.com/stretchr/testify/suite"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type DiffTestSuite struct {
+	suite.Suite
+	dir string
+}
+
+func TestDiffTestSuite(t *testing.T) {
+	suite.Run(t, new(DiffTestSuite))
+}
+
+func (s *DiffTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+	s.Require().NoError(os.MkdirAll(filepath.Join(s.dir, "svc"), 0755))
+	s.Require().NoError(os.WriteFile(filepath.Join(s.dir, "svc", "endpoints.go"), []byte("package svc\n\nfunc a() {}\n"), 0644))
+	s.Require().NoError(os.WriteFile(filepath.Join(s.dir, "main.go"), []byte("package main\n"), 0644))
+}
+
+func (s *DiffTestSuite) TestCompare() {
+	changes, err := Compare(s.dir, map[string]io.Reader{
+		"svc/endpoints.go":    strings.NewReader("package svc\n\nfunc b() {}\n"),
+		"main.go":             strings.NewReader("package main\n"),
+		"handlers/handler.go": strings.NewReader("package handlers\n"),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(changes, 2)
+
+	s.Equal("handlers/handler.go", changes[0].Path)
+	s.Equal(Created, changes[0].Status)
+	s.Nil(changes[0].Old)
+
+	s.Equal("svc/endpoints.go", changes[1].Path)
+	s.Equal(Modified, changes[1].Status)
+	s.Equal("package svc\n\nfunc a() {}\n", string(changes[1].Old))
+}
+
+func (s *DiffTestSuite) TestUnified() {
+	changes, err := Compare(s.dir, map[string]io.Reader{
+		"svc/endpoints.go": strings.NewReader("package svc\n\nfunc b() {}\n"),
+		"new.go":           strings.NewReader("package main"),
+	})
+	s.Require().NoError(err)
+	s.Require().Len(changes, 2)
+
+	d, err := changes[0].Unified()
+	s.Require().NoError(err)
+	s.Equal("--- /dev/null\n+++ b/new.go\n@@ -0,0 +1 @@\n+package main\n", d)
+
+	d, err = changes[1].Unified()
+	s.Require().NoError(err)
+	s.Equal("--- a/svc/endpoints.go\n+++ b/svc/endpoints.go\n@@ -1,3 +1,3 @@\n package svc\n \n-func a() {}\n+func b() {}\n", d)
+}
+
+func (s *DiffTestSuite) TestUnchanged() {
+	changes, err := Compare(s.dir, map[string]io.Reader{
+		"./main.go": strings.NewReader("package main\n"),
+	})
+	s.Require().NoError(err)
+	s.Empty(changes)
+}
+
+func (s *DiffTestSuite) TestRemove() {
+	c, err := Remove(s.dir, "main.go")
+	s.Require().NoError(err)
+	s.Equal(Deleted, c.Status)
+
+	d, err := c.Unified()
+	s.Require().NoError(err)
+	s.Equal("--- a/main.go\n+++ /dev/null\n@@ -1 +0,0 @@\n-package main\n", d)
+
+	c, err = Remove(s.dir, "missing.go")
+	s.Require().NoError(err)
+	s.Nil(c)
+}
@@ -0,0 +1,296 @@
+// Package docs renders a Markdown reference of the services defined in a
+// proto definition, including the comments written in the .proto file.
+package docs
+
+import (
+	"bytes"
+	"github.com/niiigoo/hawk/proto"
+	pio "github.com/niiigoo/hawk/proto/io"
+	"github.com/pkg/errors"
+	"io"
+	"strings"
+	"text/template"
+)
+
+// Document is passed to the Markdown template
+type Document struct {
+	Package  string
+	Services []*Service
+	Messages []*Message
+	Enums    []*Enum
+}
+
+type Service struct {
+	*proto.Service
+	Description string
+	Methods     []*Method
+	Events      []*Event
+}
+
+type Method struct {
+	*proto.Method
+	Description string
+	Request     string
+	Response    string
+	Bindings    []*Binding
+	WebSocket   bool
+}
+
+type Event struct {
+	Name        string
+	Description string
+	Payload     string
+}
+
+type Binding struct {
+	Method       string
+	Path         string
+	Body         string
+	ResponseBody string
+}
+
+type Message struct {
+	Name        string
+	Description string
+	Fields      []*Field
+}
+
+type Field struct {
+	Name        string
+	Type        string
+	Label       string
+	Description string
+}
+
+type Enum struct {
+	Name        string
+	Description string
+	Values      []*EnumValue
+}
+
+type EnumValue struct {
+	Name        string
+	Number      int
+	Description string
+}
+
+// TemplateFuncs are the helper functions used in the Markdown template
+var TemplateFuncs = template.FuncMap{
+	"Anchor": Anchor,
+	"Cell":   cell,
+}
+
+// builder resolves the type names to the messages and enums of the document
+type builder struct {
+	def   *proto.Definition
+	types map[string]bool
+}
+
+// NewDocument collects the services, messages (including nested ones) and enums of the definition
+func NewDocument(def *proto.Definition) *Document {
+	b := builder{
+		def:   def,
+		types: make(map[string]bool),
+	}
+	doc := &Document{
+		Package:  def.Package(),
+		Services: make([]*Service, 0),
+		Messages: make([]*Message, 0),
+		Enums:    make([]*Enum, 0),
+	}
+
+	for _, msg := range def.Messages() {
+		b.collectTypes("", msg)
+	}
+	for _, enum := range def.Enums() {
+		b.types[enum.Name] = true
+	}
+
+	for _, msg := range def.Messages() {
+		b.message(doc, "", msg)
+	}
+	for _, enum := range def.Enums() {
+		doc.Enums = append(doc.Enums, b.enum("", enum))
+	}
+	for _, svc := range def.Services {
+		doc.Services = append(doc.Services, b.service(svc))
+	}
+
+	return doc
+}
+
+// Render executes the Markdown template
+func (d *Document) Render() (io.Reader, error) {
+	t, err := template.New("docs").Funcs(TemplateFuncs).Parse(markdownTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse template")
+	}
+	buf := bytes.NewBuffer(nil)
+	err = t.Execute(buf, d)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute template")
+	}
+	return buf, nil
+}
+
+func (b builder) collectTypes(scope string, msg *pio.Message) {
+	name := scope + msg.Name
+	b.types[name] = true
+	for _, entry := range msg.Entries {
+		if entry.Message != nil {
+			b.collectTypes(name+".", entry.Message)
+		} else if entry.Enum != nil {
+			b.types[name+"."+entry.Enum.Name] = true
+		}
+	}
+}
+
+func (b builder) service(svc *proto.Service) *Service {
+	s := &Service{
+		Service:     svc,
+		Description: svc.Comments.String(),
+		Methods:     make([]*Method, 0, len(svc.Methods)),
+	}
+	for _, m := range svc.Methods {
+		method := &Method{
+			Method:      m,
+			Description: m.Comments.String(),
+			Request:     b.typeName("", m.Request),
+			Response:    b.typeName("", m.Response),
+			Bindings:    make([]*Binding, 0, len(m.HttpBindings)),
+			WebSocket:   m.WebSocket && svc.WSPath != "",
+		}
+		for _, binding := range m.HttpBindings {
+			method.Bindings = append(method.Bindings, &Binding{
+				Method:       strings.ToUpper(binding.Method),
+				Path:         binding.Template(),
+				Body:         binding.Body,
+				ResponseBody: binding.ResponseBody,
+			})
+		}
+		s.Methods = append(s.Methods, method)
+	}
+	for _, e := range svc.Events {
+		s.Events = append(s.Events, &Event{
+			Name:        e.Name,
+			Description: e.Comments.String(),
+			Payload:     b.typeName("", e.Request),
+		})
+	}
+	return s
+}
+
+func (b builder) message(doc *Document, scope string, msg *pio.Message) {
+	name := scope + msg.Name
+	m := &Message{
+		Name:        name,
+		Description: msg.Comments.String(),
+		Fields:      make([]*Field, 0),
+	}
+	doc.Messages = append(doc.Messages, m)
+
+	for _, entry := range msg.Entries {
+		if entry.Field != nil {
+			m.Fields = append(m.Fields, b.field(name, entry.Field, ""))
+		} else if entry.OneOf != nil {
+			for _, e := range entry.OneOf.Entries {
+				if e.Field != nil {
+					m.Fields = append(m.Fields, b.field(name, e.Field, entry.OneOf.Name))
+				}
+			}
+		} else if entry.Message != nil {
+			b.message(doc, name+".", entry.Message)
+		} else if entry.Enum != nil {
+			doc.Enums = append(doc.Enums, b.enum(name+".", entry.Enum))
+		}
+	}
+}
+
+func (b builder) field(scope string, field *pio.Field, oneOf string) *Field {
+	f := &Field{
+		Name:        field.Name,
+		Type:        b.fieldType(scope, &field.Type),
+		Description: field.Comments.String(),
+	}
+	if field.Repeated {
+		f.Label = "repeated"
+	} else if field.Optional {
+		f.Label = "optional"
+	} else if field.Required {
+		f.Label = "required"
+	} else if oneOf != "" {
+		f.Label = "oneof " + oneOf
+	}
+	return f
+}
+
+func (b builder) enum(scope string, enum *pio.Enum) *Enum {
+	e := &Enum{
+		Name:        scope + enum.Name,
+		Description: enum.Comments.String(),
+		Values:      make([]*EnumValue, 0, len(enum.Values)),
+	}
+	for _, v := range enum.Values {
+		if v.Value == nil {
+			continue
+		}
+		e.Values = append(e.Values, &EnumValue{
+			Name:        v.Value.Key,
+			Number:      v.Value.Value,
+			Description: v.Value.Comments.String(),
+		})
+	}
+	return e
+}
+
+// fieldType returns the Markdown representation of the type, messages and enums are linked
+func (b builder) fieldType(scope string, t *pio.Type) string {
+	if t.Scalar > pio.None {
+		return "`" + t.Scalar.GoString() + "`"
+	}
+	if t.Map != nil {
+		return "map<" + b.fieldType(scope, t.Map.Key) + ", " + b.fieldType(scope, t.Map.Value) + ">"
+	}
+	return b.typeName(scope, t.Reference)
+}
+
+// typeName links the referenced type, the scope of the message is searched first
+func (b builder) typeName(scope, name string) string {
+	for s := scope; s != ""; s = parentScope(s) {
+		if b.types[s+"."+name] {
+			return "[" + name + "](#" + Anchor(s+"."+name) + ")"
+		}
+	}
+	if b.types[name] {
+		return "[" + name + "](#" + Anchor(name) + ")"
+	}
+	return "`" + name + "`"
+}
+
+func parentScope(scope string) string {
+	pos := strings.LastIndex(scope, ".")
+	if pos < 0 {
+		return ""
+	}
+	return scope[:pos]
+}
+
+// Anchor returns the anchor of a heading as generated by GitHub
+func Anchor(heading string) string {
+	var sb strings.Builder
+	for _, r := range strings.ToLower(heading) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '-', r == '_':
+			sb.WriteRune(r)
+		case r == ' ':
+			sb.WriteRune('-')
+		}
+	}
+	return sb.String()
+}
+
+// cell escapes the text to be used inside a table cell
+func cell(text string) string {
+	text = strings.ReplaceAll(text, "|", `\|`)
+	return strings.ReplaceAll(text, "\n", "<br>")
+}
@@ -0,0 +1,143 @@
+package docs
+
+import (
+	"github.com/niiigoo/hawk/proto"
+	"github.com/stretchr/testify/suite"
+	"io"
+	"testing"
+)
+
+const definition = `
+syntax = "proto3";
+
+package sample;
+
+// Manages the users
+service Sample {
+	option (config) = {
+		HttpPrefix: "/api/sample"
+		WebSocketPath: "/ws"
+	};
+
+	// Returns a single user
+	rpc GetUser(GetUserRequest) returns (User) {
+		option (google.api.http) = {
+			get: "/users/{id}"
+		};
+		option (webSocket) = true;
+	}
+	rpc Watch(GetUserRequest) returns (stream User) {
+		option (webSocket) = true;
+	}
+	// The user has been changed
+	rpc UserUpdated(User) returns (User) {
+		option (hawk.v1.method).web_socket_event = true;
+	}
+}
+
+message GetUserRequest {
+	string id = 1; // Identifier of the user
+}
+
+// A user | the account
+message User {
+	string id = 1;
+	repeated Address addresses = 2;
+	Role role = 3;
+
+	message Address {
+		// Name of the city
+		// in english
+		string city = 1;
+	}
+}
+
+enum Role {
+	ROLE_UNKNOWN = 0;
+	ROLE_ADMIN = 1; // Full access
+}
+`
+
+type DocsTestSuite struct {
+	suite.Suite
+	doc *Document
+}
+
+func TestDocsTestSuite(t *testing.T) {
+	suite.Run(t, new(DocsTestSuite))
+}
+
+func (s *DocsTestSuite) SetupTest() {
+	p := proto.NewService()
+	s.Require().NoError(p.ParseString(definition))
+	s.doc = NewDocument(p.Definition())
+}
+
+func (s *DocsTestSuite) TestServices() {
+	s.Require().Len(s.doc.Services, 1)
+	svc := s.doc.Services[0]
+	s.Equal("Manages the users", svc.Description)
+	s.Require().Len(svc.Methods, 2)
+
+	get := svc.Methods[0]
+	s.Equal("Returns a single user", get.Description)
+	s.Equal("[GetUserRequest](#getuserrequest)", get.Request)
+	s.True(get.WebSocket)
+	s.Require().Len(get.Bindings, 1)
+	s.Equal("GET", get.Bindings[0].Method)
+	s.Equal("/api/sample/users/{id}", get.Bindings[0].Path)
+
+	watch := svc.Methods[1]
+	s.True(watch.WebSocket)
+	s.Empty(watch.Bindings)
+
+	s.Require().Len(svc.Events, 1)
+	s.Equal("UserUpdated", svc.Events[0].Name)
+	s.Equal("[User](#user)", svc.Events[0].Payload)
+}
+
+func (s *DocsTestSuite) TestMessages() {
+	s.Require().Len(s.doc.Messages, 3)
+	s.Equal("GetUserRequest", s.doc.Messages[0].Name)
+	s.Equal("Identifier of the user", s.doc.Messages[0].Fields[0].Description)
+
+	user := s.doc.Messages[1]
+	s.Equal("User", user.Name)
+	s.Equal("[Address](#useraddress)", user.Fields[1].Type)
+	s.Equal("repeated", user.Fields[1].Label)
+	s.Equal("[Role](#role)", user.Fields[2].Type)
+
+	s.Equal("User.Address", s.doc.Messages[2].Name)
+	s.Equal("Name of the city\nin english", s.doc.Messages[2].Fields[0].Description)
+}
+
+func (s *DocsTestSuite) TestEnums() {
+	s.Require().Len(s.doc.Enums, 1)
+	s.Equal("Role", s.doc.Enums[0].Name)
+	s.Require().Len(s.doc.Enums[0].Values, 2)
+	s.Equal(1, s.doc.Enums[0].Values[1].Number)
+	s.Equal("Full access", s.doc.Enums[0].Values[1].Description)
+}
+
+func (s *DocsTestSuite) TestRender() {
+	r, err := s.doc.Render()
+	s.Require().NoError(err)
+	data, err := io.ReadAll(r)
+	s.Require().NoError(err)
+
+	md := string(data)
+	s.Contains(md, "## Sample\n\nManages the users\n")
+	s.Contains(md, "### Sample.GetUser\n\nReturns a single user\n")
+	s.Contains(md, "| GET | `/api/sample/users/{id}` |  |  |")
+	s.Contains(md, "rpc Watch(GetUserRequest) returns (stream User)")
+	s.Contains(md, "WebSocket: available (method `GetUser`)")
+	s.Contains(md, "| UserUpdated | [User](#user) | The user has been changed |")
+	s.Contains(md, "A user | the account")
+	s.Contains(md, "| city | `string` |  | Name of the city<br>in english |")
+	s.Contains(md, "| ROLE_ADMIN | 1 | Full access |")
+}
+
+func (s *DocsTestSuite) TestAnchor() {
+	s.Equal("useraddress", Anchor("User.Address"))
+	s.Equal("api-reference", Anchor("API Reference"))
+}
@@ -0,0 +1,111 @@
+package docs
+
+const markdownTemplate = `# API Reference
+{{- if .Package}}
+
+Package ` + "`{{.Package}}`" + `
+{{- end}}
+
+## Table of contents
+{{range .Services}}
+- [{{.Name}}](#{{Anchor .Name}})
+{{- end}}
+{{- if .Messages}}
+- [Messages](#messages)
+{{- range .Messages}}
+  - [{{.Name}}](#{{Anchor .Name}})
+{{- end}}
+{{- end}}
+{{- if .Enums}}
+- [Enums](#enums)
+{{- range .Enums}}
+  - [{{.Name}}](#{{Anchor .Name}})
+{{- end}}
+{{- end}}
+{{range $svc := .Services}}
+## {{.Name}}
+{{- if .Description}}
+
+{{.Description}}
+{{- end}}
+{{- if or .HttpPrefix .WSPath}}
+{{if .HttpPrefix}}
+HTTP prefix: ` + "`{{.HttpPrefix}}`" + `
+{{- end}}
+{{- if and .HttpPrefix .WSPath}}<br>{{end}}
+{{- if .WSPath}}
+WebSocket: ` + "`{{.WSPath}}`" + `
+{{- end}}
+{{- end}}
+{{range .Methods}}
+### {{$svc.Name}}.{{.Name}}
+{{- if .Description}}
+
+{{.Description}}
+{{- end}}
+
+` + "```proto" + `
+rpc {{.Name}}({{if .RequestStream}}stream {{end}}{{.Method.Request}}) returns ({{if .ResponseStream}}stream {{end}}{{.Method.Response}})
+` + "```" + `
+
+| Request | Response |
+|---------|----------|
+| {{.Request}} | {{.Response}} |
+{{- if .Bindings}}
+
+| HTTP method | Path | Body | Response body |
+|-------------|------|------|---------------|
+{{- range .Bindings}}
+| {{.Method}} | ` + "`{{.Path}}`" + ` | {{if .Body}}` + "`{{.Body}}`" + `{{end}} | {{if .ResponseBody}}` + "`{{.ResponseBody}}`" + `{{end}} |
+{{- end}}
+{{- end}}
+
+WebSocket: {{if .WebSocket}}available (method ` + "`{{.Name}}`" + `){{else}}not available{{end}}
+{{end}}
+{{- if and .WSPath .Events}}
+### {{$svc.Name}} events
+
+Pushed to the WebSocket clients with the command ` + "`event`" + `.
+
+| Event | Payload | Description |
+|-------|---------|-------------|
+{{- range .Events}}
+| {{.Name}} | {{.Payload}} | {{Cell .Description}} |
+{{- end}}
+{{end}}
+{{- end}}
+{{- if .Messages}}
+## Messages
+{{range .Messages}}
+### {{.Name}}
+{{- if .Description}}
+
+{{.Description}}
+{{- end}}
+{{if .Fields}}
+| Field | Type | Label | Description |
+|-------|------|-------|-------------|
+{{- range .Fields}}
+| {{.Name}} | {{.Type}} | {{.Label}} | {{Cell .Description}} |
+{{- end}}
+{{else}}
+This message has no fields.
+{{end}}
+{{- end}}
+{{- end}}
+{{- if .Enums}}
+## Enums
+{{range .Enums}}
+### {{.Name}}
+{{- if .Description}}
+
+{{.Description}}
+{{- end}}
+
+| Name | Number | Description |
+|------|--------|-------------|
+{{- range .Values}}
+| {{.Name}} | {{.Number}} | {{Cell .Description}} |
+{{- end}}
+{{end}}
+{{- end}}`
@@ -7,6 +7,8 @@ import (
 	"github.com/niiigoo/hawk/proto"
 	"github.com/pkg/errors"
 	"io"
+	"slices"
+	"sort"
 	"text/template"
 )
 
@@ -19,6 +21,8 @@ type Config struct {
 	PBPackage   string
 	Version     string
 	VersionDate string
+	// CLI enables the command line client cmd/NAME-cli
+	CLI bool
 
 	PreviousFiles map[string]io.Reader
 }
@@ -28,6 +32,7 @@ type Config struct {
 var FuncMap = template.FuncMap{
 	"ToLower": strcase.ToLowerCamel,
 	"GoName":  strcase.ToCamel,
+	"ToKebab": strcase.ToKebab,
 }
 
 // Data is passed to templates as the executing struct; its fields
@@ -39,10 +44,15 @@ type Data struct {
 	PBImportPath string
 	// PackageName is the name of the package containing the service definition
 	PackageName string
-	// GRPC/Proto service, with all parameters and return values accessible
+	// ProtoPackage is the package declared in the .proto file
+	ProtoPackage string
+	// GRPC/Proto service, with all parameters and return values accessible.
+	// It is the first service of the definition.
 	Service *proto.Service
-	// A helper struct for generating http transport functionality.
+	// A helper struct for generating http transport functionality of Service.
 	HTTPHelper *http.Helper
+	// Services contains all services of the definition
+	Services []*Service
 	// Helper functions used within the templates
 	FuncMap template.FuncMap
 
@@ -50,17 +60,135 @@ type Data struct {
 	VersionDate string
 }
 
+// Service wraps a service of the definition with its HTTP helper
+type Service struct {
+	*proto.Service
+	HTTPHelper *http.Helper
+}
+
+// NewData creates the Data of a single service
 func NewData(svc *proto.Service, conf Config) *Data {
+	helper := http.NewHelper(svc)
 	return &Data{
 		ImportPath:   conf.GoPackage,
 		PBImportPath: conf.PBPackage,
 		PackageName:  conf.PBPackage,
 		Service:      svc,
-		HTTPHelper:   http.NewHelper(svc),
-		FuncMap:      FuncMap,
-		Version:      conf.Version,
-		VersionDate:  conf.VersionDate,
+		HTTPHelper:   helper,
+		Services: []*Service{{
+			Service:    svc,
+			HTTPHelper: helper,
+		}},
+		FuncMap:     FuncMap,
+		Version:     conf.Version,
+		VersionDate: conf.VersionDate,
+	}
+}
+
+// NewDefinitionData creates the Data of all services of the definition
+func NewDefinitionData(def *proto.Definition, conf Config) *Data {
+	data := NewData(def.Services[0], conf)
+	data.ProtoPackage = def.Package()
+	for _, svc := range def.Services[1:] {
+		data.Services = append(data.Services, &Service{
+			Service:    svc,
+			HTTPHelper: http.NewHelper(svc),
+		})
+	}
+	return data
+}
+
+// CompressionEnabled reports whether any service uses HTTP compression
+func (e *Data) CompressionEnabled() bool {
+	for _, svc := range e.Services {
+		if svc.HTTPHelper.CompressionEnabled {
+			return true
+		}
+	}
+	return false
+}
+
+// WellKnownImports returns the Go packages of the well-known types decoded from the path or query of any service
+func (e *Data) WellKnownImports() []string {
+	imports := make([]string, 0)
+	for _, svc := range e.Services {
+		for _, i := range svc.HTTPHelper.WellKnownImports() {
+			if !slices.Contains(imports, i) {
+				imports = append(imports, i)
+			}
+		}
+	}
+	sort.Strings(imports)
+	return imports
+}
+
+// GoImports returns the Go packages of the types of other `go_package`s used by the methods and events of any
+// service, ordered by their alias
+func (e *Data) GoImports() []*proto.GoImport {
+	imports := make([]*proto.GoImport, 0)
+	seen := make(map[string]bool)
+	for _, svc := range e.Services {
+		for _, m := range append(append([]*proto.Method{}, svc.Methods...), svc.Events...) {
+			for _, i := range m.GoImports() {
+				if !seen[i.Path] {
+					seen[i.Path] = true
+					imports = append(imports, i)
+				}
+			}
+		}
+	}
+	sort.Slice(imports, func(i, j int) bool {
+		return imports[i].Alias < imports[j].Alias
+	})
+	return imports
+}
+
+// StreamingEnabled reports whether any service has a streaming method
+func (e *Data) StreamingEnabled() bool {
+	for _, svc := range e.Services {
+		if svc.StreamingUsed() {
+			return true
+		}
+	}
+	return false
+}
+
+// HTTPStreamingEnabled reports whether any service streams the responses of a method over HTTP
+func (e *Data) HTTPStreamingEnabled() bool {
+	for _, svc := range e.Services {
+		if svc.HTTPHelper.StreamingEnabled() {
+			return true
+		}
+	}
+	return false
+}
+
+// WebSocketEnabled reports whether any service is served over WebSocket
+func (e *Data) WebSocketEnabled() bool {
+	for _, svc := range e.Services {
+		if svc.WSPath != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HTTPMethods reports whether any service has a method with an HTTP binding
+func (e *Data) HTTPMethods() bool {
+	for _, svc := range e.Services {
+		if len(svc.HTTPHelper.Methods) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GRPCServiceName returns the fully qualified name of the service as used by gRPC
+func (e *Data) GRPCServiceName(svc *proto.Service) string {
+	if e.ProtoPackage == "" {
+		return svc.Name
 	}
+	return e.ProtoPackage + "." + svc.Name
 }
 
 // ApplyTemplate applies the passed template with the Data
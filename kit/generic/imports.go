@@ -0,0 +1,92 @@
+package generic
+
+import (
+	"bytes"
+	"github.com/niiigoo/hawk/proto"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"strconv"
+)
+
+// FixImports removes the given imports the code does not use and adds the ones it uses but does not import, e.g. the
+// types of a handler added to an existing handlers.go. Other imports are kept as they are.
+func FixImports(code []byte, imports []*proto.GoImport) ([]byte, error) {
+	if len(imports) == 0 {
+		return code, nil
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "", code, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	// the packages are referred to by selectors of unresolved identifiers, e.g. `commonpb.User`
+	used := make(map[string]bool)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if id, ok := sel.X.(*ast.Ident); ok && id.Obj == nil {
+				used[id.Name] = true
+			}
+		}
+		return true
+	})
+
+	byPath := make(map[string]*proto.GoImport, len(imports))
+	for _, i := range imports {
+		byPath[i.Path] = i
+	}
+	var decl *ast.GenDecl
+	imported := make(map[string]bool)
+	changed := false
+	for _, d := range f.Decls {
+		gen, ok := d.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT {
+			continue
+		}
+		if decl == nil {
+			decl = gen
+		}
+		specs := gen.Specs[:0]
+		for _, spec := range gen.Specs {
+			path, _ := strconv.Unquote(spec.(*ast.ImportSpec).Path.Value)
+			if i, ok := byPath[path]; ok {
+				if !used[i.Alias] {
+					changed = true
+					continue
+				}
+				imported[path] = true
+			}
+			specs = append(specs, spec)
+		}
+		gen.Specs = specs
+	}
+
+	for _, i := range imports {
+		if imported[i.Path] || !used[i.Alias] {
+			continue
+		}
+		if decl == nil {
+			decl = &ast.GenDecl{Tok: token.IMPORT}
+			f.Decls = append([]ast.Decl{decl}, f.Decls...)
+		}
+		if !decl.Lparen.IsValid() {
+			decl.Lparen = decl.Pos()
+		}
+		decl.Specs = append(decl.Specs, &ast.ImportSpec{
+			Name: ast.NewIdent(i.Alias),
+			Path: &ast.BasicLit{Kind: token.STRING, Value: strconv.Quote(i.Path)},
+		})
+		changed = true
+	}
+	if !changed {
+		return code, nil
+	}
+
+	out := bytes.NewBuffer(nil)
+	if err = printer.Fprint(out, fset, f); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
@@ -350,9 +350,10 @@ func TestPruneDecls(t *testing.T) {
 
 func TestUpdatePBFieldType(t *testing.T) {
 	values := []string{
-		`*pb.Old`, "New", "*pb.New",
-		`pb.Old`, "New", "pb.New",
-		`Old`, "New", "Old",
+		`*pb.Old`, "pb.New", "*pb.New",
+		`pb.Old`, "pb.New", "pb.New",
+		`*pb.Old`, "commonpb.New", "*commonpb.New",
+		`Old`, "pb.New", "Old",
 	}
 	for i := 0; i < len(values); i += 3 {
 		exp, err := parser.ParseExpr(values[i])
@@ -492,6 +493,171 @@ func TestUpdateMethods(t *testing.T) {
 	}
 }
 
+func TestUpdateMethodsMultipleServices(t *testing.T) {
+	const def = `
+		syntax = "proto3";
+
+		package general;
+
+		message RequestMessage {
+			string input = 1;
+		}
+
+		message ResponseMessage {
+			string output = 1;
+		}
+
+		service UserService {
+			rpc Get (RequestMessage) returns (ResponseMessage) {}
+			rpc Update (RequestMessage) returns (ResponseMessage) {}
+		}
+
+		service AdminService {
+			rpc Get (RequestMessage) returns (ResponseMessage) {}
+		}
+	`
+	p := parser2.NewService()
+	err := p.ParseString(def)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	conf := generic.Config{
+		GoPackage: "github.com/niiigoo/hawk/kit/gengokit",
+		PBPackage: "github.com/niiigoo/hawk/kit/gengokit/general-service",
+	}
+	svcs := p.Definition().Services
+	te := generic.NewDefinitionData(p.Definition(), conf)
+
+	firstCode, err := renderServices(svcs, "", te)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"func NewUserService() pb.UserServiceServer",
+		"func NewAdminService() pb.AdminServiceServer",
+		"func (s userServiceService) Get(",
+		"func (s userServiceService) Update(",
+		"func (s adminServiceService) Get(",
+	} {
+		if !strings.Contains(firstCode, want) {
+			t.Fatalf("Generated handlers do not contain %q\n%s", want, firstCode)
+		}
+	}
+
+	// keep the custom code of both services
+	edited := strings.Replace(firstCode, "var resp pb.ResponseMessage", "var resp pb.ResponseMessage // custom", -1)
+	secondCode, err := renderServices(svcs, edited, te)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secondCode != edited {
+		t.Fatal("Generated services differ after regenerated with same definition\n" +
+			diff(edited, secondCode))
+	}
+
+	// only the method of the removed rpc is deleted, although both services define `Get`
+	svcs[0].Methods = svcs[0].Methods[1:]
+	thirdCode, err := renderServices(svcs, secondCode, te)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(thirdCode, "func (s userServiceService) Get(") ||
+		!strings.Contains(thirdCode, "func (s adminServiceService) Get(") {
+		t.Fatal("Generated services contain the wrong methods after removing an rpc\n" +
+			diff(secondCode, thirdCode))
+	}
+}
+
+func TestUpdateMethodsOrder(t *testing.T) {
+	const def = `
+		syntax = "proto3";
+		package general;
+		message Empty {}
+		service Proto {
+			rpc Alpha (Empty) returns (Empty);
+			rpc Zulu (Empty) returns (Empty);
+			rpc Bravo (Empty) returns (Empty);
+			rpc Yankee (Empty) returns (Empty);
+			rpc Charlie (Empty) returns (Empty);
+			rpc Xray (Empty) returns (Empty);
+			rpc Delta (Empty) returns (Empty);
+			rpc Whiskey (Empty) returns (Empty);
+		}
+	`
+	p := parser2.NewService()
+	err := p.ParseString(def)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc := p.Definition().Services[0]
+	allMethods := svc.Methods
+	te := generic.NewData(svc, generic.Config{
+		GoPackage: "github.com/niiigoo/hawk/kit/gengokit",
+		PBPackage: "github.com/niiigoo/hawk/kit/gengokit/general-service",
+	})
+
+	svc.Methods = allMethods[:1]
+	prev, err := renderService(svc, "", te)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the missing methods are appended in the order of the definition, every time
+	svc.Methods = allMethods
+	want, err := renderService(svc, prev, te)
+	if err != nil {
+		t.Fatal(err)
+	}
+	last := -1
+	for _, m := range allMethods {
+		i := strings.Index(want, ") "+m.Name+"(")
+		if i <= last {
+			t.Fatalf("method %s is not in the order of the definition:\n%s", m.Name, want)
+		}
+		last = i
+	}
+	for i := 0; i < 10; i++ {
+		got, err := renderService(svc, prev, te)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != want {
+			t.Fatal("Generated service differs between runs\n" + diff(want, got))
+		}
+	}
+}
+
+func renderServices(svcs []*parser2.Service, prev string, data *generic.Data) (string, error) {
+	var prevFile io.Reader
+	if prev != "" {
+		prevFile = strings.NewReader(prev)
+	}
+
+	h, err := NewServices(svcs, prevFile)
+	if err != nil {
+		return "", err
+	}
+
+	next, err := h.Render(data)
+	if err != nil {
+		return "", err
+	}
+
+	nextBytes, err := io.ReadAll(next)
+	if err != nil {
+		return "", err
+	}
+
+	nextCode, err := testFormat(string(nextBytes))
+	if err != nil {
+		return "", errors.Wrap(err, "cannot format")
+	}
+
+	return strings.TrimSpace(nextCode), nil
+}
+
 // renderService takes in a previous file as a string and returns the generated
 // service file as a string. This helper method exists because the logic for
 // reading the io.Reader to a string is repeated.
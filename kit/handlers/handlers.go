@@ -6,6 +6,7 @@ package handlers
 
 import (
 	"bytes"
+	"github.com/iancoleman/strcase"
 	"github.com/niiigoo/hawk/kit/generic"
 	"github.com/niiigoo/hawk/kit/template"
 	protoParser "github.com/niiigoo/hawk/proto"
@@ -31,10 +32,22 @@ const ServerHandlerMethodsPath = "handlers/handlers.methods.go.tpl"
 // New returns a hawk.Renderable capable of updating server handlers.
 // The previous version of the server handler should be provided.
 func New(svc *protoParser.Service, prev io.Reader) (generic.Renderable, error) {
-	var h handler
-	log.WithField("Service Methods", len(svc.Methods)).Debug("Handler being created")
-	h.mMap = newMethodMap(svc.Methods)
-	h.service = svc
+	return NewServices([]*protoParser.Service{svc}, prev)
+}
+
+// NewServices returns a hawk.Renderable capable of updating the server handlers
+// of multiple services sharing one handlers.go file.
+func NewServices(svcs []*protoParser.Service, prev io.Reader) (generic.Renderable, error) {
+	h := handler{
+		services: svcs,
+		mMaps:    make(map[string]methodMap, len(svcs)),
+		ignored:  map[string]bool{ignoredFunc: true},
+	}
+	for _, svc := range svcs {
+		log.WithField("Service Methods", len(svc.Methods)).Debug("Handler being created")
+		h.mMaps[receiverName(svc)] = newMethodMap(svc.Methods)
+		h.ignored[constructorName(svc)] = true
+	}
 
 	if prev == nil {
 		return &h, nil
@@ -65,9 +78,12 @@ func newMethodMap(meths []*protoParser.Method) methodMap {
 }
 
 type handler struct {
-	fileSet *token.FileSet
-	service *protoParser.Service
-	mMap    methodMap
+	fileSet  *token.FileSet
+	services []*protoParser.Service
+	// mMaps holds the methodMap of each service by the name of its receiver
+	mMaps map[string]methodMap
+	// ignored contains the constructors of the services
+	ignored map[string]bool
 	// The Abstract Syntax Tree (AST) of the existing go code found in
 	// 'handlers/handlers.go'. If the 'handlers/handlers.go' file does not
 	// exist, then ast will be nil.
@@ -76,7 +92,12 @@ type handler struct {
 
 type handlerData struct {
 	ServiceName string
+	GoPrefix    string
 	Methods     []*protoParser.Method
+	// Constructor and Type are set if the previous file lacks the
+	// constructor or the struct type of the service
+	Constructor bool
+	Type        bool
 }
 
 // Render returns an io.Reader with the go code of the server handler. That
@@ -90,29 +111,8 @@ func (h *handler) Render(data *generic.Data) (io.Reader, error) {
 	}
 
 	// Remove exported methods not defined in service definition
-	// and remove methods defined in the previous file from methodMap
-	log.WithField("Service Methods", len(h.mMap)).Debug("Before prune")
-	// Lowercase the service name before pruning because the templates all
-	// lowercase the service name when generating code to ensure Identifiers
-	// incorporating the service name remain unexported.
-	h.ast.Decls = h.mMap.pruneDecls(h.ast.Decls, strings.ToLower(data.Service.Name))
-	log.WithField("Service Methods", len(h.mMap)).Debug("After prune")
-
-	// create a new handlerData, and add all methods not defined in the existing 'handlers/handlers.go' file
-	ex := handlerData{
-		ServiceName: data.Service.Name,
-	}
-
-	// If there are no methods to template then exit early
-	if len(h.mMap) == 0 {
-		return h.buffer()
-	}
-
-	for k, v := range h.mMap {
-		log.WithField("Method", k).
-			Info("Generating handler from rpc definition")
-		ex.Methods = append(ex.Methods, v)
-	}
+	// and remove methods defined in the previous file from the methodMaps
+	h.ast.Decls = pruneDecls(h.ast.Decls, h.mMaps, h.ignored)
 
 	// get the code out of the ast
 	code, err := h.buffer()
@@ -120,20 +120,61 @@ func (h *handler) Render(data *generic.Data) (io.Reader, error) {
 		return nil, err
 	}
 
-	// render the server for all methods not already defined
-	newCode, err := applyServerMethsTpl(ex)
+	// render the missing parts of each service in the order of the definition
+	for _, svc := range h.services {
+		ex := handlerData{
+			ServiceName: svc.Name,
+			GoPrefix:    svc.GoPrefix,
+			Constructor: !h.declared(constructorName(svc)),
+			Type:        !h.declared(receiverName(svc)),
+		}
+		mMap := h.mMaps[receiverName(svc)]
+		for _, m := range svc.Methods {
+			if _, ok := mMap[m.Name]; ok {
+				log.WithField("Method", m.Name).
+					Info("Generating handler from rpc definition")
+				ex.Methods = append(ex.Methods, m)
+			}
+		}
 
-	if err != nil {
-		return nil, err
-	}
+		// If there is nothing to template continue with the next service
+		if len(ex.Methods) == 0 && !ex.Constructor && !ex.Type {
+			continue
+		}
 
-	if _, err = code.ReadFrom(newCode); err != nil {
-		return nil, err
+		// render the server for all methods not already defined
+		newCode, err := applyServerMethsTpl(ex)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err = code.ReadFrom(newCode); err != nil {
+			return nil, err
+		}
 	}
 
 	return code, nil
 }
 
+// declared reports whether the previous file declares a top level function or type with the name
+func (h *handler) declared(name string) bool {
+	for _, d := range h.ast.Decls {
+		switch x := d.(type) {
+		case *ast.FuncDecl:
+			if x.Recv == nil && x.Name.Name == name {
+				return true
+			}
+		case *ast.GenDecl:
+			for _, spec := range x.Specs {
+				if t, ok := spec.(*ast.TypeSpec); ok && t.Name.Name == name {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
 func (h *handler) buffer() (*bytes.Buffer, error) {
 	code := bytes.NewBuffer(nil)
 	err := printer.Fprint(code, h.fileSet, h.ast)
@@ -145,6 +186,17 @@ func (h *handler) buffer() (*bytes.Buffer, error) {
 	return code, nil
 }
 
+// receiverName returns the name of the struct implementing the service. The
+// templates lowercase the service name to keep the struct unexported.
+func receiverName(svc *protoParser.Service) string {
+	return strcase.ToLowerCamel(svc.Name) + "Service"
+}
+
+// constructorName returns the name of the func creating the service
+func constructorName(svc *protoParser.Service) string {
+	return "New" + svc.GoPrefix + "Service"
+}
+
 // pruneDecls constructs a new []ast.Decls with the exported funcs in decls
 // who's names are not keys in methodMap and/or does not have the function
 // receiver svcName + "Service" ("Handler func")  removed.
@@ -157,19 +209,27 @@ func (h *handler) buffer() (*bytes.Buffer, error) {
 // parameters and output results to by the types described in methodMap's
 // serviceMethod for that "Handler func".
 func (m methodMap) pruneDecls(decls []ast.Decl, svcName string) []ast.Decl {
+	return pruneDecls(decls, map[string]methodMap{svcName + "Service": m}, map[string]bool{ignoredFunc: true})
+}
+
+// pruneDecls is the multi-service variant of methodMap.pruneDecls, the
+// methodMaps are looked up by the receiver of the "Handler func".
+func pruneDecls(decls []ast.Decl, mMaps map[string]methodMap, ignored map[string]bool) []ast.Decl {
 	var newDecls []ast.Decl
 	for _, d := range decls {
 		switch x := d.(type) {
 		case *ast.FuncDecl:
 			name := x.Name.Name
-			// Special case NewService and ignore unexported
-			if name == ignoredFunc || !ast.IsExported(name) {
+			// Special case the constructors and ignore unexported
+			if ignored[name] || !ast.IsExported(name) {
 				log.WithField("Func", name).
 					Debug("Ignoring")
 				newDecls = append(newDecls, x)
 				continue
 			}
-			if ok := isValidFunc(x, m, svcName); ok == true {
+			rName := recvTypeToString(x.Recv)
+			m := mMaps[rName]
+			if ok := isValidFunc(x, m, strings.TrimSuffix(rName, "Service")); ok == true {
 				updateParams(x, m[name])
 				updateResults(x, m[name])
 				newDecls = append(newDecls, x)
@@ -201,7 +261,7 @@ func updateParams(f *ast.FuncDecl, m *protoParser.Method) {
 				Warn("Function params signature should be func NAME(ctx context.Context, in *pb.TYPE), cannot fix")
 			return
 		}
-		updatePBFieldType(f.Type.Params.List[1].Type, m.Request)
+		updatePBFieldType(f.Type.Params.List[1].Type, m.GoRequestType())
 	}
 }
 
@@ -223,11 +283,11 @@ func updateResults(f *ast.FuncDecl, m *protoParser.Method) {
 				Warn("Function results signature should be (*pb.TYPE, error), cannot fix")
 			return
 		}
-		updatePBFieldType(f.Type.Results.List[0].Type, m.Response)
+		updatePBFieldType(f.Type.Results.List[0].Type, m.GoResponseType())
 	}
 }
 
-// updatePBFieldType updates t if in the form X.Sel/*X.Sel to X.newType/*X.newType.
+// updatePBFieldType updates t if in the form X.Sel/*X.Sel to the qualified newType, e.g. pkg.Type/*pkg.Type.
 func updatePBFieldType(t ast.Expr, newType string) {
 	// *pb.TYPE -> pb.TYPE
 	if ptr, _ := t.(*ast.StarExpr); ptr != nil {
@@ -235,8 +295,12 @@ func updatePBFieldType(t ast.Expr, newType string) {
 	}
 	// pb.TYPE -> TYPE
 	if sel, _ := t.(*ast.SelectorExpr); sel != nil {
-		//pb.SOMETYPE -> pb.newType
-		sel.Sel.Name = newType
+		//pb.SOMETYPE -> pkg.newType
+		pkg, name, _ := strings.Cut(newType, ".")
+		if x, _ := sel.X.(*ast.Ident); x != nil {
+			x.Name = pkg
+		}
+		sel.Sel.Name = name
 	}
 }
 
@@ -14,6 +14,8 @@ import (
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"text/template"
@@ -26,11 +28,37 @@ type Helper struct {
 	Service            *proto.Service
 	Methods            []*Method
 	CompressionEnabled bool
-	QueryWithTime      bool
 	ServerTemplate     func(interface{}) (string, error)
 	ClientTemplate     func(interface{}) (string, error)
 }
 
+// Routes returns the bindings in order of registration: gorilla/mux uses the first matching
+// route, therefore routes with a custom verb precede the others and routes matching any
+// number of segments (`**`) are registered last.
+func (h *Helper) Routes() []*Binding {
+	routes := make([]*Binding, 0)
+	for _, priority := range []int{0, 1, 2} {
+		for _, m := range h.Methods {
+			for _, b := range m.Bindings {
+				if b.routePriority() == priority {
+					routes = append(routes, b)
+				}
+			}
+		}
+	}
+	return routes
+}
+
+func (b *Binding) routePriority() int {
+	if b.Verb != "" {
+		return 0
+	}
+	if b.MultiSegment {
+		return 2
+	}
+	return 1
+}
+
 // NewHelper builds a helper struct from a service declaration. The other
 // "New*" functions in this file are there to make this function smaller and
 // more testable.
@@ -49,17 +77,64 @@ func NewHelper(svc *proto.Service) *Helper {
 			rv.Methods = append(rv.Methods, NewMethod(method))
 		}
 	}
-	rv.QueryWithTime = svc.QueryWithTime
 	return &rv
 }
 
+// Method returns the method with the name, nil if the method has no HTTP binding
+func (h *Helper) Method(name string) *Method {
+	for _, m := range h.Methods {
+		if m.Name == name {
+			return m
+		}
+	}
+	return nil
+}
+
+// StreamingEnabled reports whether a method with an HTTP binding streams the responses
+func (h *Helper) StreamingEnabled() bool {
+	for _, m := range h.Methods {
+		if m.ServerStream {
+			return true
+		}
+	}
+	return false
+}
+
+// WellKnownImports returns the Go packages of the well-known types decoded from the path or query
+func (h *Helper) WellKnownImports() []string {
+	imports := make([]string, 0)
+	add := func(f Field) {
+		if f.WellKnown != nil && f.Location != "body" && !slices.Contains(imports, f.WellKnown.GoPackage) {
+			imports = append(imports, f.WellKnown.GoPackage)
+		}
+	}
+	for _, m := range h.Methods {
+		for _, b := range m.Bindings {
+			for _, f := range b.Fields {
+				add(*f)
+			}
+			for _, oneOf := range b.OneOfFields {
+				for _, option := range oneOf.Options {
+					add(option)
+				}
+			}
+		}
+	}
+	sort.Strings(imports)
+	return imports
+}
+
 // NewMethod builds a Method struct from a svcdef.ServiceMethod.
 func NewMethod(meth *proto.Method) *Method {
 	nMeth := Method{
 		Name:         meth.Name,
-		RequestType:  meth.Request,
-		ResponseType: meth.Response,
+		RequestType:  meth.GoRequestType(),
+		ResponseType: meth.GoResponseType(),
 		Compressed:   meth.Compressed,
+		ServerStream: meth.ResponseStream,
+	}
+	if meth.Parent != nil {
+		nMeth.Prefix = meth.Parent.GoPrefix
 	}
 	for i := range meth.HttpBindings {
 		nBinding := NewBinding(i, meth)
@@ -69,6 +144,56 @@ func NewMethod(meth *proto.Method) *Method {
 	return &nMeth
 }
 
+// BindingChoices returns the bindings in the order the client tries them: bindings with more path fields
+// come first, each is chosen if its path fields are populated. The condition of a binding without path fields
+// is empty, it is chosen otherwise and the bindings after it are omitted. If every binding has path fields,
+// none of them may match the request.
+func (m *Method) BindingChoices() []BindingChoice {
+	bindings := slices.Clone(m.Bindings)
+	sort.SliceStable(bindings, func(i, j int) bool {
+		return len(bindings[i].PathFields()) > len(bindings[j].PathFields())
+	})
+	choices := make([]BindingChoice, 0, len(bindings))
+	for _, b := range bindings {
+		conditions := make([]string, 0)
+		for _, f := range b.PathFields() {
+			for _, parent := range f.Parents {
+				conditions = append(conditions, fmt.Sprintf("req.%s != nil", parent.CamelName))
+			}
+			conditions = append(conditions, f.populated())
+		}
+		choices = append(choices, BindingChoice{Binding: b, Condition: strings.Join(conditions, " && ")})
+		if len(conditions) == 0 {
+			return choices
+		}
+	}
+	return choices
+}
+
+// PathFields returns the fields located in the path
+func (b *Binding) PathFields() []*Field {
+	fields := make([]*Field, 0)
+	for _, f := range b.Fields {
+		if f.Location == "path" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// populated returns the condition checking the field of the request `req` is not empty
+func (f *Field) populated() string {
+	switch {
+	case f.IsOptional || f.WellKnown != nil || f.Repeated || f.IsStringMap || f.GoType == "bytes" || !f.IsBaseType && !f.IsEnum:
+		return fmt.Sprintf("req.%s != nil", f.CamelName)
+	case f.GoType == "bool":
+		return "req." + f.CamelName
+	case f.GoType == "string":
+		return fmt.Sprintf(`req.%s != ""`, f.CamelName)
+	}
+	return fmt.Sprintf("req.%s != 0", f.CamelName)
+}
+
 // NewBinding creates a Binding struct based on a proto.OptionHttp. Because
 // NewBinding requires access to some of its parent method's fields, instead
 // of passing a proto.OptionHttp directly, you instead pass a
@@ -76,12 +201,27 @@ func NewMethod(meth *proto.Method) *Method {
 // "HTTPBinding" slice.
 func NewBinding(i int, meth *proto.Method) *Binding {
 	binding := meth.HttpBindings[i]
+	var prefix string
+	if meth.Parent != nil {
+		prefix = meth.Parent.GoPrefix
+	}
 	nBinding := Binding{
-		Label:        meth.Name + EnglishNumber(i),
+		Label:        prefix + meth.Name + EnglishNumber(i),
 		PathTemplate: binding.GorillaMuxPath(),
 		BasePath:     basePath(binding.PathRaw),
 		Method:       binding.Method,
 	}
+	if binding.Path.Verb != nil {
+		nBinding.Verb = *binding.Path.Verb
+	}
+	nBinding.Body = binding.Body
+	nBinding.ResponseBody = binding.ResponseBody
+	for _, segment := range binding.Path.Segments {
+		if segment.Wildcard != nil && *segment.Wildcard == "**" ||
+			segment.Variable != nil && segment.Variable.MultiSegment() {
+			nBinding.MultiSegment = true
+		}
+	}
 	// Handle oneOfs which need to be specially formed for query params
 	for _, param := range binding.Params {
 		// only processing oneOf fields
@@ -92,7 +232,13 @@ func NewBinding(i int, meth *proto.Method) *Binding {
 			Name:     strcase.ToCamel(param.Name),
 			Location: "query",
 		}
-		for _, oneofType := range param.OneOfFields {
+		names := make([]string, 0, len(param.OneOfFields))
+		for name := range param.OneOfFields {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			oneofType := param.OneOfFields[name]
 			option := Field{
 				Name: oneofType.Name,
 				//QueryParamName: oneOfType.PBFieldName,
@@ -107,6 +253,11 @@ func NewBinding(i int, meth *proto.Method) *Binding {
 			if oneofType.Type == proto.TypeScalar {
 				option.GoType = oneofType.Field.Type.Scalar.GoString()
 				option.IsBaseType = true
+			} else if wk := oneofType.WellKnown(); wk != nil && !oneofType.Repeated {
+				option.GoType = "*" + wk.GoType
+				option.WellKnown = wk
+			} else if oneofType.Symbol != nil {
+				option.GoType = oneofType.Symbol.GoType()
 			} else if oneofType.Field.Type.Reference != "" {
 				option.GoType = "pb." + oneofType.Field.Type.Reference
 			}
@@ -120,7 +271,7 @@ func NewBinding(i int, meth *proto.Method) *Binding {
 
 			option.IsEnum = oneofType.Type == proto.TypeEnum
 			option.ConvertFunc, option.ConvertFuncNeedsErrorCheck = createDecodeConvertFunc(option)
-			option.TypeConversion = fmt.Sprintf("&pb.%s_%s{%s: %s}", strcase.ToCamel(meth.Request), strcase.ToCamel(oneofType.Name), strcase.ToCamel(oneofType.Name), createDecodeTypeConversion(option))
+			option.TypeConversion = fmt.Sprintf("&%s_%s{%s: %s}", meth.GoRequestType(), strcase.ToCamel(oneofType.Name), strcase.ToCamel(oneofType.Name), createDecodeTypeConversion(option))
 			option.ZeroValue = getZeroValue(option)
 
 			oneOfField.Options = append(oneOfField.Options, option)
@@ -136,18 +287,37 @@ func NewBinding(i int, meth *proto.Method) *Binding {
 			continue
 		}
 		newField := Field{
-			Name:           param.Name,
-			QueryParamName: param.Name,
+			Name:           param.FieldPath(),
+			QueryParamName: param.FieldPath(),
 			CamelName:      strcase.ToCamel(param.Name),
 			LowCamelName:   strcase.ToLowerCamel(param.Name),
 			Location:       string(param.Location),
 			Repeated:       param.Repeated,
 			IsOptional:     param.Optional,
-			LocalName:      fmt.Sprintf("%s%s", strcase.ToCamel(param.Name), strcase.ToCamel(meth.Name)),
 		}
+		// nested fields are addressed through their parents, e.g. `req.Org.Id` for `org.id`
+		path := make([]string, 0, len(param.Parents))
+		for _, parent := range param.Parents {
+			path = append(path, strcase.ToCamel(parent.Name))
+			newField.Parents = append(newField.Parents, ParentField{
+				CamelName: strings.Join(path, "."),
+				GoType:    parent.Symbol.GoType(),
+			})
+		}
+		newField.CamelName = strings.Join(append(path, newField.CamelName), ".")
+		newField.LocalName = strings.ReplaceAll(newField.CamelName, ".", "") + strcase.ToCamel(meth.Name)
 		if param.Type == proto.TypeScalar {
 			newField.GoType = param.Field.Type.Scalar.GoString()
 			newField.IsBaseType = true
+		} else if wk := param.WellKnown(); wk != nil {
+			newField.GoType = "*" + wk.GoType
+			newField.WellKnown = wk
+			newField.IsOptional = false
+		} else if param.StringMap() {
+			newField.GoType = "map[string]string"
+			newField.IsStringMap = true
+		} else if param.Symbol != nil {
+			newField.GoType = param.Symbol.GoType()
 		} else if param.Field.Type.Reference != "" {
 			newField.GoType = "pb." + param.Field.Type.Reference
 		}
@@ -156,11 +326,7 @@ func NewBinding(i int, meth *proto.Method) *Binding {
 		if param.Optional && param.Repeated {
 			newField.GoType = "[]*" + newField.GoType
 		} else if param.Repeated {
-			newField.GoType = "[]" + newField.GoType
-		}
-
-		if newField.Location == "query" && newField.GoType == "pb.google.protobuf.Timestamp" {
-			meth.Parent.QueryWithTime = true
+			newField.GoType = "[]" + strings.TrimPrefix(newField.GoType, "*")
 		}
 
 		// IsEnum needed for ConvertFunc and TypeConversion logic just below
@@ -169,9 +335,12 @@ func NewBinding(i int, meth *proto.Method) *Binding {
 		newField.TypeConversion = createDecodeTypeConversion(newField)
 
 		nBinding.Fields = append(nBinding.Fields, &newField)
+		if newField.Location == "body" {
+			nBinding.BodyField = &newField
+		}
 
-		// Enums are allowed in query/path parameters, skip warning
-		if newField.IsEnum {
+		// Enums, well-known types and string maps are allowed in query/path parameters, skip warning
+		if newField.IsEnum || newField.WellKnown != nil && !newField.Repeated || newField.IsStringMap && newField.Location == "query" {
 			continue
 		}
 
@@ -201,11 +370,7 @@ func GenServerTemplate(exec interface{}) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	encodeFuncSource, err := FuncSourceCode(encodePathParams)
-	if err != nil {
-		return "", err
-	}
-	code = FormatCode(code + encodeFuncSource)
+	code = FormatCode(code)
 	return code, nil
 }
 
@@ -255,47 +420,131 @@ func (b *Binding) GenClientEncode() (string, error) {
 //	    "fmt.Sprint(req.A)",
 //	}
 func (b *Binding) PathSections() []string {
-	path := b.PathTemplate
-	re := regexp.MustCompile(`{.+:.+}`)
-	path = re.ReplaceAllStringFunc(path, func(v string) string {
-		return strings.Split(v, ":")[0] + "}"
-	})
-
-	isEnum := make(map[string]struct{})
-	for _, v := range b.Fields {
-		if v.IsEnum {
-			isEnum[v.CamelName] = struct{}{}
+	var rv []string
+	for _, segment := range b.PathParts() {
+		parts := make([]string, 0, len(segment))
+		for _, part := range segment {
+			switch {
+			case part.Variable == "":
+				// Add quotes around things which will be embedded as string literals,
+				// so that the 'fmt.Sprint' lines will be unquoted and thus
+				// evaluated as code.
+				parts = append(parts, `"`+part.Literal+`"`)
+			case part.Field != nil && part.Field.IsEnum:
+				// enums are sent by name
+				parts = append(parts, fmt.Sprintf("req.%v.String()", part.CamelName))
+			case part.Field != nil && part.Field.WellKnown != nil:
+				parts = append(parts, fmt.Sprintf("formatWellKnownPath(req.%v)", part.CamelName))
+			default:
+				parts = append(parts, fmt.Sprintf("fmt.Sprint(req.%v)", part.CamelName))
+			}
 		}
+		if len(parts) == 0 {
+			parts = append(parts, `""`)
+		}
+		rv = append(rv, strings.Join(parts, " + "))
 	}
+	return rv
+}
 
-	var rv []string
-	parts := strings.Split(path, "/")
-	for _, part := range parts {
-		if len(part) > 2 && part[0] == '{' && part[len(part)-1] == '}' {
-			name := part[1 : len(part)-1]
-			parts := strings.Split(name, ".")
-			for idx, part := range parts {
-				parts[idx] = strcase.ToCamel(part)
+// PathParents returns the messages containing the nested path fields once each, outermost first. They have to be set
+// to build the path, e.g. `req.Org` of `{org.id}`.
+func (b *Binding) PathParents() []ParentField {
+	parents := make([]ParentField, 0)
+	for _, f := range b.PathFields() {
+		for _, parent := range f.Parents {
+			if !slices.Contains(parents, parent) {
+				parents = append(parents, parent)
 			}
-			camelName := strings.Join(parts, ".")
+		}
+	}
+	return parents
+}
+
+// PathParts returns the literals and variables of each segment of the path template. Wildcards (`*`) are
+// filled by the literal `-`, wildcards matching any number of segments (`**`) are omitted together with the
+// segments left empty. The first segment is empty for absolute paths.
+func (b *Binding) PathParts() [][]PathPart {
+	fields := make(map[string]*Field)
+	for _, f := range b.Fields {
+		fields[f.CamelName] = f
+	}
 
-			if _, ok := isEnum[camelName]; ok {
-				convert := fmt.Sprintf("fmt.Sprintf(\"%%d\", req.%v)", camelName)
-				rv = append(rv, convert)
+	rv := make([][]PathPart, 0)
+	for i, segment := range splitPathTemplate(b.PathTemplate) {
+		parts := make([]PathPart, 0, len(segment))
+		for _, part := range segment {
+			if part[0] != '{' {
+				parts = append(parts, PathPart{Literal: part})
 				continue
 			}
-			convert := fmt.Sprintf("fmt.Sprint(req.%v)", camelName)
-			rv = append(rv, convert)
-		} else {
-			// Add quotes around things which will be embedded as string literals,
-			// so that the 'fmt.Sprint' lines will be unquoted and thus
-			// evaluated as code.
-			rv = append(rv, `"`+part+`"`)
+			variable := strings.SplitN(part[1:len(part)-1], ":", 2)
+			if strings.HasPrefix(variable[0], "_wildcards") {
+				// zero segments are sent for `**`
+				continue
+			} else if strings.HasPrefix(variable[0], "_wildcard") {
+				parts = append(parts, PathPart{Literal: "-"})
+				continue
+			}
+			names := strings.Split(variable[0], ".")
+			for idx, n := range names {
+				names[idx] = strcase.ToCamel(n)
+			}
+			p := PathPart{
+				Variable:     variable[0],
+				CamelName:    strings.Join(names, "."),
+				MultiSegment: len(variable) > 1 && strings.Contains(variable[1], "/"),
+			}
+			p.Field = fields[p.CamelName]
+			parts = append(parts, p)
 		}
+		if len(parts) == 0 && i > 0 {
+			continue
+		}
+		rv = append(rv, parts)
 	}
 	return rv
 }
 
+// splitPathTemplate splits the gorilla/mux path template into its segments, each segment
+// consists of literals and variables (`{name:pattern}`). Slashes inside variables are kept.
+func splitPathTemplate(path string) [][]string {
+	segments := make([][]string, 0)
+	current := make([]string, 0)
+	start, depth := 0, 0
+	for i := 0; i < len(path); i++ {
+		switch path[i] {
+		case '{':
+			if depth == 0 {
+				if i > start {
+					current = append(current, path[start:i])
+				}
+				start = i
+			}
+			depth++
+		case '}':
+			depth--
+			if depth == 0 {
+				current = append(current, path[start:i+1])
+				start = i + 1
+			}
+		case '/':
+			if depth == 0 {
+				if i > start {
+					current = append(current, path[start:i])
+				}
+				segments = append(segments, current)
+				current = make([]string, 0)
+				start = i + 1
+			}
+		}
+	}
+	if len(path) > start {
+		current = append(current, path[start:])
+	}
+	return append(segments, current)
+}
+
 // GenQueryUnmarshaler returns the generated code for server-side unmarshaling
 // of a query parameter into it's correct field on the request struct.
 func (f *Field) GenQueryUnmarshaler() (string, error) {
@@ -311,11 +560,31 @@ if {{.LocalName}}StrArr, ok := {{.Location}}Params["{{.QueryParamName}}"]; ok {
 if err != nil {
 	return nil, errors.Wrap(err, fmt.Sprintf("Error while extracting {{.LocalName}} from {{.Location}}, {{.Location}}Params: %v", {{.Location}}Params))
 }{{end}}
-{{if or .Repeated .IsBaseType .IsEnum}}req.{{.CamelName}} = {{if .IsOptional}}ref({{end}}{{.TypeConversion}}{{if .IsOptional}}){{end}}{{end}}
+{{if or .Repeated .IsBaseType .IsEnum .WellKnown}}
+{{- range .Parents}}
+if req.{{.CamelName}} == nil {
+	req.{{.CamelName}} = &{{.GoType}}{}
+}
+{{- end}}
+req.{{.CamelName}} = {{if .IsOptional}}ref({{end}}{{.TypeConversion}}{{if .IsOptional}}){{end}}{{end}}
+`
+	// maps are given as `labels[key]=value`
+	stringMapLogic := `
+for key, values := range {{.Location}}Params {
+	if strings.HasPrefix(key, "{{.QueryParamName}}[") && strings.HasSuffix(key, "]") {
+		if req.{{.CamelName}} == nil {
+			req.{{.CamelName}} = make(map[string]string)
+		}
+		req.{{.CamelName}}[key[len("{{.QueryParamName}}["):len(key)-1]] = values[0]
+	}
+}
 `
+
 	mergedLogic := queryParamLogic + genericLogic + "}"
 	if f.Location == "path" {
 		mergedLogic = pathParamLogic + genericLogic
+	} else if f.IsStringMap {
+		mergedLogic = stringMapLogic
 	}
 
 	code, err := ApplyTemplate("FieldEncodeLogic", mergedLogic, f, TemplateFuncs)
@@ -399,16 +668,35 @@ func createDecodeConvertFunc(f Field) (string, bool) {
 		needsErrorCheck = false
 	}
 
-	if f.GoType == "pb.google.protobuf.Timestamp" {
-		return fmt.Sprintf(`tmpTime, err := time.Parse(time.RFC3339, strings.Replace(%s, " ", "+", 1))
-		if err == nil {
-			req.%s = timestamppb.New(tmpTime)
-		}`, f.LocalName+"Str", f.CamelName), true
+	// Well-known types are given in their canonical JSON form, e.g. `1.5s` of a Duration
+	if f.WellKnown != nil && !f.Repeated {
+		value := f.LocalName + "Str"
+		if f.WellKnown.FullName == "google.protobuf.Timestamp" {
+			// the plus sign of the offset becomes a space unless it is escaped
+			value = fmt.Sprintf(`strings.Replace(%s, " ", "+", 1)`, value)
+		}
+		return fmt.Sprintf("%s, err := unmarshalWellKnown(%s, %t, &%s{})", f.LocalName, value, f.WellKnown.Quoted, f.WellKnown.GoType), true
 	}
 
+	// Enums are accepted by name or number, parseEnum responds with the allowed values otherwise
 	if f.IsEnum && !f.Repeated {
-		fType = "%s, err := strconv.ParseInt(%s, 10, 32)"
-		return fmt.Sprintf(fType, f.LocalName, f.LocalName+"Str"), true
+		return fmt.Sprintf(`%s, err := parseEnum(%q, %sStr, %s_value)
+if err != nil {
+	return nil, err
+}`, f.LocalName, f.QueryParamName, f.LocalName, goType), false
+	}
+	if f.IsEnum {
+		return fmt.Sprintf(`_ = %[1]sStr
+%[1]s := make(%[2]s, 0, len(%[1]sStrArr))
+for _, values := range %[1]sStrArr {
+	for _, v := range strings.Split(values, ",") {
+		converted, err := parseEnum(%[3]q, v, %[4]s_value)
+		if err != nil {
+			return nil, err
+		}
+		%[1]s = append(%[1]s, %[4]s(converted))
+	}
+}`, f.LocalName, f.GoType, f.QueryParamName, goType), false
 	}
 
 	// Use json unmarshalling for any custom/repeated messages
@@ -511,6 +799,9 @@ func createDecodeTypeConversion(f Field) string {
 }
 
 func getZeroValue(f Field) string {
+	if f.IsEnum && !f.Repeated {
+		return "0"
+	}
 	if !f.IsBaseType || f.Repeated {
 		return "nil"
 	}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
@@ -103,8 +104,8 @@ func TestNewMethod(t *testing.T) {
 	}
 	meth := &Method{
 		Name:         "Sum",
-		RequestType:  "SumRequest",
-		ResponseType: "SumReply",
+		RequestType:  "pb.SumRequest",
+		ResponseType: "pb.SumReply",
 		Bindings: []*Binding{
 			binding,
 		},
@@ -241,6 +242,27 @@ func TestBinding_PathSections(t *testing.T) {
 				"fmt.Sprint(req.Book.Name)",
 			},
 		},
+		{
+			name:         "verb",
+			pathTemplate: `/v1/users/{id}:activate`,
+			want: []string{
+				`""`,
+				`"v1"`,
+				`"users"`,
+				`fmt.Sprint(req.Id) + ":activate"`,
+			},
+		},
+		{
+			name:         "wildcards",
+			pathTemplate: `/v1/{_wildcard1}/{id:[0-9]{1,3}}/files{_wildcards4:(?:/.*)?}`,
+			want: []string{
+				`""`,
+				`"v1"`,
+				`"-"`,
+				"fmt.Sprint(req.Id)",
+				`"files"`,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -253,3 +275,381 @@ func TestBinding_PathSections(t *testing.T) {
 		})
 	}
 }
+
+func TestNewBinding_Body(t *testing.T) {
+	p := proto.NewService()
+	err := p.ParseString(`
+		syntax = "proto3";
+		package general;
+		message User {
+			string name = 1;
+		}
+		message UpdateRequest {
+			string id = 1;
+			User user = 2;
+		}
+		service Svc {
+			rpc Update(UpdateRequest) returns (UpdateRequest) {
+				option (google.api.http) = {
+					put: "/users/{id}"
+					body: "user"
+					response_body: "user"
+				};
+			}
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := NewBinding(0, p.Definition().Services[0].Methods[0])
+	if b.Body != "user" || b.ResponseBody != "user" {
+		t.Errorf("Body = %q, ResponseBody = %q, want user", b.Body, b.ResponseBody)
+	}
+	if b.BodyField == nil || b.BodyField.CamelName != "User" {
+		t.Fatalf("BodyField = %v, want field User", b.BodyField)
+	}
+	b.Parent = &Method{RequestType: "pb.UpdateRequest"}
+
+	code, err := b.GenServerDecode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(code, `unmarshaler.Unmarshal(bodyField("user", buf), &req)`) {
+		t.Errorf("body is not decoded into the field:\n%s", code)
+	}
+	code, err = b.GenClientEncode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(code, `marshalField(req, "user")`) {
+		t.Errorf("body is not encoded from the field:\n%s", code)
+	}
+}
+
+func TestNewBinding_Nested(t *testing.T) {
+	p := proto.NewService()
+	err := p.ParseString(`
+		syntax = "proto3";
+		package general;
+		message Org {
+			int64 id = 1;
+		}
+		message Page {
+			int32 size = 1;
+		}
+		message ListRequest {
+			Org org = 1;
+			Page page = 2;
+		}
+		service Svc {
+			rpc List(ListRequest) returns (ListRequest) {
+				option (google.api.http) = {
+					get: "/orgs/{org.id}/users"
+				};
+			}
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := NewBinding(0, p.Definition().Services[0].Methods[0])
+	if len(b.Fields) != 2 {
+		t.Fatalf("got %d fields, want 2", len(b.Fields))
+	}
+	f := b.Fields[1]
+	if f.QueryParamName != "page.size" || f.CamelName != "Page.Size" || f.LocalName != "PageSizeList" {
+		t.Errorf("QueryParamName = %q, CamelName = %q, LocalName = %q", f.QueryParamName, f.CamelName, f.LocalName)
+	}
+	if want := []ParentField{{CamelName: "Page", GoType: "pb.Page"}}; !reflect.DeepEqual(f.Parents, want) {
+		t.Errorf("Parents = %v, want %v", f.Parents, want)
+	}
+	b.Parent = &Method{RequestType: "pb.ListRequest"}
+
+	code, err := b.GenServerDecode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`pathParams["org.id"]`, "req.Org = &pb.Org{}", "req.Org.Id = OrgIdList", `queryParams["page.size"]`} {
+		if !strings.Contains(code, want) {
+			t.Errorf("%s is missing:\n%s", want, code)
+		}
+	}
+
+	code, err = b.GenClientEncode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "if req.Org == nil {\n\t\treturn errors.New(\"req.Org is required for path /orgs/{org.id}/users\")"
+	if !strings.Contains(code, want) {
+		t.Errorf("%s is missing:\n%s", want, code)
+	}
+}
+
+func TestNewBinding_Enum(t *testing.T) {
+	p := proto.NewService()
+	err := p.ParseString(`
+		syntax = "proto3";
+		package general;
+		enum Status {
+			STATUS_UNKNOWN = 0;
+			STATUS_ACTIVE = 1;
+		}
+		message GetRequest {
+			Status status = 1;
+			repeated Status statuses = 2;
+		}
+		service Svc {
+			rpc Get(GetRequest) returns (GetRequest) {
+				option (google.api.http) = {
+					get: "/items/{status}"
+				};
+			}
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b := NewBinding(0, p.Definition().Services[0].Methods[0])
+	if got, want := b.PathSections(), []string{`""`, `"items"`, "req.Status.String()"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PathSections() = %v, want %v", got, want)
+	}
+	b.Parent = &Method{RequestType: "pb.GetRequest"}
+
+	code, err := b.GenServerDecode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`StatusGet, err := parseEnum("status", StatusGetStr, pb.Status_value)`,
+		"req.Status = pb.Status(StatusGet)",
+		`converted, err := parseEnum("statuses", v, pb.Status_value)`,
+		"StatusesGet = append(StatusesGet, pb.Status(converted))",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("%s is missing:\n%s", want, code)
+		}
+	}
+}
+
+func TestNewBinding_WellKnown(t *testing.T) {
+	p := proto.NewService()
+	err := p.ParseString(`
+		syntax = "proto3";
+		package general;
+		message Filter {
+			google.protobuf.Timestamp after = 1;
+		}
+		message ListRequest {
+			google.protobuf.Duration timeout = 1;
+			google.protobuf.BoolValue active = 2;
+			map<string, string> labels = 3;
+			Filter filter = 4;
+		}
+		service Svc {
+			rpc List(ListRequest) returns (ListRequest) {
+				option (google.api.http) = {
+					get: "/items/{timeout}"
+				};
+			}
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := NewHelper(p.Definition().Services[0])
+	b := h.Methods[0].Bindings[0]
+	if len(b.Fields) != 4 {
+		t.Fatalf("got %d fields, want 4", len(b.Fields))
+	}
+	if got, want := b.PathSections(), []string{`""`, `"items"`, "formatWellKnownPath(req.Timeout)"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("PathSections() = %v, want %v", got, want)
+	}
+	if got, want := h.WellKnownImports(), []string{
+		"google.golang.org/protobuf/types/known/durationpb",
+		"google.golang.org/protobuf/types/known/timestamppb",
+		"google.golang.org/protobuf/types/known/wrapperspb",
+	}; !reflect.DeepEqual(got, want) {
+		t.Errorf("WellKnownImports() = %v, want %v", got, want)
+	}
+
+	code, err := b.GenServerDecode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"TimeoutList, err := unmarshalWellKnown(TimeoutListStr, true, &durationpb.Duration{})",
+		"ActiveList, err := unmarshalWellKnown(ActiveListStr, false, &wrapperspb.BoolValue{})",
+		`strings.HasPrefix(key, "labels[")`,
+		`FilterAfterList, err := unmarshalWellKnown(strings.Replace(FilterAfterListStr, " ", "+", 1), true, &timestamppb.Timestamp{})`,
+		"req.Filter.After = FilterAfterList",
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("%s is missing:\n%s", want, code)
+		}
+	}
+}
+
+func TestNewBinding_Optional(t *testing.T) {
+	p := proto.NewService()
+	err := p.ParseString(`
+		syntax = "proto3";
+		package general;
+		enum Status {
+			STATUS_UNKNOWN = 0;
+		}
+		message ListRequest {
+			optional int32 size = 1;
+			optional Status status = 2;
+			int32 page = 3;
+		}
+		service Svc {
+			rpc List(ListRequest) returns (ListRequest) {
+				option (google.api.http) = {
+					get: "/items"
+				};
+			}
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	code, err := NewHelper(p.Definition().Services[0]).Methods[0].Bindings[0].GenClientEncode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"if req.Size != nil {\n\t\tvalues.Add(\"size\", fmt.Sprint(*req.Size))",
+		"if req.Status != nil {\n\t\tvalues.Add(\"status\", req.Status.String())",
+		`values.Add("page", fmt.Sprint(req.Page))`,
+	} {
+		if !strings.Contains(code, want) {
+			t.Errorf("%s is missing:\n%s", want, code)
+		}
+	}
+}
+
+func TestMethod_BindingChoices(t *testing.T) {
+	p := proto.NewService()
+	err := p.ParseString(`
+		syntax = "proto3";
+		package general;
+		message Org {
+			int64 id = 1;
+		}
+		message GetRequest {
+			string id = 1;
+			Org org = 2;
+			oneof sel {
+				string b = 3;
+				string a = 4;
+			}
+		}
+		service Svc {
+			rpc Get(GetRequest) returns (GetRequest) {
+				option (google.api.http) = {
+					get: "/items/{id}"
+					additional_bindings { get: "/orgs/{org.id}/items/{id}" }
+					additional_bindings { get: "/items" }
+					additional_bindings { get: "/all" }
+				};
+			}
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewMethod(p.Definition().Services[0].Methods[0])
+	choices := m.BindingChoices()
+	got := make([]string, 0, len(choices))
+	for _, c := range choices {
+		got = append(got, c.Binding.Label+": "+c.Condition)
+	}
+	want := []string{
+		`GetOne: req.Org != nil && req.Org.Id != 0 && req.Id != ""`,
+		`GetZero: req.Id != ""`,
+		"GetTwo: ",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BindingChoices() = %v, want %v", got, want)
+	}
+
+	options := m.Bindings[0].OneOfFields[0].Options
+	if len(options) != 2 || options[0].CamelName != "A" || options[1].CamelName != "B" {
+		t.Errorf("oneof options are not sorted: %v", options)
+	}
+	code, err := m.Bindings[0].GenClientEncode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(code, "req.GetA()") {
+		t.Errorf("req.GetA() is missing:\n%s", code)
+	}
+}
+
+func TestMethod_BindingChoices_PathOnly(t *testing.T) {
+	p := proto.NewService()
+	err := p.ParseString(`
+		syntax = "proto3";
+		package general;
+		message GetRequest {
+			string id = 1;
+			string name = 2;
+		}
+		service Svc {
+			rpc Get(GetRequest) returns (GetRequest) {
+				option (google.api.http) = {
+					get: "/items/{id}"
+					additional_bindings { get: "/names/{name}" }
+				};
+			}
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	choices := NewMethod(p.Definition().Services[0].Methods[0]).BindingChoices()
+	got := make([]string, 0, len(choices))
+	for _, c := range choices {
+		got = append(got, c.Binding.Label+": "+c.Condition)
+	}
+	// none of the bindings is chosen if both path fields are empty
+	want := []string{`GetZero: req.Id != ""`, `GetOne: req.Name != ""`}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("BindingChoices() = %v, want %v", got, want)
+	}
+}
+
+func TestHelper_Method(t *testing.T) {
+	p := proto.NewService()
+	err := p.ParseString(`
+		syntax = "proto3";
+		package general;
+		message Empty {}
+		service Svc {
+			rpc Get(Empty) returns (Empty) {
+				option (google.api.http) = {
+					get: "/items"
+				};
+			}
+			rpc Internal(Empty) returns (Empty);
+		}
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	h := NewHelper(p.Definition().Services[0])
+	if m := h.Method("Get"); m == nil || m.Name != "Get" {
+		t.Errorf("Method(Get) = %v", m)
+	}
+	if m := h.Method("Internal"); m != nil {
+		t.Errorf("Method(Internal) = %v, want nil", m)
+	}
+}
@@ -10,29 +10,64 @@ var ClientEncodeTemplate = `
 	func EncodeHTTP{{$binding.Label}}Request(_ context.Context, r *http.Request, request interface{}) error {
 		strval := ""
 		_ = strval
-		req := request.(*pb.{{GoName $binding.Parent.RequestType}})
+		req := request.(*{{$binding.Parent.RequestType}})
 		_ = req
 		r.Header.Set("transport", "HTTPJSON")
 		r.Header.Set("request-url", r.URL.Path)
+		{{- if $binding.Parent.ServerStream}}
+		r.Header.Set("Accept", "application/x-ndjson")
+		{{- end}}
+		{{- range $parent := $binding.PathParents}}
+		if req.{{$parent.CamelName}} == nil {
+			return errors.New({{printf "%q" (print "req." $parent.CamelName " is required for path " $binding.PathTemplate)}})
+		}
+		{{- end}}
 		// Set the path parameters
 		path := strings.Join([]string{
 		{{- range $section := $binding.PathSections}}
 			{{$section}},
 		{{- end}}
 		}, "/")
-		u, err := url.Parse(path)
+		err := joinPath(r.URL, path)
 		if err != nil {
-			return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+			return err
 		}
-		r.URL.RawPath = u.RawPath
-		r.URL.Path = u.Path
 		// Set the query parameters
 		values := r.URL.Query()
 		var tmp []byte
 		_ = tmp
 		{{- range $field := $binding.Fields }}
 			{{- if eq $field.Location "query"}}
-				{{if and $field.Repeated $field.IsBaseType}}
+				{{- if $field.Parents}}
+				if {{range $i, $parent := $field.Parents}}{{if $i}} && {{end}}req.{{$parent.CamelName}} != nil{{end}} {
+				{{- end}}
+				{{if and $field.Repeated $field.IsEnum}}
+					for _, v := range req.{{$field.CamelName}} {
+						values.Add("{{$field.QueryParamName}}", v.String())
+					}
+				{{else if and $field.IsOptional $field.IsEnum}}
+					if req.{{$field.CamelName}} != nil {
+						values.Add("{{$field.QueryParamName}}", req.{{$field.CamelName}}.String())
+					}
+				{{else if and $field.IsOptional $field.IsBaseType (ne $field.GoType "bytes")}}
+					if req.{{$field.CamelName}} != nil {
+						values.Add("{{$field.QueryParamName}}", fmt.Sprint(*req.{{$field.CamelName}}))
+					}
+				{{else if $field.IsEnum}}
+					values.Add("{{$field.QueryParamName}}", req.{{$field.CamelName}}.String())
+				{{else if and $field.WellKnown (not $field.Repeated)}}
+					if req.{{$field.CamelName}} != nil {
+						strval, err = formatWellKnown(req.{{$field.CamelName}})
+						if err != nil {
+							return errors.Wrap(err, "failed to format req.{{$field.CamelName}}")
+						}
+						values.Add("{{$field.QueryParamName}}", strval)
+					}
+				{{else if $field.IsStringMap}}
+					for k, v := range req.{{$field.CamelName}} {
+						values.Add("{{$field.QueryParamName}}["+k+"]", v)
+					}
+				{{else if and $field.Repeated $field.IsBaseType}}
 					{{- if (Contains $field.GoType "[]string")}}
 					values["{{$field.QueryParamName}}"] = req.{{$field.CamelName}}
 					{{- else}}
@@ -50,22 +85,37 @@ var ClientEncodeTemplate = `
 				{{else}}
 					values.Add("{{$field.QueryParamName}}", fmt.Sprint(req.{{$field.CamelName}}))
 				{{- end }}
+				{{- if $field.Parents}}
+				}
+				{{- end}}
 			{{- end }}
 		{{- end}}
 		{{- range $oneof := $binding.OneOfFields }}
 			{{- if eq $oneof.Location "query"}}
 				{{- range $option := $oneof.Options }}
-					{{if or (not $option.IsBaseType) $option.Repeated}}
-						if val := req.Get{{$option.Name}}(); val != {{$option.ZeroValue}} {
-							tmp, err = json.Marshal(req.Get{{$option.Name}}())
+					{{if $option.WellKnown}}
+						if val := req.Get{{$option.CamelName}}(); val != nil {
+							strval, err = formatWellKnown(val)
 							if err != nil {
-								return errors.Wrap(err, "failed to marshal req.Get{{$option.Name}}()")
+								return errors.Wrap(err, "failed to format req.Get{{$option.CamelName}}()")
+							}
+							values.Add("{{$option.QueryParamName}}", strval)
+						}
+					{{else if and $option.IsEnum (not $option.Repeated)}}
+						if val := req.Get{{$option.CamelName}}(); val != {{$option.ZeroValue}} {
+							values.Add("{{$option.QueryParamName}}", val.String())
+						}
+					{{else if or (not $option.IsBaseType) $option.Repeated}}
+						if val := req.Get{{$option.CamelName}}(); val != {{$option.ZeroValue}} {
+							tmp, err = json.Marshal(req.Get{{$option.CamelName}}())
+							if err != nil {
+								return errors.Wrap(err, "failed to marshal req.Get{{$option.CamelName}}()")
 							}
 							strval = string(tmp)
 							values.Add("{{$option.QueryParamName}}", strval)
 						}
 					{{else}}
-						if val := req.Get{{$option.Name}}(); val != {{$option.ZeroValue}} {
+						if val := req.Get{{$option.CamelName}}(); val != {{$option.ZeroValue}} {
 							values.Add("{{$option.QueryParamName}}", fmt.Sprint(val))
 						}
 					{{- end }}
@@ -73,23 +123,19 @@ var ClientEncodeTemplate = `
 			{{- end }}
 		{{- end}}
 		r.URL.RawQuery = values.Encode()
-		{{- if ne $binding.Method "get" }}
+		{{- if or $binding.Body (ne $binding.Method "get") }}
 		// Set the body parameters
-		var buf bytes.Buffer
-		toRet := request.(*pb.{{GoName $binding.Parent.RequestType}})
-		{{- range $field := $binding.Fields -}}
-			{{if eq $field.Location "body"}}
-				{{/* Only set the fields which should be in the body, so all
-				others will be omitted due to emptiness */}}
-				toRet.{{$field.CamelName}} = req.{{$field.CamelName}}
-			{{end}}
-		{{- end }}
-		encoder := json.NewEncoder(&buf)
-		encoder.SetEscapeHTML(false)
-		if err := encoder.Encode(toRet); err != nil {
-			return errors.Wrapf(err, "couldn't encode body as json %v", toRet)
+		{{- if $binding.BodyField}}
+			// the body is the field {{$binding.Body}} of the request
+			body, err := marshalField(req, "{{$binding.Body}}")
+		{{- else}}
+			body, err := marshaler.Marshal(req)
+		{{- end}}
+		if err != nil {
+			return errors.Wrap(err, "couldn't encode body as json")
 		}
-		r.Body = ioutil.NopCloser(&buf)
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
 		{{- end }}
 		return nil
 	}
@@ -104,6 +150,9 @@ var ClientTemplate = `
 // Package http provides an HTTP client for the {{.Service.Name}} service.
 package http
 import (
+	{{- if .HTTPStreamingEnabled}}
+		"bufio"
+	{{- end}}
 	"bytes"
 	"encoding/json"
 	"fmt"
@@ -111,17 +160,22 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"context"
-	{{ if len .HTTPHelper.Methods -}}
-		"github.com/gogo/protobuf/jsonpb"
-	{{- end }}
 	"github.com/go-kit/kit/endpoint"
 	transport "github.com/go-kit/kit/transport/http"
+	"github.com/niiigoo/hawk/pkg/exception"
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	// This Service
 	"{{.ImportPath -}} /svc"
 	pb "{{.PBImportPath -}}"
+	{{- range .GoImports}}
+		{{.Alias}} "{{.Path}}"
+	{{- end}}
 )
 var (
 	_ = endpoint.Chain
@@ -131,10 +185,54 @@ var (
 	_ = ioutil.NopCloser
 	_ = io.EOF
 )
-// New returns a service backed by an HTTP server living at the remote
+var (
+	marshaler   = protojson.MarshalOptions{UseProtoNames: true}
+	unmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
+)
+{{- define "endpoint"}}
+	{{- with $method := .}}
+	var {{$method.Prefix}}{{$method.Name}}Endpoint endpoint.Endpoint
+	{
+		{{- $choices := $method.BindingChoices}}
+		{{- range $choice := $choices}}
+		{{- with $binding := $choice.Binding}}
+		{{$binding.Label}}Endpoint := transport.NewClient(
+			"{{$binding.Method | ToUpper}}",
+			copyURL(u),
+			EncodeHTTP{{$binding.Label}}Request,
+			{{if $method.ServerStream}}DecodeHTTP{{$method.Prefix}}{{$method.Name}}Response{{else}}DecodeHTTP{{$binding.Label}}Response{{end}},
+			options...,
+		).Endpoint()
+		{{- end}}
+		{{- end}}
+		{{- if eq (len $choices) 1}}
+		{{$method.Prefix}}{{$method.Name}}Endpoint = {{(index $choices 0).Binding.Label}}Endpoint
+		{{- else}}
+		// the binding is chosen by the populated path fields of the request
+		{{$method.Prefix}}{{$method.Name}}Endpoint = func(ctx context.Context, request interface{}) (interface{}, error) {
+			req := request.(*{{$method.RequestType}})
+			switch {
+			{{- $default := false}}
+			{{- range $choice := $choices}}
+			{{if $choice.Condition}}case {{$choice.Condition}}:{{else}}{{$default = true}}default:{{end}}
+				return {{$choice.Binding.Label}}Endpoint(ctx, request)
+			{{- end}}
+			{{- if not $default}}
+			default:
+				return nil, errors.New("no binding of {{$method.Name}} matches the request")
+			{{- end}}
+			}
+		}
+		{{- end}}
+	}
+	{{- end}}
+{{- end}}
+{{- range $svc := .Services}}
+// New{{$svc.GoPrefix}} returns a{{if $svc.GoPrefix}} {{$svc.Name}}{{end}} service backed by an HTTP server living at the remote
 // instance. We expect instance to come from a service discovery system, so
-// likely of the form "host:port".
-func New(instance string, options ...transport.ClientOption) (pb.{{.Service.Name}}Server, error) {
+// likely of the form "host:port". The path of the instance is prepended to the
+// paths of the bindings, e.g. "http://host:port/gateway".
+func New{{$svc.GoPrefix}}(instance string, options ...transport.ClientOption) (pb.{{$svc.Name}}Server, error) {
 	if !strings.HasPrefix(instance, "http") {
 		instance = "http://" + instance
 	}
@@ -143,40 +241,154 @@ func New(instance string, options ...transport.ClientOption) (pb.{{.Service.Name
 		return nil, err
 	}
 	_ = u
-	{{if not .HTTPHelper.Methods -}}
+	{{if not $svc.HTTPHelper.Methods -}}
 		panic("No HTTP Endpoints, this client will not work, define bindings in your proto definition")
 	{{- end}}
-	{{range $method := .HTTPHelper.Methods}}
-		{{ if $method.Bindings -}}
-			{{ with $binding := index $method.Bindings 0 -}}
-				var {{$binding.Label}}Endpoint endpoint.Endpoint
-				{
-					{{$binding.Label}}Endpoint = transport.NewClient(
-						"{{$binding.Method | ToUpper}}",
-						copyURL(u, "{{$binding.BasePath}}"),
-						EncodeHTTP{{$binding.Label}}Request,
-						DecodeHTTP{{$method.Name}}Response,
-						options...,
-					).Endpoint()
-				}
-			{{- end}}
+	{{- range $method := $svc.HTTPHelper.Methods}}
+		{{- if and $method.Bindings (not $method.ServerStream)}}
+			{{- template "endpoint" $method}}
 		{{- end}}
 	{{- end}}
-	return svc.Endpoints{
-	{{range $method := .HTTPHelper.Methods -}}
-		{{ if $method.Bindings -}}
-			{{ with $binding := index $method.Bindings 0 -}}
-				{{$method.Name}}Endpoint:    {{$binding.Label}}Endpoint,
-			{{end}}
+	return svc.{{$svc.GoPrefix}}Endpoints{
+	{{range $method := $svc.HTTPHelper.Methods -}}
+		{{ if and $method.Bindings (not $method.ServerStream) -}}
+			{{$method.Name}}Endpoint:    {{$method.Prefix}}{{$method.Name}}Endpoint,
+		{{end}}
+	{{- end}}
+	}, nil
+}
+{{- if $svc.HTTPHelper.StreamingEnabled}}
+
+// {{$svc.GoPrefix}}StreamClient calls the methods{{if $svc.GoPrefix}} of the {{$svc.Name}} service{{end}} streaming the responses, which are not
+// available by the service returned by New{{$svc.GoPrefix}}.
+type {{$svc.GoPrefix}}StreamClient struct {
+	{{- range $method := $svc.HTTPHelper.Methods}}
+		{{- if $method.ServerStream}}
+			{{ToLower $method.Name}} endpoint.Endpoint
+		{{- end}}
+	{{- end}}
+}
+
+// New{{$svc.GoPrefix}}StreamClient returns a client of the streaming methods backed by an HTTP server living at the
+// remote instance, see New{{$svc.GoPrefix}}.
+func New{{$svc.GoPrefix}}StreamClient(instance string, options ...transport.ClientOption) (*{{$svc.GoPrefix}}StreamClient, error) {
+	if !strings.HasPrefix(instance, "http") {
+		instance = "http://" + instance
+	}
+	u, err := url.Parse(instance)
+	if err != nil {
+		return nil, err
+	}
+	// the body of the response is read by the stream
+	options = append(options, transport.BufferedStream(true))
+	{{- range $method := $svc.HTTPHelper.Methods}}
+		{{- if $method.ServerStream}}
+			{{- template "endpoint" $method}}
+		{{- end}}
+	{{- end}}
+	return &{{$svc.GoPrefix}}StreamClient{
+	{{- range $method := $svc.HTTPHelper.Methods}}
+		{{- if $method.ServerStream}}
+			{{ToLower $method.Name}}: {{$method.Prefix}}{{$method.Name}}Endpoint,
 		{{- end}}
 	{{- end}}
 	}, nil
 }
-func copyURL(base *url.URL, path string) *url.URL {
+{{range $method := $svc.HTTPHelper.Methods}}
+{{- if $method.ServerStream}}
+// {{$method.Name}} opens the stream of the method {{$method.Name}}, the stream has to be closed.
+func (c *{{$svc.GoPrefix}}StreamClient) {{$method.Name}}(ctx context.Context, in *{{$method.RequestType}}) (*Stream[*{{$method.ResponseType}}], error) {
+	stream, err := c.{{ToLower $method.Name}}(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	return stream.(*Stream[*{{$method.ResponseType}}]), nil
+}
+{{end}}
+{{- end}}
+{{- end}}
+{{- end}}
+// formatWellKnown returns the canonical JSON form of a well-known type without quotes, e.g. ` + "`1.5s`" + ` of a Duration
+func formatWellKnown(m proto.Message) (string, error) {
+	raw, err := protojson.Marshal(m)
+	if err != nil {
+		return "", err
+	}
+	if value, err := strconv.Unquote(string(raw)); err == nil {
+		return value, nil
+	}
+	return string(raw), nil
+}
+// formatWellKnownPath returns the canonical JSON form of a well-known type used in the path
+func formatWellKnownPath(m proto.Message) string {
+	value, _ := formatWellKnown(m)
+	return value
+}
+// copyURL returns a copy of the URL of the instance, the path of the binding is appended by the encoder
+func copyURL(base *url.URL) *url.URL {
 	next := *base
-	next.Path = path
 	return &next
 }
+// joinPath appends the path of the binding to the path of the instance
+func joinPath(u *url.URL, path string) error {
+	next, err := url.Parse(path)
+	if err != nil {
+		return errors.Wrapf(err, "couldn't unmarshal path %q", path)
+	}
+	u.RawPath = strings.TrimSuffix(u.EscapedPath(), "/") + next.EscapedPath()
+	u.Path = strings.TrimSuffix(u.Path, "/") + next.Path
+	return nil
+}
+// marshalField encodes the value of the field like marshaler encodes it as part of the message. The value is copied
+// into an empty message, an unpopulated field is encoded by its default (e.g. 0, [] or null).
+func marshalField(msg proto.Message, name string) ([]byte, error) {
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil {
+		return nil, errors.Errorf("field %q not found", name)
+	}
+	field := m.New()
+	options := marshaler
+	if m.Has(fd) {
+		field.Set(fd, m.Get(fd))
+	} else {
+		// the other fields of the empty message are unpopulated as well, only their defaults are written
+		options.EmitUnpopulated = true
+	}
+	raw, err := options.Marshal(field.Interface())
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	if value, ok := fields[string(fd.Name())]; ok {
+		return value, nil
+	}
+	// unpopulated members of oneofs are never written
+	return []byte("null"), nil
+}
+// decodeResponse reads the JSON of the response into resp, field is the response_body of the binding.
+// If the response has a non-200 status code, the error is decoded from the body.
+func decodeResponse(r *http.Response, field string, resp proto.Message) error {
+	defer r.Body.Close()
+	buf, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return errors.Wrap(err, "cannot read http body")
+	}
+	if r.StatusCode != http.StatusOK {
+		return exception.Decode(r.StatusCode, buf)
+	}
+	if field != "" {
+		// the body is the field of the response
+		buf = append(append([]byte("{\""+field+"\":"), buf...), '}')
+	}
+	if err = unmarshaler.Unmarshal(buf, resp); err != nil {
+		return errors.Wrap(err, "cannot decode http body")
+	}
+	return nil
+}
 // CtxValuesToSend configures the http client to pull the specified keys out of
 // the context and add them to the http request as headers.  Note that keys
 // will have net/http.CanonicalHeaderKey called on them before being send over
@@ -192,49 +404,131 @@ func CtxValuesToSend(keys ...string) transport.ClientOption {
 	})
 }
 // HTTP Client Decode
-{{range $method := .HTTPHelper.Methods}}
-	// DecodeHTTP{{$method.Name}}Response is a transport/http.DecodeResponseFunc that decodes
-	// a JSON-encoded {{GoName $method.ResponseType}} response from the HTTP response body.
-	// If the response has a non-200 status code, we will interpret that as an
-	// error and attempt to decode the specific error message from the response
-	// body. Primarily useful in a client.
-	func DecodeHTTP{{$method.Name}}Response(_ context.Context, r *http.Response) (interface{}, error) {
-		defer r.Body.Close()
-		buf, err := ioutil.ReadAll(r.Body)
-		if err == io.EOF {
-			return nil, errors.New("response http body empty")
-		}
-		if err != nil {
-			return nil, errors.Wrap(err, "cannot read http body")
-		}
+{{range $svc := .Services}}
+{{range $method := $svc.HTTPHelper.Methods}}
+	{{- if $method.ServerStream}}
+	// DecodeHTTP{{$method.Prefix}}{{$method.Name}}Response is a transport/http.DecodeResponseFunc that returns
+	// a Stream reading the {{$method.ResponseType}} responses from the HTTP response body.
+	// If the response has a non-200 status code, the error is decoded from
+	// the body. Primarily useful in a client.
+	func DecodeHTTP{{$method.Prefix}}{{$method.Name}}Response(_ context.Context, r *http.Response) (interface{}, error) {
 		if r.StatusCode != http.StatusOK {
-			return nil, errors.Wrapf(errorDecoder(buf), "status code: '%d'", r.StatusCode)
+			defer r.Body.Close()
+			buf, err := ioutil.ReadAll(r.Body)
+			if err != nil {
+				return nil, errors.Wrap(err, "cannot read http body")
+			}
+			return nil, exception.Decode(r.StatusCode, buf)
 		}
-		var resp pb.{{GoName $method.ResponseType}}
-		if err = jsonpb.UnmarshalString(string(buf), &resp); err != nil {
-			return nil, errorDecoder(buf)
+		return newStream(r.Body, func() *{{$method.ResponseType}} {
+			return new({{$method.ResponseType}})
+		}), nil
+	}
+	{{- else}}
+	{{- range $binding := $method.Bindings}}
+	// DecodeHTTP{{$binding.Label}}Response is a transport/http.DecodeResponseFunc that decodes
+	// a JSON-encoded {{$method.ResponseType}} response of the binding "{{$binding.Method | ToUpper}} {{$binding.PathTemplate}}"
+	// from the HTTP response body. If the response has a non-200 status code, the
+	// error written by the server is decoded from the body, see exception.Decode.
+	// Primarily useful in a client.
+	func DecodeHTTP{{$binding.Label}}Response(_ context.Context, r *http.Response) (interface{}, error) {
+		var resp {{$method.ResponseType}}
+		if err := decodeResponse(r, "{{$binding.ResponseBody}}", &resp); err != nil {
+			return nil, err
 		}
 		return &resp, nil
 	}
+	{{- end}}
+	// DecodeHTTP{{$method.Prefix}}{{$method.Name}}Response decodes the response of the first binding of {{$method.Name}},
+	// see DecodeHTTP{{(index $method.Bindings 0).Label}}Response.
+	func DecodeHTTP{{$method.Prefix}}{{$method.Name}}Response(ctx context.Context, r *http.Response) (interface{}, error) {
+		return DecodeHTTP{{(index $method.Bindings 0).Label}}Response(ctx, r)
+	}
+	{{- end}}
+{{end}}
 {{end}}
 // HTTP Client Encode
-{{range $method := .HTTPHelper.Methods}}
+{{range $svc := .Services}}
+{{range $method := $svc.HTTPHelper.Methods}}
 	{{range $binding := $method.Bindings}}
 		{{$binding.GenClientEncode}}
 	{{end}}
 {{end}}
-func errorDecoder(buf []byte) error {
-	var w errorWrapper
-	if err := json.Unmarshal(buf, &w); err != nil {
-		const size = 8196
-		if len(buf) > size {
-			buf = buf[:size]
+{{end}}
+{{- if .HTTPStreamingEnabled}}
+
+// Stream reads the messages of a server stream sent as newline-delimited JSON, it has to be closed to release the
+// connection.
+//
+//	defer stream.Close()
+//	for stream.Next() {
+//		msg := stream.Msg()
+//	}
+//	if err := stream.Err(); err != nil {
+//	}
+type Stream[T proto.Message] struct {
+	body   io.ReadCloser
+	reader *bufio.Reader
+	newMsg func() T
+	msg    T
+	err    error
+}
+
+func newStream[T proto.Message](body io.ReadCloser, newMsg func() T) *Stream[T] {
+	return &Stream[T]{
+		body:   body,
+		reader: bufio.NewReader(body),
+		newMsg: newMsg,
+	}
+}
+
+// Next reads the next message, it returns false at the end of the stream or on error
+func (s *Stream[T]) Next() bool {
+	if s.err != nil {
+		return false
+	}
+	for {
+		line, err := s.reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(line)) > 0 {
+			var item struct {
+				Result json.RawMessage ` + "`" + `json:"result"` + "`" + `
+				Error  *string         ` + "`" + `json:"error"` + "`" + `
+			}
+			if s.err = json.Unmarshal(line, &item); s.err != nil {
+				return false
+			}
+			if item.Error != nil {
+				s.err = errors.New(*item.Error)
+				return false
+			}
+			s.msg = s.newMsg()
+			if s.err = unmarshaler.Unmarshal(item.Result, s.msg); s.err != nil {
+				return false
+			}
+			return true
+		}
+		if err != nil {
+			if err != io.EOF {
+				s.err = err
+			}
+			return false
 		}
-		return fmt.Errorf("response body '%s': cannot parse non-json request body", buf)
 	}
-	return errors.New(w.Error)
 }
-type errorWrapper struct {
-	Error string ` + "`" + `json:"error"` + "`" + `
+
+// Msg returns the message read by Next
+func (s *Stream[T]) Msg() T {
+	return s.msg
+}
+
+// Err returns the error which ended the stream, nil at the end of the stream
+func (s *Stream[T]) Err() error {
+	return s.err
+}
+
+// Close closes the connection
+func (s *Stream[T]) Close() error {
+	return s.body.Close()
 }
+{{- end}}
 `
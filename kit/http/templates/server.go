@@ -9,13 +9,19 @@ var ServerDecodeTemplate = `
 	// body. Primarily useful in a server.
 	func DecodeHTTP{{$binding.Label}}Request(_ context.Context, r *http.Request) (interface{}, error) {
 		defer r.Body.Close()
-		var req pb.{{GoName $binding.Parent.RequestType}}
+		var req {{$binding.Parent.RequestType}}
 		buf, err := ioutil.ReadAll(r.Body)
 		if err != nil {
 			return nil, errors.Wrapf(err, "cannot read body of http request")
 		}
 		if len(buf) > 0 {
-			if err = unmarshaler.Unmarshal(buf, &req); err != nil {
+			{{- if $binding.BodyField}}
+				// the body is mapped to the field {{$binding.Body}}
+				err = unmarshaler.Unmarshal(bodyField("{{$binding.Body}}", buf), &req)
+			{{- else}}
+				err = unmarshaler.Unmarshal(buf, &req)
+			{{- end}}
+			if err != nil {
 				const size = 8196
 				if len(buf) > size {
 					buf = buf[:size]
@@ -26,7 +32,7 @@ var ServerDecodeTemplate = `
 				}
 			}
 		}
-		pathParams := encodePathParams(mux.Vars(r))
+		pathParams := mux.Vars(r)
 		_ = pathParams
 		queryParams := r.URL.Query()
 		_ = queryParams
@@ -57,7 +63,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	{{- if .HTTPHelper.CompressionEnabled}}
+	{{- if .HTTPStreamingEnabled}}
+		"github.com/go-kit/kit/endpoint"
+		"github.com/niiigoo/hawk/pkg/middleware"
+		"google.golang.org/grpc/metadata"
+		"io"
+	{{- end}}
+	{{- if .CompressionEnabled}}
 		"github.com/CAFxX/httpcompression"
 	{{- end}}
 	transport "github.com/go-kit/kit/transport/http"
@@ -66,29 +78,44 @@ import (
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/encoding/protojson"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"io/ioutil"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
-	{{- if .HTTPHelper.QueryWithTime}}
-		"google.golang.org/protobuf/types/known/timestamppb"
-		"time"
+	{{- range .WellKnownImports}}
+		"{{.}}"
 	{{- end}}
 
 	// This service
 	pb "{{.PBImportPath -}}"
+	{{- range .GoImports}}
+		{{.Alias}} "{{.Path}}"
+	{{- end}}
 )
 const contentType = "application/json; charset=utf-8"
 var (
 	marshaler = protojson.MarshalOptions{UseProtoNames: true}
 	unmarshaler = protojson.UnmarshalOptions{DiscardUnknown: true}
 )
-// MakeHTTPHandler returns a handler that makes a set of endpoints available on predefined paths.
-func MakeHTTPHandler(logger *logrus.Entry, endpoints Endpoints, responseEncoder transport.EncodeResponseFunc, wsCfg WebSocketConfig, options ...transport.ServerOption) http.Handler {
+var (
+	_ = fmt.Sprint
+	_ = strconv.Itoa
+)
+{{- range $svc := .Services}}
+// Make{{$svc.GoPrefix}}HTTPHandler returns a handler that makes a set of endpoints available on predefined paths.
+func Make{{$svc.GoPrefix}}HTTPHandler(logger *logrus.Entry, endpoints {{$svc.GoPrefix}}Endpoints, responseEncoder transport.EncodeResponseFunc, wsCfg WebSocketConfig, options ...transport.ServerOption) http.Handler {
+	m := mux.NewRouter()
+	Register{{$svc.GoPrefix}}HTTPHandler(m, logger, endpoints, responseEncoder, wsCfg, options...)
+	return m
+}
+// Register{{$svc.GoPrefix}}HTTPHandler adds the paths of the {{$svc.Name}} endpoints to the router.
+func Register{{$svc.GoPrefix}}HTTPHandler(m *mux.Router, logger *logrus.Entry, endpoints {{$svc.GoPrefix}}Endpoints, responseEncoder transport.EncodeResponseFunc, wsCfg WebSocketConfig, options ...transport.ServerOption) {
 	if responseEncoder == nil {
 		responseEncoder = EncodeHTTPGenericResponse
 	}
-	{{- if .HTTPHelper.Methods}}
+	{{- if $svc.HTTPHelper.Methods}}
 		serverOptions := []transport.ServerOption{
 			transport.ServerBefore(headersToContext),
 			transport.ServerErrorEncoder(errorEncoder),
@@ -96,32 +123,40 @@ func MakeHTTPHandler(logger *logrus.Entry, endpoints Endpoints, responseEncoder
 		}
 		serverOptions = append(serverOptions, options...)
 	{{- end }}
-	{{- if .HTTPHelper.CompressionEnabled}}
+	{{- if $svc.HTTPHelper.CompressionEnabled}}
 		compress, _ := httpcompression.DefaultAdapter()
 	{{- end}}
-	m := mux.NewRouter()
 
-	{{- if .HTTPHelper.Service.WSPath}}
-		wsPool := NewPool(logger, endpoints, wsCfg)
-		m.Handle("{{.HTTPHelper.Service.WSPath}}", wsPool)
+	{{- if $svc.WSPath}}
+		wsPool := New{{$svc.GoPrefix}}Pool(logger, endpoints, wsCfg)
+		m.Handle("{{$svc.WSPath}}", wsPool)
 	{{- end}}
 
-	{{range $method := .HTTPHelper.Methods}}
-		{{range $binding := $method.Bindings}}
+	{{range $binding := $svc.HTTPHelper.Routes}}
+		{{with $method := $binding.Parent}}
 			if endpoints.HasHttpHandlerFunc("{{$method.Name}}") {
-				m.Methods("{{$binding.Method | ToUpper}}").Path("{{$binding.PathTemplate}}").HandlerFunc(endpoints.GetHttpHandlerFunc("{{$method.Name}}"))
+				m.Methods("{{$binding.Method | ToUpper}}").Path({{printf "%q" $binding.PathTemplate}}).HandlerFunc(endpoints.GetHttpHandlerFunc("{{$method.Name}}"))
 			} else {
-				m.Methods("{{$binding.Method | ToUpper}}").Path("{{$binding.PathTemplate}}").Handler({{ if $method.Compressed }}compress{{ end }}(transport.NewServer(
+			{{- if $method.ServerStream}}
+				m.Methods("{{$binding.Method | ToUpper}}").Path({{printf "%q" $binding.PathTemplate}}).Handler({{ if $method.Compressed }}compress{{ end }}(httpStreamHandler(transport.NewServer(
+					httpStreamEndpoint(endpoints.{{$method.Name}}Endpoint),
+					endpoints.GetHttpRequestDecoder("{{$method.Name}}", DecodeHTTP{{$binding.Label}}Request),
+					encodeHTTPStreamResponse,
+					append(serverOptions, endpoints.GetHttpServerOptions("{{$method.Name}}")...)...,
+				))))
+			{{- else}}
+				m.Methods("{{$binding.Method | ToUpper}}").Path({{printf "%q" $binding.PathTemplate}}).Handler({{ if $method.Compressed }}compress{{ end }}(transport.NewServer(
 					endpoints.{{$method.Name}}Endpoint,
 					endpoints.GetHttpRequestDecoder("{{$method.Name}}", DecodeHTTP{{$binding.Label}}Request),
-					endpoints.GetHttpResponseEncoder("{{$method.Name}}", responseEncoder),
+					endpoints.GetHttpResponseEncoder("{{$method.Name}}", {{if $binding.ResponseBody}}EncodeHTTPResponseBody("{{$binding.ResponseBody}}"){{else}}responseEncoder{{end}}),
 					append(serverOptions, endpoints.GetHttpServerOptions("{{$method.Name}}")...)...,
 				)))
+			{{- end}}
 			}
 		{{- end}}
 	{{- end}}
-	return m
 }
+{{- end}}
 // ErrorEncoder writes the error to the ResponseWriter, by default a content
 // type of application/json, a body of json with key "error" and the value
 // error.Error(), and a status code of 500. If the error implements Headerer,
@@ -166,9 +201,11 @@ func (h httpError) Headers() http.Header {
 	return h.headers
 }
 // Server Decode
-{{range $method := .HTTPHelper.Methods}}
-	{{range $binding := $method.Bindings}}
-		{{$binding.GenServerDecode}}
+{{range $svc := .Services}}
+	{{range $method := $svc.HTTPHelper.Methods}}
+		{{range $binding := $method.Bindings}}
+			{{$binding.GenServerDecode}}
+		{{end}}
 	{{end}}
 {{end}}
 // EncodeHTTPGenericResponse is a transport/http.EncodeResponseFunc that encodes
@@ -181,6 +218,50 @@ func EncodeHTTPGenericResponse(_ context.Context, w http.ResponseWriter, respons
 	_, err = w.Write(raw)
 	return err
 }
+// EncodeHTTPResponseBody returns a transport/http.EncodeResponseFunc that encodes
+// only the field of the response as JSON, used by bindings having a response_body.
+// The response encoder of the server is not applied to these bindings.
+func EncodeHTTPResponseBody(field string) transport.EncodeResponseFunc {
+	return func(_ context.Context, w http.ResponseWriter, response interface{}) error {
+		raw, err := marshalField(response.(proto.Message), field)
+		if err != nil {
+			return err
+		}
+		w.Header().Set("Content-Type", contentType)
+		_, err = w.Write(raw)
+		return err
+	}
+}
+// marshalField encodes the value of the field like marshaler encodes it as part of the message. The value is copied
+// into an empty message, an unpopulated field is encoded by its default (e.g. 0, [] or null).
+func marshalField(msg proto.Message, name string) ([]byte, error) {
+	m := msg.ProtoReflect()
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil {
+		return nil, errors.Errorf("field %q not found", name)
+	}
+	field := m.New()
+	options := marshaler
+	if m.Has(fd) {
+		field.Set(fd, m.Get(fd))
+	} else {
+		// the other fields of the empty message are unpopulated as well, only their defaults are written
+		options.EmitUnpopulated = true
+	}
+	raw, err := options.Marshal(field.Interface())
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(raw, &fields); err != nil {
+		return nil, err
+	}
+	if value, ok := fields[string(fd.Name())]; ok {
+		return value, nil
+	}
+	// unpopulated members of oneofs are never written
+	return []byte("null"), nil
+}
 // Helper functions
 func headersToContext(ctx context.Context, r *http.Request) context.Context {
 	for k := range r.Header {
@@ -198,7 +279,193 @@ func headersToContext(ctx context.Context, r *http.Request) context.Context {
 	return ctx
 }
 
+// bodyField wraps the body into a JSON object to unmarshal it into the field of the request
+func bodyField(name string, body []byte) []byte {
+	buf := make([]byte, 0, len(body)+len(name)+4)
+	buf = append(buf, '{', '"')
+	buf = append(buf, name...)
+	buf = append(buf, '"', ':')
+	buf = append(buf, body...)
+	return append(buf, '}')
+}
+
+// parseEnum parses the value of an enum by its name or number, unknown values are rejected
+// with a list of the allowed names
+func parseEnum(field, value string, values map[string]int32) (int32, error) {
+	if v, ok := values[value]; ok {
+		return v, nil
+	}
+	if number, err := strconv.ParseInt(value, 10, 32); err == nil {
+		for _, v := range values {
+			if v == int32(number) {
+				return v, nil
+			}
+		}
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if values[names[i]] == values[names[j]] {
+			return names[i] < names[j]
+		}
+		return values[names[i]] < values[names[j]]
+	})
+	return 0, httpError{errors.Errorf("invalid value %q of %s, allowed values: %s", value, field, strings.Join(names, ", ")),
+		http.StatusBadRequest,
+		nil,
+	}
+}
+
+// unmarshalWellKnown parses the canonical JSON form of a well-known type, e.g. ` + "`1.5s`" + ` of a Duration
+func unmarshalWellKnown[M proto.Message](value string, quoted bool, m M) (M, error) {
+	if quoted {
+		value = strconv.Quote(value)
+	}
+	return m, unmarshaler.Unmarshal([]byte(value), m)
+}
+
 func ref[T any](x T) *T {
 	return &x
 }
+{{- if .HTTPStreamingEnabled}}
+
+const (
+	eventStreamContentType = "text/event-stream"
+	ndjsonContentType      = "application/x-ndjson"
+)
+
+// httpStreamKey is the context key of the httpStream of a request
+type httpStreamKey struct{}
+
+// httpStreamHandler stores an httpStream in the context of the request, the stream is used by httpStreamEndpoint
+// to write the responses. The server-sent events are chosen by the header Accept, newline-delimited JSON otherwise.
+func httpStreamHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		stream := &httpStream{
+			ctx: r.Context(),
+			w:   w,
+			sse: strings.Contains(r.Header.Get("Accept"), eventStreamContentType),
+		}
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), httpStreamKey{}, stream)))
+	})
+}
+
+// httpStreamEndpoint adapts a stream endpoint to the HTTP transport. An error is encoded by the error encoder of the
+// server unless a response has been written, in that case it is written to the stream. The context is canceled
+// if the client disconnects.
+func httpStreamEndpoint(next middleware.StreamEndpoint) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		stream := ctx.Value(httpStreamKey{}).(*httpStream)
+		stream.ctx = ctx
+		err := next(ctx, request, stream)
+		if err != nil {
+			if !stream.started {
+				return nil, err
+			}
+			stream.writeError(err)
+			return nil, nil
+		}
+		stream.start()
+		return nil, nil
+	}
+}
+
+// encodeHTTPStreamResponse does nothing, the responses have been written by httpStreamEndpoint
+func encodeHTTPStreamResponse(context.Context, http.ResponseWriter, interface{}) error {
+	return nil
+}
+
+// httpStream implements grpc.ServerStream on top of an HTTP response. Each message is written as server-sent event
+// (` + "`data: {...}`" + `) or as line of newline-delimited JSON (` + "`{\"result\": {...}}`" + `) and flushed. An error is written
+// as event ` + "`error`" + ` or as line ` + "`{\"error\": \"...\"}`" + `. The header metadata is written as HTTP headers.
+type httpStream struct {
+	ctx     context.Context
+	w       http.ResponseWriter
+	sse     bool
+	started bool
+	header  metadata.MD
+}
+
+func (s *httpStream) SetHeader(md metadata.MD) error {
+	if s.started {
+		return errors.New("headers already sent")
+	}
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *httpStream) SendHeader(md metadata.MD) error {
+	if err := s.SetHeader(md); err != nil {
+		return err
+	}
+	s.start()
+	return nil
+}
+
+func (s *httpStream) SetTrailer(metadata.MD) {}
+
+func (s *httpStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *httpStream) SendMsg(m interface{}) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	raw, err := marshaler.Marshal(m.(proto.Message))
+	if err != nil {
+		return err
+	}
+	s.start()
+	if s.sse {
+		_, err = fmt.Fprintf(s.w, "data: %s\n\n", raw)
+	} else {
+		_, err = fmt.Fprintf(s.w, "{\"result\":%s}\n", raw)
+	}
+	if err != nil {
+		return err
+	}
+	if err = http.NewResponseController(s.w).Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return nil
+}
+
+func (s *httpStream) RecvMsg(interface{}) error {
+	return io.EOF
+}
+
+// start writes the headers of the response once
+func (s *httpStream) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	for k, v := range s.header {
+		for _, value := range v {
+			s.w.Header().Add(k, value)
+		}
+	}
+	if s.sse {
+		s.w.Header().Set("Content-Type", eventStreamContentType)
+	} else {
+		s.w.Header().Set("Content-Type", ndjsonContentType)
+	}
+	s.w.Header().Set("Cache-Control", "no-cache")
+	s.w.WriteHeader(http.StatusOK)
+	http.NewResponseController(s.w).Flush()
+}
+
+func (s *httpStream) writeError(err error) {
+	body, _ := json.Marshal(errorWrapper{Error: err.Error()})
+	if s.sse {
+		fmt.Fprintf(s.w, "event: error\ndata: %s\n\n", body)
+	} else {
+		fmt.Fprintf(s.w, "%s\n", body)
+	}
+	http.NewResponseController(s.w).Flush()
+}
+{{- end}}
 `
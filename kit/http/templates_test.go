@@ -44,8 +44,8 @@ func TestGenClientEncode(t *testing.T) {
 	}
 	meth := &Method{
 		Name:         "Sum",
-		RequestType:  "SumRequest",
-		ResponseType: "SumReply",
+		RequestType:  "pb.SumRequest",
+		ResponseType: "pb.SumReply",
 		Bindings: []*Binding{
 			binding,
 		},
@@ -138,8 +138,8 @@ func TestGenServerDecode(t *testing.T) {
 	}
 	meth := &Method{
 		Name:         "Sum",
-		RequestType:  "SumRequest",
-		ResponseType: "SumReply",
+		RequestType:  "pb.SumRequest",
+		ResponseType: "pb.SumReply",
 		Bindings: []*Binding{
 			binding,
 		},
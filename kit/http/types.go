@@ -1,14 +1,23 @@
 package http
 
+import (
+	"github.com/niiigoo/hawk/proto"
+)
+
 // Method contains the distillation of information within a
 // proto.Method that's useful for templating http transport.
 type Method struct {
 	Name string
-	// RequestType is the name of type of the Request, e.g. *EchoRequest
+	// Prefix is the GoPrefix of the service, prepended to the generated identifiers
+	Prefix string
+	// RequestType is the Go type of the Request qualified by the alias of its package, e.g. pb.EchoRequest
 	RequestType  string
 	ResponseType string
 	Bindings     []*Binding
 	Compressed   bool
+	// ServerStream is true if the responses are streamed, they are written as server-sent events or
+	// newline-delimited JSON
+	ServerStream bool
 }
 
 // Binding contains the distillation of information within an
@@ -32,6 +41,38 @@ type Binding struct {
 	// A pointer back to the parent method of this binding. Used within some
 	// binding methods
 	Parent *Method
+	// Verb is the custom verb of the path template, e.g. `activate` of `/users/{id}:activate`
+	Verb string
+	// MultiSegment is true if the path template matches multiple segments (`**` or variables like `{name=shelves/*}`)
+	MultiSegment bool
+	// Body is the field the body of the request is mapped to, `*` for the whole request
+	Body string
+	// BodyField is the field named by Body, nil if the whole request or nothing is mapped
+	BodyField *Field
+	// ResponseBody is the field of the response written to the body, empty for the whole response
+	ResponseBody string
+}
+
+// PathPart is a literal or a variable of a segment of the path template
+type PathPart struct {
+	// Literal is the static text of the part, empty for variables
+	Literal string
+	// Variable is the path of the field as given in the template, e.g. `org.id`
+	Variable string
+	// CamelName is the path of the field within the Go request, e.g. `Org.Id`
+	CamelName string
+	// MultiSegment is true if the variable matches multiple segments, e.g. `{name=shelves/*}`
+	MultiSegment bool
+	// Field is the field of the variable, nil if the binding does not know it
+	Field *Field
+}
+
+// BindingChoice is a binding chosen by the client if the Condition holds for the request
+type BindingChoice struct {
+	Binding *Binding
+	// Condition is the Go expression checking the path fields of the request `req` are populated, empty if the
+	// binding is chosen regardless of the request
+	Condition string
 }
 
 // Field contains the distillation of information within an svcdef.Field that's
@@ -77,6 +118,20 @@ type Field struct {
 	IsOptional bool
 
 	ZeroValue string
+	// WellKnown is the well-known type of the field (e.g. `google.protobuf.Duration`), nil for other types
+	WellKnown *proto.WellKnownType
+	// IsStringMap is true for `map<string, string>`, given as `name[key]=value` in the query
+	IsStringMap bool
+	// Parents are the messages containing a nested field (e.g. `org` of `org.id`), outermost first.
+	// They are allocated before the field is set.
+	Parents []ParentField
+}
+
+// ParentField is a message containing a nested Field.
+type ParentField struct {
+	// CamelName is the path of the field within the request, e.g. "Org.Address"
+	CamelName string
+	GoType    string
 }
 
 // OneofField contains the distillation of information within an []*svcdef.Field
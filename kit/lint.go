@@ -0,0 +1,58 @@
+package kit
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/niiigoo/hawk/proto"
+	"github.com/pkg/errors"
+	"os"
+)
+
+// Formats of the issues printed by Lint
+const (
+	LintText = "text"
+	LintJSON = "json"
+)
+
+// Lint checks the proto file and its imports against the rules of hawk without generating any file or running protoc.
+// The issues are printed to stdout as `file:line:col: severity: message (rule)` or as JSON array, an error is
+// returned if one of them is an error.
+func (g generator) Lint(format string, args ...string) error {
+	if format != LintText && format != LintJSON {
+		return errors.New(fmt.Sprintf("unknown format `%s`, use `%s` or `%s`", format, LintText, LintJSON))
+	}
+
+	f, err := g.protoService.DetectFile(args...)
+	if err != nil {
+		return errors.Wrap(err, "proto file not found")
+	}
+
+	issues, err := g.protoService.Lint(f, g.includePaths()...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to lint proto file '%s'", f)
+	}
+
+	if format == LintJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		err = enc.Encode(issues)
+		if err != nil {
+			return err
+		}
+	} else {
+		for _, issue := range issues {
+			fmt.Println(issue)
+		}
+	}
+
+	count := 0
+	for _, issue := range issues {
+		if issue.Severity == proto.SeverityError {
+			count++
+		}
+	}
+	if count > 0 {
+		return errors.New(fmt.Sprintf("%d error(s) found in '%s'", count, f))
+	}
+	return nil
+}
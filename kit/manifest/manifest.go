@@ -0,0 +1,81 @@
+// Package manifest records the files written by hawk with their checksums,
+// it is used to detect hand-edited files and to remove files which are no
+// longer generated.
+package manifest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"github.com/pkg/errors"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// Path is the location of the manifest relative to the project
+const Path = ".hawk/manifest"
+
+// Manifest lists the generated files of a project
+type Manifest struct {
+	// Version of hawk which generated the files
+	Version string `json:"version"`
+	// Files maps the paths relative to the project to the SHA-256 checksums of their content
+	Files map[string]string `json:"files"`
+}
+
+// New returns an empty manifest of the hawk version
+func New(version string) *Manifest {
+	return &Manifest{
+		Version: version,
+		Files:   make(map[string]string),
+	}
+}
+
+// Load reads the manifest of the project in dir, an empty manifest is returned if it does not exist
+func Load(dir string) (*Manifest, error) {
+	data, err := os.ReadFile(filepath.Join(dir, Path))
+	if os.IsNotExist(err) {
+		return New(""), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	m := New("")
+	err = json.Unmarshal(data, m)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid manifest '%s'", Path)
+	}
+	if m.Files == nil {
+		m.Files = make(map[string]string)
+	}
+	return m, nil
+}
+
+// Render returns the JSON of the manifest, the files are sorted by path
+func (m *Manifest) Render() (io.Reader, error) {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(append(data, '\n')), nil
+}
+
+// Add records the file with the checksum of its content
+func (m *Manifest) Add(path string, content []byte) {
+	m.Files[path] = Checksum(content)
+}
+
+// Modified reports whether the content differs from the recorded file, files not recorded are not modified
+func (m *Manifest) Modified(path string, content []byte) bool {
+	sum, ok := m.Files[path]
+	return ok && sum != Checksum(content)
+}
+
+// Checksum returns the hex encoded SHA-256 checksum of the content
+func Checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
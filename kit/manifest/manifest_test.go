@@ -0,0 +1,64 @@
+package manifest
+
+import (
+	"github.com/stretchr/testify/suite"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+type ManifestTestSuite struct {
+	suite.Suite
+	dir string
+}
+
+func TestManifestTestSuite(t *testing.T) {
+	suite.Run(t, new(ManifestTestSuite))
+}
+
+func (s *ManifestTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+}
+
+func (s *ManifestTestSuite) TestLoadMissing() {
+	m, err := Load(s.dir)
+	s.Require().NoError(err)
+	s.Empty(m.Version)
+	s.NotNil(m.Files)
+	s.False(m.Modified("svc/endpoints.go", []byte("package svc\n")))
+}
+
+func (s *ManifestTestSuite) TestRoundTrip() {
+	m := New("v1.2.3")
+	m.Add("svc/endpoints.go", []byte("package svc\n"))
+	m.Add("cmd/sample/main.go", []byte("package main\n"))
+
+	r, err := m.Render()
+	s.Require().NoError(err)
+	data, err := io.ReadAll(r)
+	s.Require().NoError(err)
+	s.Contains(string(data), "\"version\": \"v1.2.3\"")
+	s.Less(strings.Index(string(data), "cmd/sample/main.go"), strings.Index(string(data), "svc/endpoints.go"))
+
+	s.Require().NoError(os.MkdirAll(filepath.Join(s.dir, ".hawk"), 0755))
+	s.Require().NoError(os.WriteFile(filepath.Join(s.dir, Path), data, 0644))
+	loaded, err := Load(s.dir)
+	s.Require().NoError(err)
+	s.Equal(m, loaded)
+
+	s.False(loaded.Modified("svc/endpoints.go", []byte("package svc\n")))
+	s.True(loaded.Modified("svc/endpoints.go", []byte("package svc\n// edited\n")))
+}
+
+func (s *ManifestTestSuite) TestInvalid() {
+	s.Require().NoError(os.MkdirAll(filepath.Join(s.dir, ".hawk"), 0755))
+	s.Require().NoError(os.WriteFile(filepath.Join(s.dir, Path), []byte("{"), 0644))
+	_, err := Load(s.dir)
+	s.ErrorContains(err, Path)
+}
+
+func (s *ManifestTestSuite) TestChecksum() {
+	s.Equal("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", Checksum(nil))
+}
@@ -0,0 +1,569 @@
+// Package openapi generates an OpenAPI specification describing the HTTP
+// transport of the services defined in a proto definition.
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/niiigoo/hawk/kit/http"
+	"github.com/niiigoo/hawk/proto"
+	pio "github.com/niiigoo/hawk/proto/io"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
+	"io"
+	"strings"
+)
+
+const (
+	contentType = "application/json"
+	// streamContentType and ndjsonContentType are the content types of server streams
+	streamContentType = "text/event-stream"
+	ndjsonContentType = "application/x-ndjson"
+	errorSchema       = "Error"
+	schemaRef         = "#/components/schemas/"
+)
+
+// scalars maps the proto scalars to their JSON representation (see protojson)
+var scalars = map[pio.Scalar]Schema{
+	pio.Double:   {Type: "number", Format: "double"},
+	pio.Float:    {Type: "number", Format: "float"},
+	pio.Int32:    {Type: "integer", Format: "int32"},
+	pio.Int64:    {Type: "string", Format: "int64"},
+	pio.Uint32:   {Type: "integer", Format: "int64"},
+	pio.Uint64:   {Type: "string", Format: "uint64"},
+	pio.Sint32:   {Type: "integer", Format: "int32"},
+	pio.Sint64:   {Type: "string", Format: "int64"},
+	pio.Fixed32:  {Type: "integer", Format: "int64"},
+	pio.Fixed64:  {Type: "string", Format: "uint64"},
+	pio.SFixed32: {Type: "integer", Format: "int32"},
+	pio.SFixed64: {Type: "string", Format: "int64"},
+	pio.Bool:     {Type: "boolean"},
+	pio.String:   {Type: "string"},
+	pio.Bytes:    {Type: "string", Format: "byte"},
+}
+
+// wellKnownTypes maps the well-known types to their JSON representation (see protojson)
+var wellKnownTypes = map[string]Schema{
+	"google.protobuf.Timestamp":   {Type: "string", Format: "date-time"},
+	"google.protobuf.Duration":    {Type: "string", Description: "Duration in seconds with up to nine fractional digits, suffixed with `s`, e.g. `1.5s`."},
+	"google.protobuf.FieldMask":   {Type: "string", Description: "Comma-separated list of field paths, e.g. `name,address.city`."},
+	"google.protobuf.Empty":       {Type: "object"},
+	"google.protobuf.Struct":      {Type: "object"},
+	"google.protobuf.Value":       {Description: "Any JSON value."},
+	"google.protobuf.ListValue":   {Type: "array", Items: &Schema{}},
+	"google.protobuf.Any":         {Type: "object", Description: "Any message, the type is given by `@type`."},
+	"google.protobuf.DoubleValue": {Type: "number", Format: "double", Nullable: true},
+	"google.protobuf.FloatValue":  {Type: "number", Format: "float", Nullable: true},
+	"google.protobuf.Int64Value":  {Type: "string", Format: "int64", Nullable: true},
+	"google.protobuf.UInt64Value": {Type: "string", Format: "uint64", Nullable: true},
+	"google.protobuf.Int32Value":  {Type: "integer", Format: "int32", Nullable: true},
+	"google.protobuf.UInt32Value": {Type: "integer", Format: "int64", Nullable: true},
+	"google.protobuf.BoolValue":   {Type: "boolean", Nullable: true},
+	"google.protobuf.StringValue": {Type: "string", Nullable: true},
+	"google.protobuf.BytesValue":  {Type: "string", Format: "byte", Nullable: true},
+}
+
+type generator struct {
+	def *proto.Definition
+	doc *Document
+	// imported contains the referenced nested types and types of imported files,
+	// their schemas are added after the top-level types of the file
+	imported *[]*proto.Symbol
+	seen     map[string]bool
+}
+
+// NewDocument creates the OpenAPI document of all services of the definition.
+// Only methods having an HTTP binding are part of the document.
+func NewDocument(def *proto.Definition, info Info) (*Document, error) {
+	if info.Title == "" {
+		info.Title = def.Package()
+		if len(def.Services) > 0 {
+			info.Title = def.Services[0].Name
+		}
+	}
+	if info.Version == "" {
+		info.Version = "1.0.0"
+	}
+
+	g := generator{
+		def:      def,
+		imported: &[]*proto.Symbol{},
+		seen:     make(map[string]bool),
+		doc: &Document{
+			OpenAPI: Version,
+			Info:    info,
+			Tags:    make([]*Tag, 0),
+			Paths:   NewOrderedMap[*PathItem](),
+			Components: Components{
+				Schemas: NewOrderedMap[*Schema](),
+			},
+		},
+	}
+
+	for _, svc := range def.Services {
+		g.doc.Tags = append(g.doc.Tags, &Tag{Name: svc.Name, Description: svc.Comments.String()})
+		for _, m := range svc.Methods {
+			for i, binding := range m.HttpBindings {
+				err := g.addOperation(m, i, binding)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+	}
+
+	for _, msg := range def.Messages() {
+		g.doc.Components.Schemas.Set(msg.Name, g.messageSchema(qualifiedName(def.Package(), msg.Name), msg))
+	}
+	for _, enum := range def.Enums() {
+		g.doc.Components.Schemas.Set(enum.Name, enumSchema(enum))
+	}
+	// the schemas of these types may reference further ones
+	for i := 0; i < len(*g.imported); i++ {
+		sym := (*g.imported)[i]
+		if sym.Message != nil {
+			g.doc.Components.Schemas.Set(g.schemaName(sym), g.messageSchema(sym.FullName, sym.Message))
+		} else {
+			g.doc.Components.Schemas.Set(g.schemaName(sym), enumSchema(sym.Enum))
+		}
+	}
+	g.doc.Components.Schemas.Set(errorSchema, exceptionSchema())
+
+	return g.doc, nil
+}
+
+// Render encodes the document as YAML
+func (d *Document) Render() (io.Reader, error) {
+	buf := bytes.NewBuffer(nil)
+	enc := yaml.NewEncoder(buf)
+	enc.SetIndent(2)
+	err := enc.Encode(d)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode OpenAPI document")
+	}
+	err = enc.Close()
+	if err != nil {
+		return nil, err
+	}
+	return buf, nil
+}
+
+// Path translates the path template of the binding to an OpenAPI path including the prefix of the service.
+// Variables become plain parameters (e.g. `{name=people/*}` becomes `{name}`), wildcards are named like the variables
+// of gorilla/mux (see proto.OptionHttp.GorillaMuxPath).
+func Path(b *proto.OptionHttp) string {
+	var path string
+	if b.Parent != nil && b.Parent.Parent != nil {
+		path = strings.TrimSuffix(b.Parent.Parent.HttpPrefix, "/")
+	}
+	for i, segment := range b.Path.Segments {
+		path += "/"
+		if segment.Literal != nil {
+			path += *segment.Literal
+		} else if segment.Wildcard != nil {
+			path += "{" + wildcardName(i, *segment.Wildcard) + "}"
+		} else if segment.Variable != nil {
+			path += "{" + segment.Variable.Field + "}"
+		}
+	}
+	if b.Path.Verb != nil {
+		path += ":" + *b.Path.Verb
+	}
+	return path
+}
+
+// wildcardName returns the name of the parameter of the wildcard segment at the index
+func wildcardName(i int, wildcard string) string {
+	if wildcard == "**" {
+		return fmt.Sprintf("_wildcards%d", i)
+	}
+	return fmt.Sprintf("_wildcard%d", i)
+}
+
+// wildcardParameters describes the wildcard segments of the path, their values are ignored by the service
+func wildcardParameters(path *pio.Path) []*Parameter {
+	result := make([]*Parameter, 0)
+	for i, segment := range path.Segments {
+		if segment.Wildcard == nil {
+			continue
+		}
+		p := &Parameter{
+			Name:        wildcardName(i, *segment.Wildcard),
+			In:          "path",
+			Description: "Matches a single segment, the value is ignored.",
+			Required:    true,
+			Schema:      &Schema{Type: "string"},
+		}
+		if *segment.Wildcard == "**" {
+			p.Description = "Matches any number of segments including their slashes, the value is ignored."
+		}
+		result = append(result, p)
+	}
+	return result
+}
+
+func (g generator) addOperation(m *proto.Method, i int, b *proto.OptionHttp) error {
+	path := Path(b)
+	item, ok := g.doc.Paths.Get(path)
+	if !ok {
+		item = &PathItem{}
+	}
+	method := strings.ToLower(b.Method)
+	op := item.operation(method)
+	if op == nil {
+		log.Warnf("HTTP method `%s` of %s is not supported by OpenAPI, skipping binding", b.Method, m.Name)
+		return nil
+	}
+	if *op != nil {
+		return errors.New(fmt.Sprintf("duplicate route `%s %s` (methods `%s`, `%s`)", strings.ToUpper(method), path, (*op).OperationID, m.Name))
+	}
+
+	operation := &Operation{
+		Tags:        []string{m.Parent.Name},
+		Description: m.Comments.String(),
+		OperationID: m.Parent.GoPrefix + m.Name + http.EnglishNumber(i),
+		Parameters:  wildcardParameters(b.Path),
+		Responses:   NewOrderedMap[*Response](),
+	}
+	if i == 0 {
+		operation.OperationID = m.Parent.GoPrefix + m.Name
+	}
+
+	for _, param := range b.Params {
+		switch param.Location {
+		case proto.LocationPath:
+			p := &Parameter{
+				Name:     param.FieldPath(),
+				In:       "path",
+				Required: true,
+				Schema:   g.fieldSchema(param.Scope(m), param.Field),
+			}
+			p.Description = description(param.Comments.String(), variableDescription(b.Path, param.FieldPath()))
+			operation.Parameters = append(operation.Parameters, p)
+		case proto.LocationBody:
+			operation.RequestBody = &RequestBody{
+				Required: true,
+				Content: map[string]*MediaType{
+					contentType: {Schema: g.fieldSchema(m.RequestType.FullName, param.Field)},
+				},
+			}
+		case proto.LocationQuery:
+			if param.Type == proto.TypeOneOf {
+				names := oneOfNames(m.RequestType.Message, param.Name)
+				for _, name := range names {
+					p := g.queryParameter(m.RequestType.FullName, param.OneOfFields[name])
+					p.Description = description(p.Description, oneOfDescription(param.Name, names))
+					operation.Parameters = append(operation.Parameters, p)
+				}
+				continue
+			}
+			operation.Parameters = append(operation.Parameters, g.queryParameter(param.Scope(m), param))
+		}
+	}
+	if b.Body == "*" {
+		operation.RequestBody = &RequestBody{
+			Required: true,
+			Content: map[string]*MediaType{
+				contentType: {Schema: g.referenceSchema(g.def.Package(), m.Request)},
+			},
+		}
+	}
+
+	response := g.referenceSchema(g.def.Package(), m.Response)
+	if b.ResponseBody != "" {
+		response = g.responseBodySchema(m.ResponseType, b.ResponseBody)
+	}
+	if m.ResponseStream {
+		operation.Responses.Set("200", streamResponse(response))
+	} else {
+		operation.Responses.Set("200", &Response{
+			Description: "A successful response.",
+			Content: map[string]*MediaType{
+				contentType: {Schema: response},
+			},
+		})
+	}
+	operation.Responses.Set("default", &Response{
+		Description: "An error response.",
+		Content: map[string]*MediaType{
+			contentType: {Schema: &Schema{Ref: schemaRef + errorSchema}},
+		},
+	})
+
+	*op = operation
+	g.doc.Paths.Set(path, item)
+	return nil
+}
+
+// streamResponse describes the responses of a server stream, written as server-sent events or newline-delimited JSON
+func streamResponse(response *Schema) *Response {
+	line := &Schema{Type: "object", Properties: NewOrderedMap[*Schema]()}
+	line.Properties.Set("result", response)
+	line.Properties.Set("error", &Schema{Type: "string"})
+	return &Response{
+		Description: "A stream of responses, chosen by the header Accept. Each server-sent event contains a response, " +
+			"an error is sent as event `error`.",
+		Content: map[string]*MediaType{
+			streamContentType: {Schema: &Schema{Type: "string"}},
+			ndjsonContentType: {Schema: line},
+		},
+	}
+}
+
+// queryParameter describes a query parameter, maps and repeated messages are expected as JSON
+func (g generator) queryParameter(scope string, param *proto.Param) *Parameter {
+	p := &Parameter{
+		Name:        param.FieldPath(),
+		In:          "query",
+		Description: param.Comments.String(),
+		Required:    param.Required,
+	}
+	schema := g.fieldSchema(scope, param.Field)
+	if param.StringMap() {
+		// e.g. `labels[key]=value`
+		p.Style = "deepObject"
+		p.Explode = true
+		p.Schema = schema
+	} else if param.Type == proto.TypeMessage && param.WellKnown() == nil || param.Type == proto.TypeMap {
+		p.Content = map[string]*MediaType{
+			contentType: {Schema: schema},
+		}
+	} else {
+		p.Schema = schema
+	}
+	return p
+}
+
+func (g generator) responseBodySchema(msg *proto.Symbol, field string) *Schema {
+	if msg == nil {
+		return &Schema{}
+	}
+	for _, entry := range msg.Message.Entries {
+		if entry.Field != nil && entry.Field.Name == field {
+			return g.fieldSchema(msg.FullName, entry.Field)
+		}
+	}
+	return &Schema{}
+}
+
+func (g generator) messageSchema(scope string, msg *pio.Message) *Schema {
+	s := &Schema{
+		Type:        "object",
+		Description: msg.Comments.String(),
+		Properties:  NewOrderedMap[*Schema](),
+	}
+	oneOfs := make([]*Schema, 0)
+	for _, entry := range msg.Entries {
+		if entry.Field != nil {
+			field := g.fieldSchema(scope, entry.Field)
+			field.Description = description(field.Description, entry.Field.Comments.String())
+			s.Properties.Set(entry.Field.Name, wrapRef(field))
+			if entry.Field.Required {
+				s.Required = append(s.Required, entry.Field.Name)
+			}
+		} else if entry.OneOf != nil {
+			names := make([]string, 0, len(entry.OneOf.Entries))
+			for _, e := range entry.OneOf.Entries {
+				if e.Field == nil {
+					continue
+				}
+				names = append(names, e.Field.Name)
+			}
+			for _, e := range entry.OneOf.Entries {
+				if e.Field == nil {
+					continue
+				}
+				field := g.fieldSchema(scope, e.Field)
+				field.Description = description(e.Field.Comments.String(), oneOfDescription(entry.OneOf.Name, names))
+				s.Properties.Set(e.Field.Name, wrapRef(field))
+			}
+			oneOfs = append(oneOfs, oneOfSchema(names))
+		}
+	}
+	// multiple oneofs have to be satisfied each
+	if len(oneOfs) == 1 {
+		s.OneOf = oneOfs[0].OneOf
+	} else if len(oneOfs) > 1 {
+		s.AllOf = oneOfs
+	}
+	return s
+}
+
+// oneOfSchema requires at most one of the fields of a oneof, each variant requires one of them while the last one
+// matches if none is set
+func oneOfSchema(names []string) *Schema {
+	s := &Schema{OneOf: make([]*Schema, 0, len(names)+1)}
+	none := &Schema{AnyOf: make([]*Schema, 0, len(names))}
+	for _, name := range names {
+		s.OneOf = append(s.OneOf, &Schema{Required: []string{name}})
+		none.AnyOf = append(none.AnyOf, &Schema{Required: []string{name}})
+	}
+	s.OneOf = append(s.OneOf, &Schema{Not: none})
+	return s
+}
+
+func (g generator) fieldSchema(scope string, field *pio.Field) *Schema {
+	s := g.typeSchema(scope, &field.Type)
+	if field.Repeated {
+		s = &Schema{
+			Type:  "array",
+			Items: s,
+		}
+	}
+	s.Example = example(proto.FieldExample(field))
+	return wrapRef(s)
+}
+
+// wrapRef moves the reference of the schema into `allOf` if it has a description or an example, the siblings of
+// `$ref` are ignored by OpenAPI 3.0
+func wrapRef(s *Schema) *Schema {
+	if s.Ref == "" || s.Description == "" && s.Example == nil {
+		return s
+	}
+	return &Schema{
+		AllOf:       []*Schema{{Ref: s.Ref}},
+		Description: s.Description,
+		Example:     s.Example,
+	}
+}
+
+// example returns the example given by the option `(hawk.v1.field)`, it is parsed as JSON if valid
+func example(value string) interface{} {
+	if value == "" {
+		return nil
+	}
+	var v interface{}
+	if err := json.Unmarshal([]byte(value), &v); err != nil {
+		return value
+	}
+	return v
+}
+
+func (g generator) typeSchema(scope string, t *pio.Type) *Schema {
+	if t.Scalar > pio.None {
+		s := scalars[t.Scalar]
+		return &s
+	}
+	if t.Map != nil {
+		return &Schema{
+			Type:                 "object",
+			AdditionalProperties: g.typeSchema(scope, t.Map.Value),
+		}
+	}
+	return g.referenceSchema(scope, t.Reference)
+}
+
+// referenceSchema references the schema of the message or enum, the name is resolved within the scope
+func (g generator) referenceSchema(scope, name string) *Schema {
+	if s, ok := wellKnownTypes[strings.TrimPrefix(name, ".")]; ok {
+		return &s
+	}
+	if sym, ok := g.def.Resolve(scope, name); ok {
+		if (sym.File != nil || sym.Nested()) && !g.seen[sym.FullName] {
+			g.seen[sym.FullName] = true
+			*g.imported = append(*g.imported, sym)
+		}
+		return &Schema{Ref: schemaRef + g.schemaName(sym)}
+	}
+	log.Warnf("type `%s` is not defined, using an arbitrary schema", name)
+	return &Schema{Description: "Unresolved type `" + name + "`."}
+}
+
+// schemaName returns the name of the symbol's schema, imported types of other packages are qualified
+func (g generator) schemaName(sym *proto.Symbol) string {
+	if sym.Package == g.def.Package() {
+		return sym.Name
+	}
+	return sym.FullName
+}
+
+func enumSchema(enum *pio.Enum) *Schema {
+	s := &Schema{
+		Type:        "string",
+		Description: enum.Comments.String(),
+		Enum:        make([]string, 0, len(enum.Values)),
+	}
+	for _, v := range enum.Values {
+		if v.Value != nil {
+			s.Enum = append(s.Enum, v.Value.Key)
+		}
+	}
+	return s
+}
+
+// exceptionSchema describes the error body written by the package `pkg/exception`
+func exceptionSchema() *Schema {
+	details := NewOrderedMap[*Schema]()
+	details.Set("message", &Schema{Type: "string"})
+	details.Set("error_id", &Schema{Type: "string", Description: "Identifier to find the error in the logs."})
+	details.Set("reasons", &Schema{Type: "object", AdditionalProperties: &Schema{Type: "string"}})
+
+	properties := NewOrderedMap[*Schema]()
+	properties.Set("error", &Schema{
+		Type:       "object",
+		Properties: details,
+		Required:   []string{"message"},
+	})
+
+	return &Schema{
+		Type:       "object",
+		Properties: properties,
+		Required:   []string{"error"},
+	}
+}
+
+// variableDescription documents the pattern a path variable has to match
+func variableDescription(path *pio.Path, field string) string {
+	for _, s := range path.Segments {
+		if s.Variable == nil || s.Variable.Field != field {
+			continue
+		}
+		if len(s.Variable.Segments) > 0 {
+			return "Has to match `" + strings.Join(s.Variable.Segments, "/") + "`."
+		}
+		if s.Variable.Pattern != nil {
+			return "Has to match `" + *s.Variable.Pattern + "`."
+		}
+	}
+	return ""
+}
+
+// oneOfNames returns the names of the oneof's fields in order of their definition
+func oneOfNames(msg *pio.Message, oneOf string) []string {
+	names := make([]string, 0)
+	for _, entry := range msg.Entries {
+		if entry.OneOf == nil || entry.OneOf.Name != oneOf {
+			continue
+		}
+		for _, e := range entry.OneOf.Entries {
+			if e.Field != nil {
+				names = append(names, e.Field.Name)
+			}
+		}
+	}
+	return names
+}
+
+func qualifiedName(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+// description joins the non-empty parts by a blank line
+func description(parts ...string) string {
+	text := make([]string, 0, len(parts))
+	for _, p := range parts {
+		if p != "" {
+			text = append(text, p)
+		}
+	}
+	return strings.Join(text, "\n\n")
+}
+
+func oneOfDescription(name string, fields []string) string {
+	return fmt.Sprintf("Part of oneof `%s`, only one of `%s` may be set.", name, strings.Join(fields, "`, `"))
+}
@@ -0,0 +1,421 @@
+package openapi
+
+import (
+	"github.com/niiigoo/hawk/proto"
+	"github.com/stretchr/testify/suite"
+	"gopkg.in/yaml.v3"
+	"io"
+	"testing"
+)
+
+const definition = `
+syntax = "proto3";
+
+package sample;
+
+service Sample {
+	option (config) = {
+		HttpPrefix: "/api/sample/"
+	};
+
+	rpc GetUser(GetUserRequest) returns (User) {
+		option (google.api.http) = {
+			get: "/users/{id}"
+			additional_bindings {
+				get: "/orgs/{org}/users/{id}"
+			}
+		};
+	}
+	rpc Search(SearchRequest) returns (SearchResponse) {
+		option (google.api.http) = {
+			post: "/users:search"
+			body: "filter"
+			response_body: "users"
+		};
+	}
+	rpc Update(User) returns (User) {
+		option (google.api.http) = {
+			put: "/users/{id}"
+			body: "*"
+		};
+	}
+}
+
+enum Role {
+	ROLE_UNKNOWN = 0;
+	ROLE_ADMIN = 1;
+}
+
+message GetUserRequest {
+	string id = 1;
+	string org = 2;
+	oneof selector {
+		string name = 3;
+		int64 number = 4;
+	}
+}
+
+message User {
+	string id = 1;
+	Role role = 2;
+	map<string, string> labels = 3;
+	google.protobuf.Timestamp created = 4;
+}
+
+message SearchRequest {
+	User filter = 1;
+	int32 limit = 2;
+}
+
+message SearchResponse {
+	repeated User users = 1;
+}
+`
+
+type OpenAPITestSuite struct {
+	suite.Suite
+	doc *Document
+}
+
+func TestOpenAPITestSuite(t *testing.T) {
+	suite.Run(t, new(OpenAPITestSuite))
+}
+
+func (s *OpenAPITestSuite) SetupTest() {
+	p := proto.NewService()
+	s.Require().NoError(p.ParseString(definition))
+
+	var err error
+	s.doc, err = NewDocument(p.Definition(), Info{})
+	s.Require().NoError(err)
+}
+
+func (s *OpenAPITestSuite) TestInfo() {
+	s.Equal(Version, s.doc.OpenAPI)
+	s.Equal("Sample", s.doc.Info.Title)
+	s.Equal("1.0.0", s.doc.Info.Version)
+}
+
+func (s *OpenAPITestSuite) TestPaths() {
+	s.Equal([]string{
+		"/api/sample/users/{id}",
+		"/api/sample/orgs/{org}/users/{id}",
+		"/api/sample/users:search",
+	}, s.doc.Paths.Keys())
+}
+
+func (s *OpenAPITestSuite) TestAdditionalBindings() {
+	item, ok := s.doc.Paths.Get("/api/sample/users/{id}")
+	s.Require().True(ok)
+	s.Require().NotNil(item.Get)
+	s.Equal("GetUser", item.Get.OperationID)
+	s.Require().NotNil(item.Put)
+	s.Equal("Update", item.Put.OperationID)
+
+	item, ok = s.doc.Paths.Get("/api/sample/orgs/{org}/users/{id}")
+	s.Require().True(ok)
+	s.Require().NotNil(item.Get)
+	s.Equal("GetUserOne", item.Get.OperationID)
+	s.Require().Len(item.Get.Parameters, 4)
+	s.Equal("org", item.Get.Parameters[0].Name)
+	s.Equal("path", item.Get.Parameters[0].In)
+	s.Equal("id", item.Get.Parameters[1].Name)
+	s.Equal("path", item.Get.Parameters[1].In)
+}
+
+func (s *OpenAPITestSuite) TestParams() {
+	item, _ := s.doc.Paths.Get("/api/sample/users/{id}")
+	op := item.Get
+
+	s.Nil(op.RequestBody)
+	s.Require().Len(op.Parameters, 4)
+	s.Equal("id", op.Parameters[0].Name)
+	s.True(op.Parameters[0].Required)
+	s.Equal("org", op.Parameters[1].Name)
+	s.Equal("query", op.Parameters[1].In)
+	s.Equal("name", op.Parameters[2].Name)
+	s.Equal("query", op.Parameters[2].In)
+	s.Contains(op.Parameters[2].Description, "`selector`")
+	s.Equal("number", op.Parameters[3].Name)
+	s.Equal("string", op.Parameters[3].Schema.Type)
+	s.Equal("int64", op.Parameters[3].Schema.Format)
+}
+
+func (s *OpenAPITestSuite) TestBody() {
+	item, _ := s.doc.Paths.Get("/api/sample/users:search")
+	op := item.Post
+
+	s.Require().NotNil(op.RequestBody)
+	s.Equal("#/components/schemas/User", op.RequestBody.Content[contentType].Schema.Ref)
+	s.Require().Len(op.Parameters, 1)
+	s.Equal("limit", op.Parameters[0].Name)
+
+	response, ok := op.Responses.Get("200")
+	s.Require().True(ok)
+	s.Equal("array", response.Content[contentType].Schema.Type)
+	s.Equal("#/components/schemas/User", response.Content[contentType].Schema.Items.Ref)
+
+	item, _ = s.doc.Paths.Get("/api/sample/users/{id}")
+	s.Equal("#/components/schemas/User", item.Put.RequestBody.Content[contentType].Schema.Ref)
+	s.Require().Len(item.Put.Parameters, 1)
+}
+
+func (s *OpenAPITestSuite) TestSchemas() {
+	s.Equal([]string{"GetUserRequest", "User", "SearchRequest", "SearchResponse", "Role", "Error"}, s.doc.Components.Schemas.Keys())
+
+	user, _ := s.doc.Components.Schemas.Get("User")
+	s.Equal([]string{"id", "role", "labels", "created"}, user.Properties.Keys())
+	role, _ := user.Properties.Get("role")
+	s.Equal("#/components/schemas/Role", role.Ref)
+	labels, _ := user.Properties.Get("labels")
+	s.Equal("string", labels.AdditionalProperties.Type)
+	created, _ := user.Properties.Get("created")
+	s.Equal("date-time", created.Format)
+
+	enum, _ := s.doc.Components.Schemas.Get("Role")
+	s.Equal([]string{"ROLE_UNKNOWN", "ROLE_ADMIN"}, enum.Enum)
+
+	req, _ := s.doc.Components.Schemas.Get("GetUserRequest")
+	s.Require().Len(req.OneOf, 3)
+	s.Equal([]string{"name"}, req.OneOf[0].Required)
+	s.Equal([]string{"number"}, req.OneOf[1].Required)
+	s.Require().NotNil(req.OneOf[2].Not)
+	s.Len(req.OneOf[2].Not.AnyOf, 2)
+}
+
+func (s *OpenAPITestSuite) TestOneOfs() {
+	p := proto.NewService()
+	s.Require().NoError(p.ParseString(`
+syntax = "proto3";
+service Sample {
+	rpc A(Req) returns (Req) { option (google.api.http) = { post: "/a" body: "*" }; }
+}
+message Req {
+	oneof a { string x = 1; string y = 2; }
+	oneof b { string z = 3; }
+}
+`))
+
+	doc, err := NewDocument(p.Definition(), Info{})
+	s.Require().NoError(err)
+	req, _ := doc.Components.Schemas.Get("Req")
+	s.Nil(req.OneOf)
+	s.Require().Len(req.AllOf, 2)
+	s.Len(req.AllOf[0].OneOf, 3)
+	s.Len(req.AllOf[1].OneOf, 2)
+
+	out, err := doc.Render()
+	s.Require().NoError(err)
+	data, err := io.ReadAll(out)
+	s.Require().NoError(err)
+	s.Contains(string(data), "oneOf:")
+	s.NotContains(string(data), "x-oneof")
+}
+
+func (s *OpenAPITestSuite) TestPathTemplates() {
+	p := proto.NewService()
+	s.Require().NoError(p.ParseString(`
+syntax = "proto3";
+service Sample {
+	rpc Get(Req) returns (Req) { option (google.api.http) = { get: "/v1/{name=people/*}" }; }
+	rpc List(Req) returns (Req) { option (google.api.http) = { get: "/v1/*/files/**" }; }
+}
+message Req { string name = 1; }
+`))
+
+	doc, err := NewDocument(p.Definition(), Info{})
+	s.Require().NoError(err)
+	s.Equal([]string{"/v1/{name}", "/v1/{_wildcard1}/files/{_wildcards3}"}, doc.Paths.Keys())
+
+	item, _ := doc.Paths.Get("/v1/{name}")
+	s.Equal("name", item.Get.Parameters[0].Name)
+	s.Equal("path", item.Get.Parameters[0].In)
+
+	item, _ = doc.Paths.Get("/v1/{_wildcard1}/files/{_wildcards3}")
+	s.Require().Len(item.Get.Parameters, 3)
+	s.Equal("_wildcard1", item.Get.Parameters[0].Name)
+	s.Equal("_wildcards3", item.Get.Parameters[1].Name)
+	s.Equal("path", item.Get.Parameters[1].In)
+	s.True(item.Get.Parameters[1].Required)
+}
+
+func (s *OpenAPITestSuite) TestExample() {
+	p := proto.NewService()
+	s.Require().NoError(p.ParseString(`
+syntax = "proto3";
+service Sample {
+	rpc A(Req) returns (Req) { option (google.api.http) = { get: "/a" }; }
+}
+message Req {
+	string id = 1 [(hawk.v1.field) = { example: "u-123" }];
+	repeated int32 sizes = 2 [(hawk.v1.field).example = "[1, 2]"];
+}
+`))
+
+	doc, err := NewDocument(p.Definition(), Info{})
+	s.Require().NoError(err)
+	req, _ := doc.Components.Schemas.Get("Req")
+	id, _ := req.Properties.Get("id")
+	s.Equal("u-123", id.Example)
+	sizes, _ := req.Properties.Get("sizes")
+	s.Equal([]interface{}{1.0, 2.0}, sizes.Example)
+}
+
+func (s *OpenAPITestSuite) TestDocumentedReference() {
+	p := proto.NewService()
+	s.Require().NoError(p.ParseString(`
+syntax = "proto3";
+service Sample {
+	rpc A(Req) returns (Req) { option (google.api.http) = { post: "/a" body: "*" }; }
+}
+message Req {
+	// Owner of the request
+	User owner = 1;
+	User editor = 2 [(hawk.v1.field).example = "{\"id\": \"u-1\"}"];
+	User viewer = 3;
+}
+message User { string id = 1; }
+`))
+
+	doc, err := NewDocument(p.Definition(), Info{})
+	s.Require().NoError(err)
+	req, _ := doc.Components.Schemas.Get("Req")
+	owner, _ := req.Properties.Get("owner")
+	s.Empty(owner.Ref)
+	s.Equal("Owner of the request", owner.Description)
+	s.Require().Len(owner.AllOf, 1)
+	s.Equal(schemaRef+"User", owner.AllOf[0].Ref)
+	editor, _ := req.Properties.Get("editor")
+	s.Empty(editor.Ref)
+	s.Equal(map[string]interface{}{"id": "u-1"}, editor.Example)
+	s.Require().Len(editor.AllOf, 1)
+	viewer, _ := req.Properties.Get("viewer")
+	s.Equal(schemaRef+"User", viewer.Ref)
+	s.Nil(viewer.AllOf)
+}
+
+func (s *OpenAPITestSuite) TestDuplicateRoute() {
+	p := proto.NewService()
+	s.Require().NoError(p.ParseString(`
+syntax = "proto3";
+service Sample {
+	rpc A(Req) returns (Req) { option (google.api.http) = { get: "/a" }; }
+	rpc B(Req) returns (Req) { option (google.api.http) = { get: "/a" }; }
+}
+message Req {}
+`))
+
+	_, err := NewDocument(p.Definition(), Info{})
+	s.ErrorContains(err, "duplicate route `GET /a`")
+}
+
+func (s *OpenAPITestSuite) TestMultipleServices() {
+	p := proto.NewService()
+	s.Require().NoError(p.ParseString(`
+syntax = "proto3";
+service UserService {
+	rpc Get(Req) returns (Req) { option (google.api.http) = { get: "/users/{id}" }; }
+}
+service AdminService {
+	rpc Get(Req) returns (Req) { option (google.api.http) = { get: "/admin/users/{id}" }; }
+}
+message Req { string id = 1; }
+`))
+
+	doc, err := NewDocument(p.Definition(), Info{})
+	s.Require().NoError(err)
+	s.Len(doc.Tags, 2)
+	item, _ := doc.Paths.Get("/users/{id}")
+	s.Equal("UserGet", item.Get.OperationID)
+	item, _ = doc.Paths.Get("/admin/users/{id}")
+	s.Equal("AdminGet", item.Get.OperationID)
+}
+
+func (s *OpenAPITestSuite) TestNestedTypes() {
+	p := proto.NewService()
+	s.Require().NoError(p.ParseString(`
+syntax = "proto3";
+package sample;
+service Sample {
+	rpc Get(Order.Lookup) returns (Order) { option (google.api.http) = { get: "/orders/{id}" }; }
+}
+message Order {
+	enum Status { STATUS_UNKNOWN = 0; }
+	message Lookup { string id = 1; }
+	message Item { Status status = 1; }
+	repeated Item items = 1;
+}
+`))
+
+	doc, err := NewDocument(p.Definition(), Info{})
+	s.Require().NoError(err)
+	s.Equal([]string{"Order", "Order.Item", "Order.Status", "Error"}, doc.Components.Schemas.Keys())
+	item, _ := doc.Components.Schemas.Get("Order.Item")
+	status, _ := item.Properties.Get("status")
+	s.Equal("#/components/schemas/Order.Status", status.Ref)
+}
+
+func (s *OpenAPITestSuite) TestQueryWellKnownAndMaps() {
+	p := proto.NewService()
+	s.Require().NoError(p.ParseString(`
+syntax = "proto3";
+service Sample {
+	rpc List(Req) returns (Req) { option (google.api.http) = { get: "/items" }; }
+}
+message Req {
+	google.protobuf.Duration timeout = 1;
+	map<string, string> labels = 2;
+}
+`))
+
+	doc, err := NewDocument(p.Definition(), Info{})
+	s.Require().NoError(err)
+	item, _ := doc.Paths.Get("/items")
+	s.Require().Len(item.Get.Parameters, 2)
+	s.Equal("string", item.Get.Parameters[0].Schema.Type)
+	s.Equal("deepObject", item.Get.Parameters[1].Style)
+	s.True(item.Get.Parameters[1].Explode)
+	s.Equal("string", item.Get.Parameters[1].Schema.AdditionalProperties.Type)
+}
+
+func (s *OpenAPITestSuite) TestServerStream() {
+	p := proto.NewService()
+	s.Require().NoError(p.ParseString(`
+syntax = "proto3";
+service Sample {
+	rpc Watch(Req) returns (stream Req) { option (google.api.http) = { get: "/items" }; }
+}
+message Req {
+	string id = 1;
+}
+`))
+
+	doc, err := NewDocument(p.Definition(), Info{})
+	s.Require().NoError(err)
+	item, _ := doc.Paths.Get("/items")
+	response, ok := item.Get.Responses.Get("200")
+	s.Require().True(ok)
+	s.Contains(response.Content, streamContentType)
+	s.Require().Contains(response.Content, ndjsonContentType)
+	result, ok := response.Content[ndjsonContentType].Schema.Properties.Get("result")
+	s.True(ok)
+	s.Equal(schemaRef+"Req", result.Ref)
+}
+
+func (s *OpenAPITestSuite) TestRender() {
+	r, err := s.doc.Render()
+	s.Require().NoError(err)
+	data, err := io.ReadAll(r)
+	s.Require().NoError(err)
+
+	var doc struct {
+		Paths      yaml.Node `yaml:"paths"`
+		Components struct {
+			Schemas map[string]interface{} `yaml:"schemas"`
+		} `yaml:"components"`
+	}
+	s.Require().NoError(yaml.Unmarshal(data, &doc))
+	s.Require().Len(doc.Paths.Content, 6)
+	s.Equal("/api/sample/users/{id}", doc.Paths.Content[0].Value)
+	s.Contains(doc.Components.Schemas, "Error")
+}
@@ -0,0 +1,189 @@
+package openapi
+
+import (
+	"gopkg.in/yaml.v3"
+)
+
+// Version of the OpenAPI specification the documents are generated for
+const Version = "3.0.3"
+
+// Document is the root object of an OpenAPI specification
+type Document struct {
+	OpenAPI    string     `yaml:"openapi"`
+	Info       Info       `yaml:"info"`
+	Tags       []*Tag     `yaml:"tags,omitempty"`
+	Paths      *Paths     `yaml:"paths"`
+	Components Components `yaml:"components"`
+}
+
+type Info struct {
+	Title       string `yaml:"title"`
+	Description string `yaml:"description,omitempty"`
+	Version     string `yaml:"version"`
+}
+
+type Tag struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+}
+
+type Components struct {
+	Schemas *Schemas `yaml:"schemas,omitempty"`
+}
+
+type PathItem struct {
+	Get     *Operation `yaml:"get,omitempty"`
+	Put     *Operation `yaml:"put,omitempty"`
+	Post    *Operation `yaml:"post,omitempty"`
+	Delete  *Operation `yaml:"delete,omitempty"`
+	Options *Operation `yaml:"options,omitempty"`
+	Head    *Operation `yaml:"head,omitempty"`
+	Patch   *Operation `yaml:"patch,omitempty"`
+	Trace   *Operation `yaml:"trace,omitempty"`
+}
+
+// operation returns a reference to the field holding the operation of the HTTP method,
+// nil if the method is not supported by OpenAPI
+func (p *PathItem) operation(method string) **Operation {
+	switch method {
+	case "get":
+		return &p.Get
+	case "put":
+		return &p.Put
+	case "post":
+		return &p.Post
+	case "delete":
+		return &p.Delete
+	case "options":
+		return &p.Options
+	case "head":
+		return &p.Head
+	case "patch":
+		return &p.Patch
+	case "trace":
+		return &p.Trace
+	}
+	return nil
+}
+
+type Operation struct {
+	Tags        []string     `yaml:"tags,omitempty"`
+	Summary     string       `yaml:"summary,omitempty"`
+	Description string       `yaml:"description,omitempty"`
+	OperationID string       `yaml:"operationId"`
+	Parameters  []*Parameter `yaml:"parameters,omitempty"`
+	RequestBody *RequestBody `yaml:"requestBody,omitempty"`
+	Responses   *Responses   `yaml:"responses"`
+}
+
+type Parameter struct {
+	Name        string                `yaml:"name"`
+	In          string                `yaml:"in"`
+	Description string                `yaml:"description,omitempty"`
+	Required    bool                  `yaml:"required,omitempty"`
+	Style       string                `yaml:"style,omitempty"`
+	Explode     bool                  `yaml:"explode,omitempty"`
+	Schema      *Schema               `yaml:"schema,omitempty"`
+	Content     map[string]*MediaType `yaml:"content,omitempty"`
+}
+
+type RequestBody struct {
+	Description string                `yaml:"description,omitempty"`
+	Required    bool                  `yaml:"required,omitempty"`
+	Content     map[string]*MediaType `yaml:"content"`
+}
+
+type Response struct {
+	Description string                `yaml:"description"`
+	Content     map[string]*MediaType `yaml:"content,omitempty"`
+}
+
+type MediaType struct {
+	Schema *Schema `yaml:"schema"`
+}
+
+type Schema struct {
+	Ref                  string      `yaml:"$ref,omitempty"`
+	Type                 string      `yaml:"type,omitempty"`
+	Format               string      `yaml:"format,omitempty"`
+	Title                string      `yaml:"title,omitempty"`
+	Description          string      `yaml:"description,omitempty"`
+	Nullable             bool        `yaml:"nullable,omitempty"`
+	Enum                 []string    `yaml:"enum,omitempty"`
+	Items                *Schema     `yaml:"items,omitempty"`
+	Properties           *Schemas    `yaml:"properties,omitempty"`
+	AdditionalProperties *Schema     `yaml:"additionalProperties,omitempty"`
+	Required             []string    `yaml:"required,omitempty"`
+	Example              interface{} `yaml:"example,omitempty"`
+	OneOf                []*Schema   `yaml:"oneOf,omitempty"`
+	AnyOf                []*Schema   `yaml:"anyOf,omitempty"`
+	AllOf                []*Schema   `yaml:"allOf,omitempty"`
+	Not                  *Schema     `yaml:"not,omitempty"`
+}
+
+// Paths holds the path items in order of their definition
+type Paths = OrderedMap[*PathItem]
+
+// Responses holds the responses of an operation by status code
+type Responses = OrderedMap[*Response]
+
+// Schemas holds named schemas in order of their definition
+type Schemas = OrderedMap[*Schema]
+
+// OrderedMap is a map which keeps the insertion order when encoded as YAML
+type OrderedMap[T any] struct {
+	keys   []string
+	values map[string]T
+}
+
+func NewOrderedMap[T any]() *OrderedMap[T] {
+	return &OrderedMap[T]{
+		keys:   make([]string, 0),
+		values: make(map[string]T),
+	}
+}
+
+// Set adds or replaces the value of the key, new keys are appended
+func (m *OrderedMap[T]) Set(key string, value T) {
+	if _, ok := m.values[key]; !ok {
+		m.keys = append(m.keys, key)
+	}
+	m.values[key] = value
+}
+
+func (m *OrderedMap[T]) Get(key string) (T, bool) {
+	v, ok := m.values[key]
+	return v, ok
+}
+
+func (m *OrderedMap[T]) Keys() []string {
+	return m.keys
+}
+
+func (m *OrderedMap[T]) Len() int {
+	return len(m.keys)
+}
+
+// IsZero reports empty maps to let them be omitted
+func (m *OrderedMap[T]) IsZero() bool {
+	return m == nil || len(m.keys) == 0
+}
+
+func (m *OrderedMap[T]) MarshalYAML() (interface{}, error) {
+	node := &yaml.Node{
+		Kind: yaml.MappingNode,
+	}
+	for _, key := range m.keys {
+		value := &yaml.Node{}
+		err := value.Encode(m.values[key])
+		if err != nil {
+			return nil, err
+		}
+		node.Content = append(node.Content, &yaml.Node{
+			Kind:  yaml.ScalarNode,
+			Tag:   "!!str",
+			Value: key,
+		}, value)
+	}
+	return node, nil
+}
@@ -13,10 +13,13 @@ import (
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 )
 
 type Repository interface {
 	WriteFile(name string, reader io.Reader) error
+	WriteFiles(dir string, files map[string][]byte) error
+	RemoveFile(dir, name string) error
 	OpenFiles(dir string, excludes ...string) (map[string]io.Reader, error)
 	GitClone(path, repo string) error
 	GoModInit(pkg string) error
@@ -40,30 +43,94 @@ func (r repository) GoModTidy() error {
 	return exec.Command("go", "mod", "tidy").Run()
 }
 
-// WriteFile creates or overrides a file with the data from the reader
+// WriteFile creates or replaces a file with the data from the reader. The data is written to a temporary file
+// which is renamed, so the file is never left partially written.
 func (r repository) WriteFile(name string, reader io.Reader) error {
-	err := os.MkdirAll(filepath.Dir(name), 0666)
+	err := os.MkdirAll(filepath.Dir(name), 0755)
 	if err != nil {
 		return err
 	}
-	f, err := os.OpenFile(name, os.O_CREATE|os.O_TRUNC, 0777)
+	f, err := os.CreateTemp(filepath.Dir(name), "."+filepath.Base(name)+"-*")
 	if err != nil {
 		return err
 	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
 
-	buf := make([]byte, 1024)
-	for {
-		// read a chunk
-		n, err := reader.Read(buf)
-		if err != nil && err != io.EOF {
+	if _, err = io.Copy(f, reader); err != nil {
+		return err
+	}
+	if err = f.Chmod(0644); err != nil {
+		return err
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), name)
+}
+
+// WriteFiles writes the files to the directory dir. All files are staged in a temporary directory first and moved
+// into place once they have been written.
+func (r repository) WriteFiles(dir string, files map[string][]byte) error {
+	err := os.MkdirAll(filepath.Join(dir, ".hawk"), 0755)
+	if err != nil {
+		return err
+	}
+	stage, err := os.MkdirTemp(filepath.Join(dir, ".hawk"), "stage-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(stage)
+	}()
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		err = os.MkdirAll(filepath.Dir(filepath.Join(stage, name)), 0755)
+		if err != nil {
 			return err
 		}
-		if n == 0 {
-			break
+		err = os.WriteFile(filepath.Join(stage, name), files[name], 0644)
+		if err != nil {
+			return errors.Wrapf(err, "cannot stage file '%s'", name)
 		}
+	}
 
-		// write a chunk
-		if _, err = f.Write(buf[:n]); err != nil {
+	for _, name := range names {
+		err = os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0755)
+		if err != nil {
+			return err
+		}
+		err = os.Rename(filepath.Join(stage, name), filepath.Join(dir, name))
+		if err != nil {
+			return errors.Wrapf(err, "cannot move file '%s' into place", name)
+		}
+	}
+
+	return nil
+}
+
+// RemoveFile deletes the file name of the directory dir and its parent directories becoming empty
+func (r repository) RemoveFile(dir, name string) error {
+	err := os.Remove(filepath.Join(dir, name))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	for parent := filepath.Dir(name); parent != "." && parent != string(filepath.Separator); parent = filepath.Dir(parent) {
+		entries, err := os.ReadDir(filepath.Join(dir, parent))
+		if err != nil || len(entries) > 0 {
+			break
+		}
+		if err = os.Remove(filepath.Join(dir, parent)); err != nil {
 			return err
 		}
 	}
@@ -165,6 +232,11 @@ func (r repository) GenerateFile(tpl string, gen generic.Renderable, data *gener
 		return nil, err
 	}
 
+	// the imports of other Go packages are listed by the templates regardless of their usage
+	if fixed, err := generic.FixImports(codeBytes, data.GoImports()); err == nil {
+		codeBytes = fixed
+	}
+
 	// ignore error as we want to write the code either way to inspect after writing to disk
 	formatted, err := format.Source(codeBytes)
 	if err != nil {
@@ -1,21 +1,60 @@
 package kit
 
 import (
+	"bytes"
+	"fmt"
 	"github.com/iancoleman/strcase"
+	fileDiff "github.com/niiigoo/hawk/kit/diff"
+	"github.com/niiigoo/hawk/kit/docs"
 	"github.com/niiigoo/hawk/kit/generic"
 	"github.com/niiigoo/hawk/kit/handlers"
+	"github.com/niiigoo/hawk/kit/manifest"
+	"github.com/niiigoo/hawk/kit/openapi"
 	tplFiles "github.com/niiigoo/hawk/kit/template"
+	"github.com/niiigoo/hawk/kit/ts"
 	"github.com/niiigoo/hawk/proto"
 	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
 	"io"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
+	"runtime/debug"
+	"sort"
 	"strings"
 )
 
+// cliPath is the template of the optional command line client
+const cliPath = "cmd/NAME-cli/main.go.tpl"
+
 type Generator interface {
 	Init(args ...string) error
-	Service(file ...string) error
+	Service(options ServiceOptions, file ...string) error
+	Watch(options WatchOptions, file ...string) error
+	Lint(format string, file ...string) error
+	OpenAPI(out string, info openapi.Info, file ...string) error
+	Docs(out string, file ...string) error
+	TypeScript(out string, file ...string) error
+}
+
+// ServiceOptions configure the generation of the service
+type ServiceOptions struct {
+	// CLI generates the command line client cmd/NAME-cli, it is kept up to date once generated
+	CLI bool
+	// Force overwrites and removes generated files even if they have been edited by hand
+	Force bool
+	// DryRun prints the files which would be written instead of writing them
+	DryRun bool
+	// Diff prints the unified diff of the files which would be written instead of writing them
+	Diff bool
+	// Check fails if a generated file differs from the file on disk, nothing is written
+	Check bool
+}
+
+// preview reports whether the files are compared with the files on disk instead of writing them
+func (o ServiceOptions) preview() bool {
+	return o.DryRun || o.Diff || o.Check
 }
 
 type generator struct {
@@ -24,6 +63,14 @@ type generator struct {
 	dir          string
 }
 
+// Version returns the version of hawk, "(devel)" unless it has been installed from a tagged module
+func Version() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		return info.Main.Version
+	}
+	return ""
+}
+
 func NewGenerator() Generator {
 	dir, _ := os.Getwd()
 	return &generator{
@@ -61,7 +108,7 @@ func (g generator) Init(args ...string) error {
 	return nil
 }
 
-func (g generator) Service(args ...string) error {
+func (g generator) Service(options ServiceOptions, args ...string) error {
 	err := g.downloadDependencies()
 	if err != nil {
 		return errors.Wrap(err, "failed to download dependencies")
@@ -72,12 +119,20 @@ func (g generator) Service(args ...string) error {
 		return errors.Wrap(err, "proto file not found")
 	}
 
-	err = g.protoService.Parse(f)
+	err = g.protoService.Parse(f, g.includePaths()...)
 	if err != nil {
 		return errors.Wrapf(err, "failed to parse proto file '%s'", f)
 	}
 
-	err = g.protoService.CompileProto(f, g.dir, g.dir, "$GOPATH/src/github.com/googleapis", "$GOPATH/src/github.com/googleapis/googleapis")
+	// protoc compiles into a temporary directory, the Go files are written like the generated files
+	out, err := os.MkdirTemp("", "hawk-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = os.RemoveAll(out)
+	}()
+	err = g.protoService.CompileProto(f, out, g.includePaths()...)
 	if err != nil {
 		return errors.Wrap(err, "protoc failed")
 	}
@@ -92,11 +147,17 @@ func (g generator) Service(args ...string) error {
 		return err
 	}
 
+	prev, err := manifest.Load(g.dir)
+	if err != nil {
+		return err
+	}
+
 	config := generic.Config{
 		GoPackage:     module,
 		PBPackage:     module,
 		Version:       "",
 		VersionDate:   "",
+		CLI:           options.CLI || cliGenerated(prev),
 		PreviousFiles: prevFiles,
 	}
 	files, err := g.generateGoKit(config)
@@ -104,11 +165,21 @@ func (g generator) Service(args ...string) error {
 		return errors.Wrap(err, "failed to generate service files")
 	}
 
-	for name, content := range files {
-		err = g.repo.WriteFile("./"+name, content)
-		if err != nil {
-			return errors.Wrapf(err, "failed to write file '%s'", name)
-		}
+	compiled, err := readFiles(out)
+	if err != nil {
+		return err
+	}
+	for name, content := range compiled {
+		files[name] = content
+	}
+
+	if options.preview() {
+		return g.preview(files, prev, options)
+	}
+
+	err = g.write(files, prev, options.Force)
+	if err != nil {
+		return err
 	}
 
 	err = g.repo.GoModTidy()
@@ -119,6 +190,242 @@ func (g generator) Service(args ...string) error {
 	return nil
 }
 
+// write writes the generated files and records them in the manifest. Files of the previous manifest which are no
+// longer generated are removed. Generated files ("DO NOT EDIT") edited by hand are kept unless forced.
+func (g generator) write(files map[string]io.Reader, prev *manifest.Manifest, force bool) error {
+	next := manifest.New(Version())
+	changed := make(map[string][]byte)
+	for name, r := range files {
+		content, err := io.ReadAll(r)
+		if err != nil {
+			return errors.Wrapf(err, "failed to render file '%s'", name)
+		}
+
+		old, err := os.ReadFile(filepath.Join(g.dir, name))
+		if err == nil && !force && edited(prev, name, old) {
+			log.WithField("file", name).Warn("Generated file has been edited by hand, it is not overwritten (use --force)")
+			next.Files[name] = prev.Files[name]
+			continue
+		}
+		next.Add(name, content)
+		if err == nil && bytes.Equal(old, content) {
+			continue
+		}
+		changed[name] = content
+	}
+
+	err := g.repo.WriteFiles(g.dir, changed)
+	if err != nil {
+		return errors.Wrap(err, "failed to write files")
+	}
+
+	for _, name := range stale(prev, next) {
+		old, err := os.ReadFile(filepath.Join(g.dir, name))
+		if os.IsNotExist(err) {
+			continue
+		} else if err != nil {
+			return err
+		}
+		if !force && edited(prev, name, old) {
+			log.WithField("file", name).Warn("Generated file is no longer generated but has been edited by hand, it is not removed (use --force)")
+			continue
+		}
+		log.WithField("file", name).Info("Removing file which is no longer generated")
+		err = g.repo.RemoveFile(g.dir, name)
+		if err != nil {
+			return errors.Wrapf(err, "failed to remove file '%s'", name)
+		}
+	}
+
+	content, err := next.Render()
+	if err != nil {
+		return err
+	}
+	err = g.repo.WriteFile(filepath.Join(g.dir, manifest.Path), content)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write file '%s'", manifest.Path)
+	}
+
+	return nil
+}
+
+// cliGenerated reports whether the manifest contains a command line client, it is generated again even if the
+// service has been renamed
+func cliGenerated(m *manifest.Manifest) bool {
+	for name := range m.Files {
+		if ok, _ := path.Match(strings.Replace(cliPath, "NAME", "*", -1), name+".tpl"); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// edited reports whether a generated file ("DO NOT EDIT") differs from the content recorded by the manifest
+func edited(m *manifest.Manifest, name string, content []byte) bool {
+	return bytes.Contains(content, []byte("DO NOT EDIT")) && m.Modified(name, content)
+}
+
+// stale returns the sorted files of the previous manifest missing in the next one
+func stale(prev, next *manifest.Manifest) []string {
+	names := make([]string, 0)
+	for name := range prev.Files {
+		if _, ok := next.Files[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// preview prints the changes of the generated files compared with the files on disk, including the files of the
+// manifest which would be removed
+func (g generator) preview(files map[string]io.Reader, prev *manifest.Manifest, options ServiceOptions) error {
+	changes, err := fileDiff.Compare(g.dir, files)
+	if err != nil {
+		return err
+	}
+
+	next := manifest.New("")
+	for name := range files {
+		next.Files[name] = ""
+	}
+	for _, name := range stale(prev, next) {
+		c, err := fileDiff.Remove(g.dir, name)
+		if err != nil {
+			return err
+		}
+		if c != nil {
+			changes = append(changes, c)
+		}
+	}
+
+	for _, c := range changes {
+		if options.Diff {
+			d, err := c.Unified()
+			if err != nil {
+				return errors.Wrapf(err, "failed to compare file '%s'", c.Path)
+			}
+			fmt.Print(d)
+		} else if options.DryRun || options.Check {
+			fmt.Printf("%s %s\n", c.Status, c.Path)
+		}
+	}
+
+	if options.Check && len(changes) > 0 {
+		return errors.New(fmt.Sprintf("generated code is stale, %d file(s) differ: run `hawk generate`", len(changes)))
+	}
+	return nil
+}
+
+// readFiles returns the files of the directory and its subdirectories by their relative paths
+func readFiles(dir string) (map[string]io.Reader, error) {
+	files := make(map[string]io.Reader)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = bytes.NewReader(content)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot read directory '%s'", dir)
+	}
+	return files, nil
+}
+
+// OpenAPI writes the OpenAPI specification of the HTTP transport to the file `out`
+func (g generator) OpenAPI(out string, info openapi.Info, args ...string) error {
+	f, err := g.protoService.DetectFile(args...)
+	if err != nil {
+		return errors.Wrap(err, "proto file not found")
+	}
+
+	err = g.protoService.Parse(f, g.includePaths()...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse proto file '%s'", f)
+	}
+
+	doc, err := openapi.NewDocument(g.protoService.Definition(), info)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate OpenAPI document")
+	}
+
+	content, err := doc.Render()
+	if err != nil {
+		return err
+	}
+
+	err = g.repo.WriteFile(out, content)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write file '%s'", out)
+	}
+
+	return nil
+}
+
+// Docs writes the Markdown reference of the services to the file `out`
+func (g generator) Docs(out string, args ...string) error {
+	f, err := g.protoService.DetectFile(args...)
+	if err != nil {
+		return errors.Wrap(err, "proto file not found")
+	}
+
+	err = g.protoService.Parse(f, g.includePaths()...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse proto file '%s'", f)
+	}
+
+	content, err := docs.NewDocument(g.protoService.Definition()).Render()
+	if err != nil {
+		return errors.Wrap(err, "failed to render documentation")
+	}
+
+	err = g.repo.WriteFile(out, content)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write file '%s'", out)
+	}
+
+	return nil
+}
+
+// TypeScript writes the TypeScript client of the HTTP and WebSocket transports to the file `out`
+func (g generator) TypeScript(out string, args ...string) error {
+	f, err := g.protoService.DetectFile(args...)
+	if err != nil {
+		return errors.Wrap(err, "proto file not found")
+	}
+
+	err = g.protoService.Parse(f, g.includePaths()...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse proto file '%s'", f)
+	}
+
+	file, err := ts.NewFile(g.protoService.Definition())
+	if err != nil {
+		return errors.Wrap(err, "failed to generate TypeScript client")
+	}
+
+	content, err := file.Render()
+	if err != nil {
+		return err
+	}
+
+	err = g.repo.WriteFile(out, content)
+	if err != nil {
+		return errors.Wrapf(err, "failed to write file '%s'", out)
+	}
+
+	return nil
+}
+
 func (g generator) downloadDependencies() error {
 	return g.repo.GitClone(
 		os.Getenv("GOPATH")+"/src/",
@@ -126,21 +433,25 @@ func (g generator) downloadDependencies() error {
 	)
 }
 
+// includePaths returns the directories searched for imported proto files (the imports of `protoc.yaml` are added by the parser)
+func (g generator) includePaths() []string {
+	return []string{g.dir, "$GOPATH/src/github.com/googleapis", "$GOPATH/src/github.com/googleapis/googleapis"}
+}
+
 // generateGoKit returns a go-kit service generated from a service definition,
 // the package to the root of the generated service goPackage, the package
 // to the .pb.go service struct files (goPBPackage) and any previously generated files.
 func (g generator) generateGoKit(conf generic.Config) (map[string]io.Reader, error) {
-	if len(g.protoService.Definition().Services) == 0 {
+	def := g.protoService.Definition()
+	if len(def.Services) == 0 {
 		return nil, errors.New("no service found")
 	}
-	svc := g.protoService.Definition().Services[0]
 
 	codeGenFiles := make(map[string]io.Reader)
 	var err error
 
-	// Remove the suffix "service" since it's added back in by templatePathToActual
-	svcName := strings.TrimSuffix(strings.ToLower(svc.Name), "service")
-	helper := generic.NewData(svc, conf)
+	svcName := serviceName(def)
+	helper := generic.NewDefinitionData(def, conf)
 	for _, tpl := range tplFiles.AssetNames() {
 		parts := strings.Split(tpl, ".")
 		if len(parts) > 3 {
@@ -153,11 +464,16 @@ func (g generator) generateGoKit(conf generic.Config) (map[string]io.Reader, err
 		if _, ok := codeGenFiles[actualPath]; ok {
 			continue
 		}
+		if tpl == cliPath && !conf.CLI {
+			if _, err := os.Stat(filepath.Join(g.dir, actualPath)); err != nil {
+				continue
+			}
+		}
 
 		var r generic.Renderable
 		switch tpl {
 		case handlers.ServerHandlerPath:
-			r, err = handlers.New(svc, conf.PreviousFiles[actualPath])
+			r, err = handlers.NewServices(def.Services, conf.PreviousFiles[actualPath])
 			if err != nil {
 				return nil, errors.Wrapf(err, "cannot parse previous handler: %q", tpl)
 			}
@@ -179,6 +495,15 @@ func (g generator) generateGoKit(conf generic.Config) (map[string]io.Reader, err
 	return codeGenFiles, nil
 }
 
+// serviceName returns the name of the generated service used by the paths, e.g. cmd/NAME. The suffix "service" is
+// removed, multiple services are named after the last part of the proto package.
+func serviceName(def *proto.Definition) string {
+	if len(def.Services) > 1 && def.Package() != "" {
+		return strings.ToLower(def.Package()[strings.LastIndex(def.Package(), ".")+1:])
+	}
+	return strings.TrimSuffix(strings.ToLower(def.Services[0].Name), "service")
+}
+
 // templatePathToActual accepts a templateFilePath and the svcName of the
 // service and returns what the relative file path of what should be written to
 // disk
@@ -5,38 +5,46 @@ import (
 	"github.com/sirupsen/logrus"
 
 	pb "{{.PBImportPath -}}"
+	{{- range .GoImports}}
+		{{.Alias}} "{{.Path}}"
+	{{- end}}
 )
 
 var Logger *logrus.Entry
 
-// NewService returns a naive, stateless implementation of Service.
-func NewService() pb.{{GoName .Service.Name}}Server {
+func init() {
 	log := logrus.New()
 	log.SetFormatter(&logrus.JSONFormatter{})
-	Logger = log.WithField("service", "{{ToLower .Service.Name}}")
-
-	return {{ToLower .Service.Name}}Service{}
+	{{- if eq (len .Services) 1}}
+		Logger = log.WithField("service", "{{ToLower .Service.Name}}")
+	{{- else}}
+		Logger = logrus.NewEntry(log)
+	{{- end}}
+}
+{{range $svc := .Services}}
+// New{{$svc.GoPrefix}}Service returns a naive, stateless implementation of {{$svc.Name}}.
+func New{{$svc.GoPrefix}}Service() pb.{{GoName $svc.Name}}Server {
+	return {{ToLower $svc.Name}}Service{}
 }
 
-type {{ToLower .Service.Name}}Service struct{
-	pb.Unimplemented{{GoName .Service.Name}}Server
+type {{ToLower $svc.Name}}Service struct{
+	pb.Unimplemented{{GoName $svc.Name}}Server
 }
 
-{{with $te := . }}
-	{{range $i := $te.Service.Methods}}
-		{{ if $i.RequestStream }}
-            func (s {{ToLower $te.Service.Name}}Service) {{.Name}}(stream pb.{{GoName $te.Service.Name}}_{{GoName .Name}}Server) error {
-                return nil
-            }
-		{{ else if $i.ResponseStream }}
-            func (s {{ToLower $te.Service.Name}}Service) {{.Name}}(in *pb.{{GoName .Request}}, stream pb.{{GoName $te.Service.Name}}_{{GoName .Name}}Server) error {
-                return nil
-            }
-		{{ else }}
-            func (s {{ToLower $te.Service.Name}}Service) {{.Name}}(ctx context.Context, in *pb.{{GoName .Request}}) (*pb.{{GoName .Response}}, error){
-                var resp pb.{{GoName .Response}}
-                return &resp, nil
-            }
-		{{ end }}
-	{{end}}
+{{range $i := $svc.Methods}}
+	{{ if $i.RequestStream }}
+		func (s {{ToLower $svc.Name}}Service) {{.Name}}(stream pb.{{GoName $svc.Name}}_{{GoName .Name}}Server) error {
+			return nil
+		}
+	{{ else if $i.ResponseStream }}
+		func (s {{ToLower $svc.Name}}Service) {{.Name}}(in *{{.GoRequestType}}, stream pb.{{GoName $svc.Name}}_{{GoName .Name}}Server) error {
+			return nil
+		}
+	{{ else }}
+		func (s {{ToLower $svc.Name}}Service) {{.Name}}(ctx context.Context, in *{{.GoRequestType}}) (*{{.GoResponseType}}, error){
+			var resp {{.GoResponseType}}
+			return &resp, nil
+		}
+	{{ end }}
+{{end}}
 {{- end}}
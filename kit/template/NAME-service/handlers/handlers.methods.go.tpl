@@ -1,17 +1,29 @@
 
 {{ with $te := .}}
+	{{- if $te.Constructor}}
+		// New{{$te.GoPrefix}}Service returns a naive, stateless implementation of {{$te.ServiceName}}.
+		func New{{$te.GoPrefix}}Service() pb.{{GoName $te.ServiceName}}Server {
+			return {{ToLower $te.ServiceName}}Service{}
+		}
+	{{- end}}
+	{{- if $te.Type}}
+
+		type {{ToLower $te.ServiceName}}Service struct{
+			pb.Unimplemented{{GoName $te.ServiceName}}Server
+		}
+	{{- end}}
     {{range $i := .Methods}}
 		{{ if $i.RequestStream }}
             func (s {{ToLower $te.ServiceName}}Service) {{.Name}}(stream pb.{{GoName $te.ServiceName}}_{{GoName .Name}}Server) error {
                 return nil
             }
 		{{ else if $i.ResponseStream }}
-            func (s {{ToLower $te.ServiceName}}Service) {{.Name}}(in *pb.{{GoName .Request}}, stream pb.{{GoName $te.ServiceName}}_{{GoName .Name}}Server) error {
+            func (s {{ToLower $te.ServiceName}}Service) {{.Name}}(in *{{.GoRequestType}}, stream pb.{{GoName $te.ServiceName}}_{{GoName .Name}}Server) error {
                 return nil
             }
 		{{ else }}
-            func (s {{ToLower $te.ServiceName}}Service) {{.Name}}(ctx context.Context, in *pb.{{GoName .Request}}) (*pb.{{GoName .Response}}, error){
-                var resp pb.{{GoName .Response}}
+            func (s {{ToLower $te.ServiceName}}Service) {{.Name}}(ctx context.Context, in *{{.GoRequestType}}) (*{{.GoResponseType}}, error){
+                var resp {{.GoResponseType}}
                 return &resp, nil
             }
 		{{ end }}
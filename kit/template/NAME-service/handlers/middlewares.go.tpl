@@ -3,6 +3,9 @@ package handlers
 import (
 	"context"
 	pb "{{.PBImportPath -}}"
+	{{- range .GoImports}}
+		{{.Alias}} "{{.Path}}"
+	{{- end}}
 	"{{.ImportPath -}} /svc"
 	"net/url"
 	"strings"
@@ -13,13 +16,19 @@ import (
 	"net/http"
 )
 
-// WrapEndpoints accepts the service's entire collection of endpoints, so that a
+{{- range $svc := .Services}}
+// Wrap{{$svc.GoPrefix}}Endpoints accepts the service's entire collection of endpoints, so that a
 // set of middlewares can be wrapped around every middleware (e.g., access
 // logging and instrumentation), and others wrapped selectively around some
 // endpoints and not others (e.g., endpoints requiring authenticated access).
 // Note that the final middleware wrapped will be the outermost middleware
 // (i.e. applied first)
-func WrapEndpoints(service pb.{{.Service.Name}}Server, in svc.Endpoints) svc.Endpoints {
+func Wrap{{$svc.GoPrefix}}Endpoints(service pb.{{$svc.Name}}Server, in svc.{{$svc.GoPrefix}}Endpoints) svc.{{$svc.GoPrefix}}Endpoints {
+	{{- if $svc.GoPrefix}}
+		logger := Logger.WithField("service", "{{ToLower $svc.Name}}")
+	{{- else}}
+		logger := Logger
+	{{- end}}
 	in.WrapAllLabeledExcept(middleware.CatchPanic)
 
 	// Pass a middleware you want applied to every endpoint.
@@ -39,9 +48,9 @@ func WrapEndpoints(service pb.{{.Service.Name}}Server, in svc.Endpoints) svc.End
 
     // Some middlewares to improve the logging of requests
     // Use `middleware.GetLogger` to benefit from it
-	in.WrapAllLabeledExcept(middleware.EndpointLogging(Logger, nil))
-	in.WrapAllLabeledExcept(middleware.LoggerToContext(Logger))
-	in.WrapAllWithHttpOptionExcept(middleware.LoggerToContextHTTP(Logger, func(r *http.Request) logrus.Fields {
+	in.WrapAllLabeledExcept(middleware.EndpointLogging(logger, nil))
+	in.WrapAllLabeledExcept(middleware.LoggerToContext(logger))
+	in.WrapAllWithHttpOptionExcept(middleware.LoggerToContextHTTP(logger, func(r *http.Request) logrus.Fields {
 		fields := logrus.Fields{
 			"method": r.Method,
 			"url":    r.URL.String(),
@@ -61,24 +70,44 @@ func WrapEndpoints(service pb.{{.Service.Name}}Server, in svc.Endpoints) svc.End
 
 		return fields
 	}))
+	{{- if $svc.StreamingUsed}}
+
+	// The streaming methods have their own middlewares, they get passed the stream as well
+	in.WrapAllStreamsExcept(middleware.CatchPanicStream)
+	in.WrapAllStreamsExcept(middleware.StreamGuard(func(ctx context.Context, method string) (context.Context, error) {
+		return {{$svc.GoPrefix}}StreamGuard(ctx, service, method)
+	}))
+	in.WrapAllStreamsExcept(middleware.StreamLogging(logger, nil))
+	in.WrapAllStreamsExcept(middleware.LoggerToStreamContext(logger))
+	{{- end}}
 
 	return in
 }
 
-func WrapService(in pb.{{.Service.Name}}Server) pb.{{.Service.Name}}Server {
+func Wrap{{$svc.GoPrefix}}Service(in pb.{{$svc.Name}}Server) pb.{{$svc.Name}}Server {
 	return in
 }
 
-// WebSocketGuard protects the webSocket endpoint. The connection is only upgraded if the guard
-// function does not report an error. The returned context is valid for the lifetime of the connection.
-// `service` has the type `{{ToLower .Service.Name}}Service` and can be cast.
-func WebSocketGuard(ctx context.Context, service pb.{{GoName .Service.Name}}Server, r *http.Request) (context.Context, error) {
+// {{$svc.GoPrefix}}WebSocketGuard protects the webSocket endpoint{{if $svc.GoPrefix}} of {{$svc.Name}}{{end}}. The connection is only upgraded if the guard
+// function does not report an error. The returned context is valid for the lifetime of the connection, the user of the
+// connection can be set by `svc.WithWebSocketUser` to push events to all connections of the user.
+// `service` has the type `{{ToLower $svc.Name}}Service` and can be cast.
+func {{$svc.GoPrefix}}WebSocketGuard(ctx context.Context, service pb.{{GoName $svc.Name}}Server, r *http.Request) (context.Context, error) {
+	return ctx, nil
+}
+
+{{- if $svc.StreamingUsed}}
+// {{$svc.GoPrefix}}StreamGuard protects the streaming methods{{if $svc.GoPrefix}} of {{$svc.Name}}{{end}}, e.g. by checking the metadata of the context.
+// The stream is rejected if the guard function reports an error, the returned context is passed to the method.
+// `service` has the type `{{ToLower $svc.Name}}Service` and can be cast.
+func {{$svc.GoPrefix}}StreamGuard(ctx context.Context, service pb.{{GoName $svc.Name}}Server, method string) (context.Context, error) {
 	return ctx, nil
 }
 
-// WebSocketOriginChecker checks the origin header of the request before upgrading the connection.
+{{end -}}
+// {{$svc.GoPrefix}}WebSocketOriginChecker checks the origin header of the request before upgrading the connection.
 // @see github.com/gorilla/websocket for more details
-func WebSocketOriginChecker(service pb.{{GoName .Service.Name}}Server, r *http.Request) bool {
+func {{$svc.GoPrefix}}WebSocketOriginChecker(service pb.{{GoName $svc.Name}}Server, r *http.Request) bool {
 	origin := r.Header["Origin"]
 	if len(origin) == 0 {
 		return true
@@ -89,3 +118,4 @@ func WebSocketOriginChecker(service pb.{{GoName .Service.Name}}Server, r *http.R
 	}
 	return strings.EqualFold(u.Host, r.Host)
 }
+{{- end}}
@@ -18,11 +18,16 @@ import (
 	// This Service
 	"{{.ImportPath -}} /svc"
 	pb "{{.PBImportPath -}}"
+	{{- range .GoImports}}
+		{{.Alias}} "{{.Path}}"
+	{{- end}}
 )
 
-// New returns an service backed by a gRPC client connection. It is the
+{{- range $svc := .Services}}
+
+// New{{$svc.GoPrefix}} returns a{{if $svc.GoPrefix}} {{$svc.Name}}{{end}} service backed by a gRPC client connection. It is the
 // responsibility of the caller to dial, and later close, the connection.
-func New(conn *grpc.ClientConn, options ...ClientOption) (pb.{{.Service.Name}}Server, error) {
+func New{{$svc.GoPrefix}}(conn *grpc.ClientConn, options ...ClientOption) (pb.{{$svc.Name}}Server, error) {
 	var cc clientConfig
 
 	for _, f := range options {
@@ -36,53 +41,93 @@ func New(conn *grpc.ClientConn, options ...ClientOption) (pb.{{.Service.Name}}Se
 		grpctransport.ClientBefore(
 			contextValuesToGRPCMetadata(cc.headers)),
 	}
-	{{- with $te := .}}
-		{{- with $pkgName := $te.PackageName}}
-			{{- range $i := $te.Service.Methods}}
-				var {{ToLower $i.Name}}Endpoint endpoint.Endpoint
-				{
-					{{ToLower $i.Name}}Endpoint = grpctransport.NewClient(
-						conn,
-						"{{$pkgName}}.{{$te.Service.Name}}",
-						"{{$i.Name}}",
-						EncodeGRPC{{$i.Name}}Request,
-						DecodeGRPC{{$i.Name}}Response,
-						pb.{{GoName $i.Response}}{},
-						clientOptions...,
-					).Endpoint()
-				}
-			{{end}}
-		{{end}}
+	{{- range $i := $svc.Methods}}
+		{{- if $i.Streaming}}{{continue}}{{end}}
+		var {{ToLower $i.Name}}Endpoint endpoint.Endpoint
+		{
+			{{ToLower $i.Name}}Endpoint = grpctransport.NewClient(
+				conn,
+				"{{$.GRPCServiceName $svc.Service}}",
+				"{{$i.Name}}",
+				EncodeGRPC{{$svc.GoPrefix}}{{$i.Name}}Request,
+				DecodeGRPC{{$svc.GoPrefix}}{{$i.Name}}Response,
+				{{$i.GoResponseType}}{},
+				clientOptions...,
+			).Endpoint()
+		}
 	{{end}}
 
-    endpoints := svc.NewEndpoints()
-	{{range $i := .Service.Methods -}}
+	endpoints := svc.New{{$svc.GoPrefix}}Endpoints()
+	{{range $i := $svc.Methods -}}
+		{{- if $i.Streaming}}{{continue}}{{end}}
 		endpoints.{{$i.Name}}Endpoint = {{ToLower $i.Name}}Endpoint
 	{{end}}
 
 	return endpoints, nil
 }
+{{- if $svc.StreamingUsed}}
+
+// {{$svc.GoPrefix}}StreamClient calls the streaming methods of the {{$svc.Name}} service, which are not available by the
+// service returned by New{{$svc.GoPrefix}}. The context values configured by CtxValuesToSend are sent as metadata.
+type {{$svc.GoPrefix}}StreamClient struct {
+	client  pb.{{GoName $svc.Name}}Client
+	headers []string
+}
+
+// New{{$svc.GoPrefix}}StreamClient returns a client of the streaming methods{{if $svc.GoPrefix}} of the {{$svc.Name}} service{{end}}. It is
+// the responsibility of the caller to dial, and later close, the connection.
+func New{{$svc.GoPrefix}}StreamClient(conn *grpc.ClientConn, options ...ClientOption) (*{{$svc.GoPrefix}}StreamClient, error) {
+	var cc clientConfig
+
+	for _, f := range options {
+		err := f(&cc)
+		if err != nil {
+			return nil, errors.Wrap(err, "cannot apply option")
+		}
+	}
+
+	return &{{$svc.GoPrefix}}StreamClient{
+		client:  pb.New{{GoName $svc.Name}}Client(conn),
+		headers: cc.headers,
+	}, nil
+}
+{{range $i := $svc.Methods}}
+{{- if not $i.Streaming}}{{continue}}{{end}}
+// {{$i.Name}} opens a stream of the method {{$i.Name}}
+{{- if $i.RequestStream}}
+func (c *{{$svc.GoPrefix}}StreamClient) {{$i.Name}}(ctx context.Context, opts ...grpc.CallOption) (pb.{{GoName $svc.Name}}_{{GoName $i.Name}}Client, error) {
+	return c.client.{{$i.Name}}(outgoingContext(ctx, c.headers), opts...)
+}
+{{- else}}
+func (c *{{$svc.GoPrefix}}StreamClient) {{$i.Name}}(ctx context.Context, in *{{$i.GoRequestType}}, opts ...grpc.CallOption) (pb.{{GoName $svc.Name}}_{{GoName $i.Name}}Client, error) {
+	return c.client.{{$i.Name}}(outgoingContext(ctx, c.headers), in, opts...)
+}
+{{- end}}
+{{end}}
+{{- end}}
 
 // GRPC Client Decode
-{{range $i := .Service.Methods}}
-// DecodeGRPC{{$i.Name}}Response is a transport/grpc.DecodeResponseFunc that converts a
+{{range $i := $svc.Methods}}
+{{- if $i.Streaming}}{{continue}}{{end}}
+// DecodeGRPC{{$svc.GoPrefix}}{{$i.Name}}Response is a transport/grpc.DecodeResponseFunc that converts a
 // gRPC {{ToLower $i.Name}} reply to a user-domain {{ToLower $i.Name}} response. Primarily useful in a client.
-func DecodeGRPC{{$i.Name}}Response(_ context.Context, grpcReply interface{}) (interface{}, error) {
-	reply := grpcReply.(*pb.{{GoName $i.Response}})
+func DecodeGRPC{{$svc.GoPrefix}}{{$i.Name}}Response(_ context.Context, grpcReply interface{}) (interface{}, error) {
+	reply := grpcReply.(*{{$i.GoResponseType}})
 	return reply, nil
 }
 {{end}}
 
 // GRPC Client Encode
-{{range $i := .Service.Methods}}
-// EncodeGRPC{{$i.Name}}Request is a transport/grpc.EncodeRequestFunc that converts a
+{{range $i := $svc.Methods}}
+{{- if $i.Streaming}}{{continue}}{{end}}
+// EncodeGRPC{{$svc.GoPrefix}}{{$i.Name}}Request is a transport/grpc.EncodeRequestFunc that converts a
 // user-domain {{ToLower $i.Name}} request to a gRPC {{ToLower $i.Name}} request. Primarily useful in a client.
-func EncodeGRPC{{$i.Name}}Request(_ context.Context, request interface{}) (interface{}, error) {
-	req := request.(*pb.{{GoName $i.Request}})
+func EncodeGRPC{{$svc.GoPrefix}}{{$i.Name}}Request(_ context.Context, request interface{}) (interface{}, error) {
+	req := request.(*{{$i.GoRequestType}})
 	return req, nil
 }
 {{end}}
-
+{{- end}}
 
 type clientConfig struct {
 	headers []string
@@ -114,3 +159,11 @@ func contextValuesToGRPCMetadata(keys []string) grpctransport.ClientRequestFunc
 		return ctx
 	}
 }
+
+// outgoingContext adds the context values of the keys to the outgoing metadata of the context
+func outgoingContext(ctx context.Context, keys []string) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	md = md.Copy()
+	ctx = contextValuesToGRPCMetadata(keys)(ctx, &md)
+	return metadata.NewOutgoingContext(ctx, md)
+}
@@ -9,4 +9,7 @@ type Config struct {
 	ServiceAddr                string
 	DebugAddr                  string
 	GenericHTTPResponseEncoder http.EncodeResponseFunc
+	// WebSocket configures the connections of the WebSocket transport, the guard and the origin checker are set per
+	// service
+	WebSocket WebSocketConfig
 }
@@ -17,11 +17,25 @@ import (
 	transport "github.com/go-kit/kit/transport/http"
 
 	"github.com/go-kit/kit/endpoint"
+{{- if .StreamingEnabled}}
+	"github.com/niiigoo/hawk/pkg/middleware"
+	"google.golang.org/grpc"
+{{- end}}
 
 	pb "{{.PBImportPath -}}"
+	{{- range .GoImports}}
+		{{.Alias}} "{{.Path}}"
+	{{- end}}
 )
 
-// Endpoints collects all of the endpoints that compose an add service. It's
+// LabeledMiddleware will get passed the endpoint name when passed to
+// WrapAllLabeledExcept, this can be used to write a generic metrics
+// middleware which can send the endpoint name to the metrics collector.
+type LabeledMiddleware func(string, endpoint.Endpoint) endpoint.Endpoint
+
+{{- range $svc := .Services}}
+
+// {{$svc.GoPrefix}}Endpoints collects all of the endpoints that compose the {{$svc.Name}} service. It's
 // meant to be used as a helper struct, to collect all of the endpoints into a
 // single parameter.
 //
@@ -34,22 +48,24 @@ import (
 // In a client, it's useful to collect individually constructed endpoints into a
 // single type that implements the Service interface. For example, you might
 // construct individual endpoints using transport/http.NewClient, combine them into an Endpoints, and return it to the caller as a Service.
-type Endpoints struct {
-	pb.Unimplemented{{GoName .Service.Name}}Server
+type {{$svc.GoPrefix}}Endpoints struct {
+	pb.Unimplemented{{GoName $svc.Name}}Server
 	httpServerOptions    map[string][]transport.ServerOption
 	httpRequestDecoders  map[string]transport.DecodeRequestFunc
 	httpResponseEncoders map[string]transport.EncodeResponseFunc
 	httpHandlerFuncs     map[string]func(http.ResponseWriter, *http.Request)
 
-{{range $i := .Service.Methods}}
+{{range $i := $svc.Methods}}
 	{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
 		{{$i.Name}}Endpoint	endpoint.Endpoint
+	{{ else }}
+		{{$i.Name}}Endpoint	middleware.StreamEndpoint
 	{{ end }}
 {{- end}}
 }
 
-func NewEndpoints() Endpoints {
-	return Endpoints{
+func New{{$svc.GoPrefix}}Endpoints() {{$svc.GoPrefix}}Endpoints {
+	return {{$svc.GoPrefix}}Endpoints{
 		httpServerOptions:	  make(map[string][]transport.ServerOption),
 		httpRequestDecoders:  make(map[string]transport.DecodeRequestFunc),
 		httpResponseEncoders: make(map[string]transport.EncodeResponseFunc),
@@ -58,25 +74,38 @@ func NewEndpoints() Endpoints {
 }
 
 // Endpoints
-{{range $i := .Service.Methods}}
+{{range $i := $svc.Methods}}
 	{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
-		func (e Endpoints) {{$i.Name}}(ctx context.Context, in *pb.{{GoName $i.Request}}) (*pb.{{GoName $i.Response}}, error) {
+		func (e {{$svc.GoPrefix}}Endpoints) {{$i.Name}}(ctx context.Context, in *{{$i.GoRequestType}}) (*{{$i.GoResponseType}}, error) {
 			response, err := e.{{$i.Name}}Endpoint(ctx, in)
 			if err != nil {
 				return nil, err
 			}
-			return response.(*pb.{{GoName $i.Response}}), nil
+			return response.(*{{$i.GoResponseType}}), nil
+		}
+	{{ else if not $i.RequestStream }}
+		func (e {{$svc.GoPrefix}}Endpoints) {{$i.Name}}(in *{{$i.GoRequestType}}, stream pb.{{GoName $svc.Name}}_{{GoName $i.Name}}Server) error {
+			if e.{{$i.Name}}Endpoint == nil {
+				return e.Unimplemented{{GoName $svc.Name}}Server.{{$i.Name}}(in, stream)
+			}
+			return e.{{$i.Name}}Endpoint(stream.Context(), in, stream)
+		}
+	{{ else }}
+		func (e {{$svc.GoPrefix}}Endpoints) {{$i.Name}}(stream pb.{{GoName $svc.Name}}_{{GoName $i.Name}}Server) error {
+			if e.{{$i.Name}}Endpoint == nil {
+				return e.Unimplemented{{GoName $svc.Name}}Server.{{$i.Name}}(stream)
+			}
+			return e.{{$i.Name}}Endpoint(stream.Context(), nil, stream)
 		}
 	{{ end }}
 {{end}}
 
 // Make Endpoints
-{{with $te := .}}
-	{{range $i := $te.Service.Methods}}
+{{range $i := $svc.Methods}}
 		{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
-			func Make{{$i.Name}}Endpoint(s pb.{{$te.Service.Name}}Server) endpoint.Endpoint {
+			func Make{{$svc.GoPrefix}}{{$i.Name}}Endpoint(s pb.{{$svc.Name}}Server) endpoint.Endpoint {
 				return func(ctx context.Context, request interface{}) (response interface{}, err error) {
-					req := request.(*pb.{{GoName $i.Request}})
+					req := request.(*{{$i.GoRequestType}})
 					v, err := s.{{$i.Name}}(ctx, req)
 					if err != nil {
 						return nil, err
@@ -84,8 +113,46 @@ func NewEndpoints() Endpoints {
 					return v, nil
 				}
 			}
+		{{ else }}
+			func Make{{$svc.GoPrefix}}{{$i.Name}}Endpoint(s pb.{{$svc.Name}}Server) middleware.StreamEndpoint {
+				return func(ctx context.Context, request interface{}, stream grpc.ServerStream) error {
+					{{- if $i.RequestStream}}
+					return s.{{$i.Name}}(&{{ToLower $svc.Name}}{{$i.Name}}Server{ServerStream: stream, ctx: ctx})
+					{{- else}}
+					return s.{{$i.Name}}(request.(*{{$i.GoRequestType}}), &{{ToLower $svc.Name}}{{$i.Name}}Server{ServerStream: stream, ctx: ctx})
+					{{- end}}
+				}
+			}
+
+			// {{ToLower $svc.Name}}{{$i.Name}}Server implements pb.{{GoName $svc.Name}}_{{GoName $i.Name}}Server on top of the
+			// stream passed through the middlewares, the context is the one of the middlewares.
+			type {{ToLower $svc.Name}}{{$i.Name}}Server struct {
+				grpc.ServerStream
+				ctx context.Context
+			}
+
+			func (s *{{ToLower $svc.Name}}{{$i.Name}}Server) Context() context.Context {
+				return s.ctx
+			}
+			{{ if $i.ResponseStream }}
+			func (s *{{ToLower $svc.Name}}{{$i.Name}}Server) Send(m *{{$i.GoResponseType}}) error {
+				return s.ServerStream.SendMsg(m)
+			}
+			{{ else }}
+			func (s *{{ToLower $svc.Name}}{{$i.Name}}Server) SendAndClose(m *{{$i.GoResponseType}}) error {
+				return s.ServerStream.SendMsg(m)
+			}
+			{{ end }}
+			{{ if $i.RequestStream }}
+			func (s *{{ToLower $svc.Name}}{{$i.Name}}Server) Recv() (*{{$i.GoRequestType}}, error) {
+				m := new({{$i.GoRequestType}})
+				if err := s.ServerStream.RecvMsg(m); err != nil {
+					return nil, err
+				}
+				return m, nil
+			}
+			{{ end }}
 		{{ end }}
-	{{end}}
 {{end}}
 
 // WrapAllExcept wraps each Endpoint field of struct Endpoints with a
@@ -93,9 +160,9 @@ func NewEndpoints() Endpoints {
 // Use this for applying a set of middlewares to every endpoint in the service.
 // Optionally, endpoints can be passed in by name to be excluded from being wrapped.
 // WrapAllExcept(middleware, "Status", "Ping")
-func (e *Endpoints) WrapAllExcept(middleware endpoint.Middleware, excluded ...string) {
+func (e *{{$svc.GoPrefix}}Endpoints) WrapAllExcept(middleware endpoint.Middleware, excluded ...string) {
 	included := map[string]struct{}{
-		{{- range $i := .Service.Methods}}
+		{{- range $i := $svc.Methods}}
 			{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
 				"{{$i.Name}}": {},
 			{{ end }}
@@ -110,7 +177,7 @@ func (e *Endpoints) WrapAllExcept(middleware endpoint.Middleware, excluded ...st
 	}
 
 	for inc := range included {
-		{{- range $i := .Service.Methods}}
+		{{- range $i := $svc.Methods}}
 			{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
 				if inc == "{{$i.Name}}" {
 					e.{{$i.Name}}Endpoint = middleware(e.{{$i.Name}}Endpoint)
@@ -120,18 +187,13 @@ func (e *Endpoints) WrapAllExcept(middleware endpoint.Middleware, excluded ...st
 	}
 }
 
-// LabeledMiddleware will get passed the endpoint name when passed to
-// WrapAllLabeledExcept, this can be used to write a generic metrics
-// middleware which can send the endpoint name to the metrics collector.
-type LabeledMiddleware func(string, endpoint.Endpoint) endpoint.Endpoint
-
 // WrapAllLabeledExcept wraps each Endpoint field of struct Endpoints with a
 // LabeledMiddleware, which will receive the name of the endpoint. See
 // LabeledMiddleware. See method WrapAllExcept for details on excluded
 // functionality.
-func (e *Endpoints) WrapAllLabeledExcept(middleware func(string, endpoint.Endpoint) endpoint.Endpoint, excluded ...string) {
+func (e *{{$svc.GoPrefix}}Endpoints) WrapAllLabeledExcept(middleware func(string, endpoint.Endpoint) endpoint.Endpoint, excluded ...string) {
 	included := map[string]struct{}{
-		{{- range $i := .Service.Methods}}
+		{{- range $i := $svc.Methods}}
 			{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
 				"{{$i.Name}}": {},
 			{{ end }}
@@ -146,7 +208,7 @@ func (e *Endpoints) WrapAllLabeledExcept(middleware func(string, endpoint.Endpoi
 	}
 
 	for inc := range included {
-		{{- range $i := .Service.Methods}}
+		{{- range $i := $svc.Methods}}
 			{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
 				if inc == "{{$i.Name}}" {
 					e.{{$i.Name}}Endpoint = middleware("{{$i.Name}}", e.{{$i.Name}}Endpoint)
@@ -156,14 +218,47 @@ func (e *Endpoints) WrapAllLabeledExcept(middleware func(string, endpoint.Endpoi
 	}
 }
 
+{{- if $svc.StreamingUsed}}
+
+// WrapAllStreamsExcept wraps each stream endpoint of struct Endpoints with a
+// middleware.StreamMiddleware, which will receive the name of the endpoint.
+// See method WrapAllExcept for details on excluded functionality.
+func (e *{{$svc.GoPrefix}}Endpoints) WrapAllStreamsExcept(m middleware.StreamMiddleware, excluded ...string) {
+	included := map[string]struct{}{
+		{{- range $i := $svc.Methods}}
+			{{ if $i.Streaming }}
+				"{{$i.Name}}": {},
+			{{ end }}
+		{{- end}}
+	}
+
+	for _, ex := range excluded {
+		if _, ok := included[ex]; !ok {
+			panic(fmt.Sprintf("Excluded endpoint '%s' does not exist; see middlewares/endpoints.go", ex))
+		}
+		delete(included, ex)
+	}
+
+	for inc := range included {
+		{{- range $i := $svc.Methods}}
+			{{ if $i.Streaming }}
+				if inc == "{{$i.Name}}" {
+					e.{{$i.Name}}Endpoint = m("{{$i.Name}}", e.{{$i.Name}}Endpoint)
+				}
+			{{ end }}
+		{{- end}}
+	}
+}
+{{- end}}
+
 // WrapAllWithHttpOptionExcept wraps each Endpoint entry of filed HttpServerOptions of struct Endpoints with a
 // transport.ServerOption.
 // Use this for applying a set of server options to every endpoint in the service.
 // Optionally, endpoints can be passed in by name to be excluded from being wrapped.
 // WrapAllWithHttpOptionExcept(serverOption, "Status", "Ping")
-func (e *Endpoints) WrapAllWithHttpOptionExcept(serverOption transport.ServerOption, excluded ...string) {
+func (e *{{$svc.GoPrefix}}Endpoints) WrapAllWithHttpOptionExcept(serverOption transport.ServerOption, excluded ...string) {
 	included := map[string]struct{}{
-		{{- range $i := .Service.Methods}}
+		{{- range $i := $svc.Methods}}
 			{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
 				"{{$i.Name}}": {},
 			{{ end }}
@@ -192,7 +287,7 @@ func (e *Endpoints) WrapAllWithHttpOptionExcept(serverOption transport.ServerOpt
 // WrapWithHttpOption wraps one Endpoint entry of filed HttpServerOptions of struct Endpoints with a
 // transport.ServerOption.
 // WrapWithHttpOption(serverOption, "Status")
-func (e *Endpoints) WrapWithHttpOption(endpoint string, serverOption transport.ServerOption) {
+func (e *{{$svc.GoPrefix}}Endpoints) WrapWithHttpOption(endpoint string, serverOption transport.ServerOption) {
 	var options []transport.ServerOption
 	if o, ok := e.httpServerOptions[endpoint]; ok {
 		options = append(o, serverOption)
@@ -205,7 +300,7 @@ func (e *Endpoints) WrapWithHttpOption(endpoint string, serverOption transport.S
 }
 
 // GetHttpServerOptions returns all transport.ServerOption associated with the given endpoint.
-func (e Endpoints) GetHttpServerOptions(endpoint string) []transport.ServerOption {
+func (e {{$svc.GoPrefix}}Endpoints) GetHttpServerOptions(endpoint string) []transport.ServerOption {
 	if options, ok := e.httpServerOptions[endpoint]; ok {
 		return options
 	}
@@ -213,12 +308,12 @@ func (e Endpoints) GetHttpServerOptions(endpoint string) []transport.ServerOptio
 }
 
 // SetHttpRequestDecoder assigns a transport.DecodeRequestFunc to an endpoint.
-func (e Endpoints) SetHttpRequestDecoder(endpoint string, decoder transport.DecodeRequestFunc) {
+func (e {{$svc.GoPrefix}}Endpoints) SetHttpRequestDecoder(endpoint string, decoder transport.DecodeRequestFunc) {
 	e.httpRequestDecoders[endpoint] = decoder
 }
 
 // GetHttpRequestDecoder returns the transport.DecodeRequestFunc associated with the given endpoint.
-func (e Endpoints) GetHttpRequestDecoder(endpoint string, fallback transport.DecodeRequestFunc) transport.DecodeRequestFunc {
+func (e {{$svc.GoPrefix}}Endpoints) GetHttpRequestDecoder(endpoint string, fallback transport.DecodeRequestFunc) transport.DecodeRequestFunc {
 	if decoder, ok := e.httpRequestDecoders[endpoint]; ok {
 		return decoder
 	}
@@ -226,12 +321,12 @@ func (e Endpoints) GetHttpRequestDecoder(endpoint string, fallback transport.Dec
 }
 
 // SetHttpResponseEncoder assigns a transport.EncodeResponseFunc to an endpoint.
-func (e Endpoints) SetHttpResponseEncoder(endpoint string, encoder transport.EncodeResponseFunc) {
+func (e {{$svc.GoPrefix}}Endpoints) SetHttpResponseEncoder(endpoint string, encoder transport.EncodeResponseFunc) {
 	e.httpResponseEncoders[endpoint] = encoder
 }
 
 // GetHttpResponseEncoder returns the transport.EncodeResponseFunc associated with the given endpoint.
-func (e Endpoints) GetHttpResponseEncoder(endpoint string, fallback transport.EncodeResponseFunc) transport.EncodeResponseFunc {
+func (e {{$svc.GoPrefix}}Endpoints) GetHttpResponseEncoder(endpoint string, fallback transport.EncodeResponseFunc) transport.EncodeResponseFunc {
 	if encoder, ok := e.httpResponseEncoders[endpoint]; ok {
 		return encoder
 	}
@@ -239,12 +334,12 @@ func (e Endpoints) GetHttpResponseEncoder(endpoint string, fallback transport.En
 }
 
 // SetHttpHandlerFunc assigns a custom http HandlerFunc to an endpoint instead of using the default one.
-func (e Endpoints) SetHttpHandlerFunc(endpoint string, handler func(http.ResponseWriter, *http.Request)) {
+func (e {{$svc.GoPrefix}}Endpoints) SetHttpHandlerFunc(endpoint string, handler func(http.ResponseWriter, *http.Request)) {
 	e.httpHandlerFuncs[endpoint] = handler
 }
 
 // GetHttpHandlerFunc returns the http HandlerFunc for the given endpoint.
-func (e Endpoints) GetHttpHandlerFunc(endpoint string) func(http.ResponseWriter, *http.Request) {
+func (e {{$svc.GoPrefix}}Endpoints) GetHttpHandlerFunc(endpoint string) func(http.ResponseWriter, *http.Request) {
 	if handler, ok := e.httpHandlerFuncs[endpoint]; ok {
 		return handler
 	}
@@ -252,7 +347,8 @@ func (e Endpoints) GetHttpHandlerFunc(endpoint string) func(http.ResponseWriter,
 }
 
 // HasHttpHandlerFunc checks if a custom http HandlerFunc is associated with the given endpoint.
-func (e Endpoints) HasHttpHandlerFunc(endpoint string) bool {
+func (e {{$svc.GoPrefix}}Endpoints) HasHttpHandlerFunc(endpoint string) bool {
 	_, ok := e.httpHandlerFuncs[endpoint]
 	return ok
 }
+{{- end}}
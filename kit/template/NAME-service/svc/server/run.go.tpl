@@ -9,6 +9,7 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"github.com/gorilla/mux"
 	"github.com/sirupsen/logrus"
 	"github.com/soheilhy/cmux"
 	"google.golang.org/grpc/reflection"
@@ -22,6 +23,9 @@ import (
 
 	// This Service
 	pb "{{.PBImportPath -}}"
+	{{- range .GoImports}}
+		{{.Alias}} "{{.Path}}"
+	{{- end}}
 	"{{.ImportPath -}} /handlers"
 	"{{.ImportPath -}} /svc"
 )
@@ -31,6 +35,10 @@ var DefaultConfig svc.Config
 func init() {
 	flag.StringVar(&DefaultConfig.DebugAddr, "debug.addr", ":5060", "Debug and metrics listen address")
 	flag.StringVar(&DefaultConfig.ServiceAddr, "service.addr", ":5050", "HTTP and gRPC listen address")
+	flag.IntVar(&DefaultConfig.WebSocket.Workers, "ws.workers", 16, "Maximum number of concurrent requests per WebSocket connection")
+	flag.IntVar(&DefaultConfig.WebSocket.Backlog, "ws.backlog", 64, "Number of requests per WebSocket connection waiting for a worker")
+	flag.IntVar(&DefaultConfig.WebSocket.QueueSize, "ws.queue-size", 64, "Number of messages queued per WebSocket connection")
+	flag.StringVar((*string)(&DefaultConfig.WebSocket.SlowConsumer), "ws.slow-consumer", string(svc.SlowConsumerBlock), "Policy if the queue of a WebSocket connection is full: block, drop or close")
 
 	// Use environment variables, if set. Flags have priority over Env vars.
 	if addr := os.Getenv("DEBUG_ADDR"); addr != "" {
@@ -44,54 +52,38 @@ func init() {
 	}
 }
 
-func NewEndpoints(service pb.{{.Service.Name}}Server) svc.Endpoints {
+{{- range $svc := .Services}}
+func New{{$svc.GoPrefix}}Endpoints(service pb.{{$svc.Name}}Server) svc.{{$svc.GoPrefix}}Endpoints {
 	// Business domain.
 
 	// Wrap Service with middlewares. See handlers/middlewares.go
-	service = handlers.WrapService(service)
+	service = handlers.Wrap{{$svc.GoPrefix}}Service(service)
 
 	// Endpoint domain.
 	var (
-	{{range $i := .Service.Methods -}}
-		{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
-			{{ToLower $i.Name}}Endpoint = svc.Make{{$i.Name}}Endpoint(service)
-		{{ end }}
+	{{range $i := $svc.Methods -}}
+		{{ToLower $i.Name}}Endpoint = svc.Make{{$svc.GoPrefix}}{{$i.Name}}Endpoint(service)
 	{{end}}
 	)
 
-	endpoints := svc.NewEndpoints()
-	{{range $i := .Service.Methods -}}
-		{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
-			endpoints.{{$i.Name}}Endpoint = {{ToLower $i.Name}}Endpoint
-		{{ end }}
+	endpoints := svc.New{{$svc.GoPrefix}}Endpoints()
+	{{range $i := $svc.Methods -}}
+		endpoints.{{$i.Name}}Endpoint = {{ToLower $i.Name}}Endpoint
 	{{end}}
 
 	// Wrap selected Endpoints with middlewares. See handlers/middlewares.go
-	endpoints = handlers.WrapEndpoints(service, endpoints)
+	endpoints = handlers.Wrap{{$svc.GoPrefix}}Endpoints(service, endpoints)
 
 	return endpoints
 }
-
+{{end}}
 // Run starts a new http server, gRPC server, and a debug server with the
 // passed config and logger
 func Run(cfg svc.Config) {
-	service := handlers.NewService()
-	endpoints := NewEndpoints(service)
-
 	if cfg.GenericHTTPResponseEncoder == nil {
 		cfg.GenericHTTPResponseEncoder = svc.EncodeHTTPGenericResponse
 	}
 
-	// WebSocket config
-	wsCfg := svc.WebSocketConfig{
-		Guard: func(ctx context.Context, r *http.Request) (context.Context, error) {
-			return handlers.WebSocketGuard(ctx, service, r)
-		},
-		OriginChecker: func(r *http.Request) bool {
-			return handlers.WebSocketOriginChecker(service, r)
-		},
-	}
-
 	// Mechanical domain.
 	errc := make(chan error)
 
@@ -128,10 +120,23 @@ func Run(cfg svc.Config) {
 	grpcListener2 := tcpMux.Match(cmux.HTTP2HeaderField("content-type", "application/grpc"))
 	httpListener := tcpMux.Match(cmux.Any())
 
-	// gRPC transport.
+	// gRPC and HTTP transport, the services share both servers.
 	s := grpc.NewServer()
-	srv := svc.MakeGRPCServer(endpoints)
-	pb.Register{{.Service.Name}}Server(s, srv)
+	m := mux.NewRouter()
+	{{range $svc := .Services}}
+		{{ToLower $svc.Name}}Service := handlers.New{{$svc.GoPrefix}}Service()
+		{{ToLower $svc.Name}}Endpoints := New{{$svc.GoPrefix}}Endpoints({{ToLower $svc.Name}}Service)
+
+		pb.Register{{$svc.Name}}Server(s, svc.Make{{$svc.GoPrefix}}GRPCServer({{ToLower $svc.Name}}Endpoints))
+		{{ToLower $svc.Name}}WebSocket := cfg.WebSocket
+		{{ToLower $svc.Name}}WebSocket.Guard = func(ctx context.Context, r *http.Request) (context.Context, error) {
+			return handlers.{{$svc.GoPrefix}}WebSocketGuard(ctx, {{ToLower $svc.Name}}Service, r)
+		}
+		{{ToLower $svc.Name}}WebSocket.OriginChecker = func(r *http.Request) bool {
+			return handlers.{{$svc.GoPrefix}}WebSocketOriginChecker({{ToLower $svc.Name}}Service, r)
+		}
+		svc.Register{{$svc.GoPrefix}}HTTPHandler(m, handlers.Logger, {{ToLower $svc.Name}}Endpoints, cfg.GenericHTTPResponseEncoder, {{ToLower $svc.Name}}WebSocket)
+	{{end}}
 	reflection.Register(s)
 
 	go func() {
@@ -141,10 +146,8 @@ func Run(cfg svc.Config) {
 		errc <- s.Serve(grpcListener2)
 	}()
 
-	// HTTP transport.
-	h := svc.MakeHTTPHandler(handlers.Logger, endpoints, cfg.GenericHTTPResponseEncoder, wsCfg)
 	go func() {
-		errc <- http.Serve(httpListener, h)
+		errc <- http.Serve(httpListener, m)
 	}()
 
 	// Start the mux.
@@ -15,69 +15,100 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	grpctransport "github.com/go-kit/kit/transport/grpc"
+{{- if .StreamingEnabled}}
+	"github.com/niiigoo/hawk/pkg/middleware"
+{{- end}}
 
 	// This Service
 	pb "{{.PBImportPath -}}"
+	{{- range .GoImports}}
+		{{.Alias}} "{{.Path}}"
+	{{- end}}
 )
 
-// MakeGRPCServer makes a set of endpoints available as a gRPC {{.Service.Name}}Server.
-func MakeGRPCServer(endpoints Endpoints, options ...grpctransport.ServerOption) pb.{{.Service.Name}}Server {
+{{- range $svc := .Services}}
+
+// Make{{$svc.GoPrefix}}GRPCServer makes a set of endpoints available as a gRPC {{$svc.Name}}Server.
+func Make{{$svc.GoPrefix}}GRPCServer(endpoints {{$svc.GoPrefix}}Endpoints, options ...grpctransport.ServerOption) pb.{{$svc.Name}}Server {
 	serverOptions := []grpctransport.ServerOption{
 		grpctransport.ServerBefore(metadataToContext),
 	}
 	serverOptions = append(serverOptions, options...)
-	return &grpcServer{
-	// {{ ToLower .Service.Name }}
-	{{range $i := .Service.Methods}}
+	return &grpc{{$svc.GoPrefix}}Server{
+	// {{ ToLower $svc.Name }}
+	{{range $i := $svc.Methods}}
+		{{- if $i.Streaming}}
+		{{ToLower $i.Name}}: endpoints.{{$i.Name}}Endpoint,
+		{{- else}}
 		{{ToLower $i.Name}}: grpctransport.NewServer(
 			endpoints.{{$i.Name}}Endpoint,
-			DecodeGRPC{{$i.Name}}Request,
-			EncodeGRPC{{$i.Name}}Response,
+			DecodeGRPC{{$svc.GoPrefix}}{{$i.Name}}Request,
+			EncodeGRPC{{$svc.GoPrefix}}{{$i.Name}}Response,
 			serverOptions...,
 		),
+		{{- end}}
 	{{- end}}
 	}
 }
 
-// grpcServer implements the {{GoName .Service.Name}}Server interface
-type grpcServer struct {
-    pb.Unimplemented{{GoName .Service.Name}}Server
+// grpc{{$svc.GoPrefix}}Server implements the {{GoName $svc.Name}}Server interface
+type grpc{{$svc.GoPrefix}}Server struct {
+    pb.Unimplemented{{GoName $svc.Name}}Server
 
-{{range $i := .Service.Methods}}
+{{range $i := $svc.Methods}}
+	{{- if $i.Streaming}}
+	{{ToLower $i.Name}}   middleware.StreamEndpoint
+	{{- else}}
 	{{ToLower $i.Name}}   grpctransport.Handler
+	{{- end}}
 {{- end}}
 }
 
-// Methods for grpcServer to implement {{GoName .Service.Name}}Server interface
-{{range $i := .Service.Methods}}
-func (s *grpcServer) {{GoName $i.Name}}(ctx context.Context, req *pb.{{GoName $i.Request}}) (*pb.{{GoName $i.Response}}, error) {
+// Methods for grpc{{$svc.GoPrefix}}Server to implement {{GoName $svc.Name}}Server interface
+{{range $i := $svc.Methods}}
+{{- if not $i.Streaming}}
+func (s *grpc{{$svc.GoPrefix}}Server) {{GoName $i.Name}}(ctx context.Context, req *{{$i.GoRequestType}}) (*{{$i.GoResponseType}}, error) {
 	_, rep, err := s.{{ToLower $i.Name}}.ServeGRPC(ctx, req)
 	if err != nil {
 		return nil, err
 	}
-	return rep.(*pb.{{GoName $i.Response}}), nil
+	return rep.(*{{$i.GoResponseType}}), nil
+}
+{{else if not $i.RequestStream}}
+func (s *grpc{{$svc.GoPrefix}}Server) {{GoName $i.Name}}(req *{{$i.GoRequestType}}, stream pb.{{GoName $svc.Name}}_{{GoName $i.Name}}Server) error {
+	return s.{{ToLower $i.Name}}(streamContext(stream.Context()), req, stream)
+}
+{{else}}
+func (s *grpc{{$svc.GoPrefix}}Server) {{GoName $i.Name}}(stream pb.{{GoName $svc.Name}}_{{GoName $i.Name}}Server) error {
+	return s.{{ToLower $i.Name}}(streamContext(stream.Context()), nil, stream)
 }
 {{end}}
+{{- end}}
 
 // Server Decode
-{{range $i := .Service.Methods}}
-// DecodeGRPC{{$i.Name}}Request is a transport/grpc.DecodeRequestFunc that converts a
+{{range $i := $svc.Methods}}
+{{- if not $i.Streaming}}
+// DecodeGRPC{{$svc.GoPrefix}}{{$i.Name}}Request is a transport/grpc.DecodeRequestFunc that converts a
 // gRPC {{ToLower $i.Name}} request to a user-domain {{ToLower $i.Name}} request. Primarily useful in a server.
-func DecodeGRPC{{$i.Name}}Request(_ context.Context, grpcReq interface{}) (interface{}, error) {
-	req := grpcReq.(*pb.{{GoName $i.Request}})
+func DecodeGRPC{{$svc.GoPrefix}}{{$i.Name}}Request(_ context.Context, grpcReq interface{}) (interface{}, error) {
+	req := grpcReq.(*{{$i.GoRequestType}})
 	return req, nil
 }
 {{end}}
+{{- end}}
 
 // Server Encode
-{{range $i := .Service.Methods}}
-// EncodeGRPC{{$i.Name}}Response is a transport/grpc.EncodeResponseFunc that converts a
+{{range $i := $svc.Methods}}
+{{- if not $i.Streaming}}
+// EncodeGRPC{{$svc.GoPrefix}}{{$i.Name}}Response is a transport/grpc.EncodeResponseFunc that converts a
 // user-domain {{ToLower $i.Name}} response to a gRPC {{ToLower $i.Name}} reply. Primarily useful in a server.
-func EncodeGRPC{{$i.Name}}Response(_ context.Context, response interface{}) (interface{}, error) {
-	resp := response.(*pb.{{GoName $i.Response}})
+func EncodeGRPC{{$svc.GoPrefix}}{{$i.Name}}Response(_ context.Context, response interface{}) (interface{}, error) {
+	resp := response.(*{{$i.GoResponseType}})
 	return resp, nil
 }
 {{end}}
+{{- end}}
+{{- end}}
 
 // Helpers
 
@@ -95,3 +126,9 @@ func metadataToContext(ctx context.Context, md metadata.MD) context.Context {
 
 	return ctx
 }
+
+// streamContext adds the metadata of the stream to its context, like metadataToContext does for unary calls
+func streamContext(ctx context.Context) context.Context {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return metadataToContext(ctx, md)
+}
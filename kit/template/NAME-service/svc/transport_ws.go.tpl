@@ -9,45 +9,117 @@ import (
 	"context"
 	"encoding/json"
 	"github.com/go-kit/kit/endpoint"
+	"github.com/niiigoo/hawk/pkg/ws"
+{{- if .StreamingEnabled}}
+	"github.com/niiigoo/hawk/pkg/middleware"
+	"google.golang.org/grpc/metadata"
+	"io"
+{{- end}}
 	transport "github.com/go-kit/kit/transport/http"
 	"github.com/google/uuid"
 	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+	"github.com/pkg/errors"
 	"net/http"
 	"sync"
+	"sync/atomic"
 	"time"
 	// This service
 	pb "{{.PBImportPath -}}"
+	{{- range .GoImports}}
+		{{.Alias}} "{{.Path}}"
+	{{- end}}
 )
 
 const (
 	pongWait	   = 20 * time.Second
 	pingPeriod	 = (pongWait * 9) / 10
-	{{- if gt .HTTPHelper.Service.WSMaxSize 0 }}
-		maxMessageSize = {{.HTTPHelper.Service.WSMaxSize}}
-	{{- end }}
+
+	defaultWorkers   = 16
+	defaultQueueSize = 64
+	defaultBacklog   = 64
+	// streamBuffer is the number of requests of a client stream received but not read by the method yet
+	streamBuffer = 16
+)
+
+// Commands of a Message
+const (
+	// CommandEnd ends a stream: sent by the client, no further message is sent to the stream, sent by the server, the
+	// method returned with the status of the message
+	CommandEnd = "end"
+	// CommandCancel is sent by the client to cancel the context of a request or a stream
+	CommandCancel = "cancel"
+	// CommandSubscribe is sent by the client to receive the events of the topic
+	CommandSubscribe = "subscribe"
+	// CommandUnsubscribe is sent by the client to stop receiving the events of the topic
+	CommandUnsubscribe = "unsubscribe"
+	// CommandEvent is sent by the server pushing an event, the method is the name of the event
+	CommandEvent = "event"
+)
+
+// SlowConsumerPolicy defines how to handle a client not reading the messages as fast as they are sent
+type SlowConsumerPolicy string
+
+const (
+	// SlowConsumerBlock waits until the queue of the client has space again
+	SlowConsumerBlock SlowConsumerPolicy = "block"
+	// SlowConsumerDrop drops the messages not fitting into the queue
+	SlowConsumerDrop SlowConsumerPolicy = "drop"
+	// SlowConsumerClose closes the connection if the queue is full
+	SlowConsumerClose SlowConsumerPolicy = "close"
+)
+
+var (
+	errMessageDropped = errors.New("message dropped, the queue of the client is full")
+	errClientClosed   = errors.New("connection closed")
 )
 
 type WebSocketConfig struct {
 	Guard         func(ctx context.Context, r *http.Request) (context.Context, error)
 	OriginChecker func(r *http.Request) bool
+	// Workers limits the requests executed concurrently per connection (default 16). Streams are not counted.
+	Workers int
+	// Backlog is the number of requests per connection waiting for a worker (default 64), further requests are
+	// rejected with the status 429. Reading the connection never waits for a worker.
+	Backlog int
+	// QueueSize is the number of messages queued per connection to be sent (default 64)
+	QueueSize int
+	// SlowConsumer is the policy applied if the queue is full (default SlowConsumerBlock)
+	SlowConsumer SlowConsumerPolicy
 }
 
+// Message is sent in both directions, Data is encoded by the codec of the connection
 type Message struct {
 	Method    string          `json:"method"`
 	Data	  json.RawMessage `json:"data,omitempty"`
 	Command   string          `json:"command,omitempty"`
 	RequestID string          `json:"request_id,omitempty"`
 	Status    int             `json:"status,omitempty"`
+	Topic     string          `json:"topic,omitempty"`
 }
 
 type Pool struct {
 	log	   *logrus.Entry
+	// maxMessageSize limits the size of incoming messages, 0 disables the limit
+	maxMessageSize int64
 	upgrade   websocket.Upgrader
 	guard     func(ctx context.Context, r *http.Request) (context.Context, error)
 	endpoints map[string]endpoint.Endpoint
-	decoders  map[string]func(json.RawMessage) (interface{}, error)
+{{- if .StreamingEnabled}}
+	streams   map[string]wsStreamEndpoint
+{{- end}}
+	decoders  map[string]func(wsCodec, []byte) (interface{}, error)
 	clients   map[*Client]bool
+	// byID, byUser and byTopic index the clients
+	byID    map[string]*Client
+	byUser  map[string]map[*Client]bool
+	byTopic map[string]map[*Client]bool
+	events  *eventPublisher
+	workers      int
+	backlog      int
+	queueSize    int
+	slowConsumer SlowConsumerPolicy
 	sync.RWMutex
 }
 
@@ -55,51 +127,125 @@ type Client struct {
 	id         string
 	log        *logrus.Entry
 	connection *websocket.Conn
+	codec      wsCodec
 	pool       *Pool
 	ctx        context.Context
+	cancel     context.CancelFunc
+	// user is the user of the connection set by WithWebSocketUser, topics are the subscribed topics
+	user   string
+	topics map[string]bool
 
-	out chan Message
+	out  chan Message
+	done chan struct{}
+	// workers limits the requests executed concurrently, pending are the requests waiting for a worker
+	workers chan struct{}
+	pending chan *wsCall
+	// requests are the running requests by request id
+	requests   map[string]*wsCall
+	requestsMu sync.Mutex
+{{- if .StreamingEnabled}}
+	// streams are the open streams by request id
+	streams   map[string]*wsStream
+	streamsMu sync.Mutex
+{{- end}}
 }
 
-func NewPool(log *logrus.Entry, endpoints Endpoints, wsCfg WebSocketConfig) *Pool {
-	p := &Pool{
+{{- range $svc := .Services}}
+	{{- if $svc.WSPath}}
+		// New{{$svc.GoPrefix}}Pool creates the WebSocket pool serving the endpoints of the {{$svc.Name}} service.
+		func New{{$svc.GoPrefix}}Pool(log *logrus.Entry, endpoints {{$svc.GoPrefix}}Endpoints, wsCfg WebSocketConfig) *Pool {
+			p := newPool(log, wsCfg, {{$svc.WSMaxSize}})
+			p.events = &{{$svc.GoPrefix}}Events.eventPublisher
+			{{$svc.GoPrefix}}Events.pool.Store(p)
+
+			{{range $i := $svc.Methods}}
+				{{- if $i.Streaming}}
+					p.streams["{{$i.Name}}"] = wsStreamEndpoint{endpoints.{{$i.Name}}Endpoint, {{$i.RequestStream}}}
+				{{- else}}
+					p.endpoints["{{$i.Name}}"] = endpoints.{{$i.Name}}Endpoint
+				{{- end}}
+				p.decoders["{{$i.Name}}"] = decoder{{$svc.GoPrefix}}{{$i.Name}}
+			{{- end}}
+
+			return p
+		}
+	{{- end}}
+{{- end}}
+
+func newPool(log *logrus.Entry, wsCfg WebSocketConfig, maxMessageSize int64) *Pool {
+	if wsCfg.Workers <= 0 {
+		wsCfg.Workers = defaultWorkers
+	}
+	if wsCfg.Backlog <= 0 {
+		wsCfg.Backlog = defaultBacklog
+	}
+	if wsCfg.QueueSize <= 0 {
+		wsCfg.QueueSize = defaultQueueSize
+	}
+	if wsCfg.SlowConsumer == "" {
+		wsCfg.SlowConsumer = SlowConsumerBlock
+	}
+	return &Pool{
 		log:	 log,
+		maxMessageSize: maxMessageSize,
 		clients: make(map[*Client]bool),
+		byID:    make(map[string]*Client),
+		byUser:  make(map[string]map[*Client]bool),
+		byTopic: make(map[string]map[*Client]bool),
 		upgrade: websocket.Upgrader{
 			CheckOrigin:     wsCfg.OriginChecker,
 			ReadBufferSize:  1024,
 			WriteBufferSize: 1024,
+			Subprotocols:    []string{ws.SubprotocolProto, ws.SubprotocolJSON},
 		},
-		guard:     wsCfg.Guard,
-		endpoints: make(map[string]endpoint.Endpoint),
-		decoders:  make(map[string]func(json.RawMessage) (interface{}, error)),
+		guard:        wsCfg.Guard,
+		workers:      wsCfg.Workers,
+		backlog:      wsCfg.Backlog,
+		queueSize:    wsCfg.QueueSize,
+		slowConsumer: wsCfg.SlowConsumer,
+		endpoints:    make(map[string]endpoint.Endpoint),
+{{- if .StreamingEnabled}}
+		streams:   make(map[string]wsStreamEndpoint),
+{{- end}}
+		decoders:  make(map[string]func(wsCodec, []byte) (interface{}, error)),
 	}
-
-	{{range $i := .Service.Methods}}
-		{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
-			p.endpoints["{{$i.Name}}"] = endpoints.{{$i.Name}}Endpoint
-			p.decoders["{{$i.Name}}"] = p.decoder{{$i.Name}}
-		{{ end }}
-	{{- end}}
-
-	return p
 }
 
 func (p *Pool) AddClient(ctx context.Context, connection *websocket.Conn) *Client {
 	id := uuid.NewString()
+	ctx = context.WithValue(ctx, wsClientKey{}, id)
+	ctx, cancel := context.WithCancel(context.WithValue(ctx, "transport", "WEBSOCKET"))
+	user, _ := ctx.Value(wsUserKey{}).(string)
 	c := &Client{
 		id:		 id,
 		connection: connection,
+		codec:      codecOf(connection.Subprotocol()),
 		pool:	   p,
 		log: p.log.WithFields(logrus.Fields{
 			"transport": "WEBSOCKET",
 			"client":	id,
 		}),
-		ctx: context.WithValue(ctx, "transport", "WEBSOCKET"),
-		out: make(chan Message),
+		ctx:    ctx,
+		cancel: cancel,
+		user:   user,
+		topics: make(map[string]bool),
+		out:      make(chan Message, p.queueSize),
+		done:     make(chan struct{}),
+		workers:  make(chan struct{}, p.workers),
+		pending:  make(chan *wsCall, p.backlog),
+		requests: make(map[string]*wsCall),
+{{- if .StreamingEnabled}}
+		streams: make(map[string]*wsStream),
+{{- end}}
 	}
-	c.log.Info("[WS] client connected")
+	c.log.WithField("subprotocol", connection.Subprotocol()).Info("[WS] client connected")
+	p.Lock()
 	p.clients[c] = true
+	p.byID[id] = c
+	if user != "" {
+		addIndex(p.byUser, user, c)
+	}
+	p.Unlock()
 
 	return c
 }
@@ -108,9 +254,201 @@ func (p *Pool) removeClient(client *Client) {
 	p.Lock()
 	defer p.Unlock()
 	if _, ok := p.clients[client]; ok {
-		close(client.out)
+		// the context of the open streams is canceled as well
+		client.cancel()
+		close(client.done)
 		_ = client.connection.Close()
 		delete(p.clients, client)
+		delete(p.byID, client.id)
+		if client.user != "" {
+			removeIndex(p.byUser, client.user, client)
+		}
+		for topic := range client.topics {
+			removeIndex(p.byTopic, topic, client)
+		}
+	}
+}
+
+func addIndex(index map[string]map[*Client]bool, key string, client *Client) {
+	if index[key] == nil {
+		index[key] = make(map[*Client]bool)
+	}
+	index[key][client] = true
+}
+
+func removeIndex(index map[string]map[*Client]bool, key string, client *Client) {
+	delete(index[key], client)
+	if len(index[key]) == 0 {
+		delete(index, key)
+	}
+}
+
+type wsUserKey struct{}
+type wsClientKey struct{}
+
+// WithWebSocketUser identifies the user of a WebSocket connection, it is used by the WebSocketGuard to enable
+// pushing events to all connections of the user (see ToUser).
+func WithWebSocketUser(ctx context.Context, user string) context.Context {
+	return context.WithValue(ctx, wsUserKey{}, user)
+}
+
+// WebSocketClientID returns the id of the WebSocket connection executing the request (see ToClient)
+func WebSocketClientID(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(wsClientKey{}).(string)
+	return id, ok
+}
+
+// Target addresses the WebSocket clients an event is pushed to
+type Target struct {
+	client string
+	user   string
+	topic  string
+}
+
+// ToClient addresses a single connection, see WebSocketClientID
+func ToClient(id string) Target {
+	return Target{client: id}
+}
+
+// ToUser addresses all connections of the user, see WithWebSocketUser
+func ToUser(user string) Target {
+	return Target{user: user}
+}
+
+// ToTopic addresses all connections subscribed to the topic
+func ToTopic(topic string) Target {
+	return Target{topic: topic}
+}
+
+// eventPublisher pushes events to the WebSocket clients of a service, the events are declared by methods with the
+// option `(hawk.v1.method).web_socket_event`. It is ready once the HTTP handler of the service is registered.
+type eventPublisher struct {
+	pool atomic.Pointer[Pool]
+	// SubscribeGuard authorizes the subscription of a topic, all topics are allowed if nil. The context is the one of
+	// the connection.
+	SubscribeGuard func(ctx context.Context, topic string) error
+}
+
+// publish sends the event to the clients of the target and returns the number of clients it has been sent to
+func (e *eventPublisher) publish(ctx context.Context, to Target, event string, payload proto.Message) (int, error) {
+	p := e.pool.Load()
+	if p == nil {
+		return 0, nil
+	}
+	clients := make([]*Client, 0)
+	p.RLock()
+	switch {
+	case to.client != "":
+		if c, ok := p.byID[to.client]; ok {
+			clients = append(clients, c)
+		}
+	case to.user != "":
+		for c := range p.byUser[to.user] {
+			clients = append(clients, c)
+		}
+	case to.topic != "":
+		for c := range p.byTopic[to.topic] {
+			clients = append(clients, c)
+		}
+	}
+	p.RUnlock()
+
+	// the payload is encoded once per codec
+	encoded := make(map[wsCodec][]byte)
+	sent := 0
+	for _, c := range clients {
+		data, ok := encoded[c.codec]
+		if !ok {
+			var err error
+			if data, err = c.codec.marshal(payload); err != nil {
+				return sent, errors.Wrapf(err, "cannot marshal event %s", event)
+			}
+			encoded[c.codec] = data
+		}
+		if c.send(ctx, Message{Method: event, Command: CommandEvent, Topic: to.topic, Data: data}) == nil {
+			sent++
+		}
+	}
+	return sent, nil
+}
+
+// subscribe handles the commands subscribe and unsubscribe of the client
+func (c *Client) subscribe(msg Message) {
+	reply := Message{
+		Command:   msg.Command,
+		RequestID: msg.RequestID,
+		Topic:     msg.Topic,
+		Status:    http.StatusOK,
+	}
+	if msg.Topic == "" {
+		reply.encodeError(httpError{errors.New("topic missing"), http.StatusBadRequest, nil})
+		c.reply(reply)
+		return
+	}
+	if msg.Command == CommandSubscribe && c.pool.events != nil && c.pool.events.SubscribeGuard != nil {
+		if err := c.pool.events.SubscribeGuard(c.ctx, msg.Topic); err != nil {
+			c.log.WithError(err).WithField("topic", msg.Topic).Info("[WS] subscription denied")
+			reply.encodeError(err)
+			if _, ok := err.(transport.StatusCoder); !ok {
+				reply.Status = http.StatusForbidden
+			}
+			c.reply(reply)
+			return
+		}
+	}
+
+	c.pool.Lock()
+	if _, ok := c.pool.clients[c]; ok {
+		if msg.Command == CommandSubscribe {
+			c.topics[msg.Topic] = true
+			addIndex(c.pool.byTopic, msg.Topic, c)
+		} else {
+			delete(c.topics, msg.Topic)
+			removeIndex(c.pool.byTopic, msg.Topic, c)
+		}
+	}
+	c.pool.Unlock()
+	c.reply(reply)
+}
+
+// send queues the message, it is dropped if the connection is closed. A full queue is handled according to the
+// SlowConsumerPolicy of the pool, blocking until the context is done at most.
+func (c *Client) send(ctx context.Context, msg Message) error {
+	select {
+	case c.out <- msg:
+		return nil
+	case <-c.done:
+		return errClientClosed
+	default:
+	}
+
+	switch c.pool.slowConsumer {
+	case SlowConsumerDrop:
+		c.log.WithField("method", msg.Method).Warn("[WS] queue full, message dropped")
+		return errMessageDropped
+	case SlowConsumerClose:
+		c.log.Warn("[WS] queue full, closing connection of slow consumer")
+		c.pool.removeClient(c)
+		return errClientClosed
+	}
+	select {
+	case c.out <- msg:
+		return nil
+	case <-c.done:
+		return errClientClosed
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reply queues a message sent in response to the reader, it never blocks the reader. The reply is dropped if the
+// queue is full regardless of the SlowConsumerPolicy.
+func (c *Client) reply(msg Message) {
+	select {
+	case c.out <- msg:
+	case <-c.done:
+	default:
+		c.log.WithField("method", msg.Method).Warn("[WS] queue full, reply dropped")
 	}
 }
 
@@ -119,9 +457,9 @@ func (c *Client) readMessages() {
 		c.pool.removeClient(c)
 	}()
 
-	{{ if gt .HTTPHelper.Service.WSMaxSize 0 }}
-		c.connection.SetReadLimit(maxMessageSize)
-	{{ end }}
+	if c.pool.maxMessageSize > 0 {
+		c.connection.SetReadLimit(c.pool.maxMessageSize)
+	}
 	if err := c.connection.SetReadDeadline(time.Now().Add(pongWait)); err != nil {
 		return
 	}
@@ -131,7 +469,7 @@ func (c *Client) readMessages() {
 	})
 
 	for {
-		_, payload, err := c.connection.ReadMessage()
+		_, frame, err := c.connection.ReadMessage()
 
 		if err != nil {
 			if websocket.IsUnexpectedCloseError(err, websocket.CloseGoingAway, websocket.CloseAbnormalClosure) {
@@ -142,41 +480,152 @@ func (c *Client) readMessages() {
 			break
 		}
 
-		var msg Message
-		if err = json.Unmarshal(payload, &msg); err != nil {
+		msg, err := c.codec.read(frame)
+		if err != nil {
 			c.log.WithError(err).Info("[WS] invalid message received")
 			continue
 		}
 
 		log := c.log.WithField("method", msg.Method)
-		if e, ok := c.pool.endpoints[msg.Method]; ok {
-			data, err := c.pool.decoders[msg.Method](msg.Data)
-			msg = Message{
-				Method:    msg.Method,
-				RequestID: msg.RequestID,
-				Status:    http.StatusOK,
-			}
-			if err != nil {
-				log.WithError(err).Info("[WS] error decoding message")
-				msg.encodeError(err)
-				msg.Status = http.StatusBadRequest
-				c.out <- msg
-				continue
+{{- if .StreamingEnabled}}
+		if c.handleStreamMessage(log, msg) {
+			continue
+		}
+{{- end}}
+		if msg.Command != "" {
+			switch msg.Command {
+			case CommandCancel:
+				c.cancelRequest(msg.RequestID)
+			case CommandSubscribe, CommandUnsubscribe:
+				c.subscribe(msg)
 			}
-			response, err := e(c.ctx, data)
-			if err != nil {
-				log.WithError(err).Info("[WS] error executing endpoint")
-				msg.encodeError(err)
-			} else {
-				msg.Data, err = json.Marshal(response)
-				if err != nil {
-					log.WithError(err).Error("[WS] error marshalling response")
-					msg.encodeError(err)
-				}
+			continue
+		}
+
+		e, ok := c.pool.endpoints[msg.Method]
+		if !ok {
+			log.Info("[WS] unknown method")
+			reply := Message{Method: msg.Method, RequestID: msg.RequestID}
+			reply.encodeError(httpError{errors.Errorf("unknown method %q", msg.Method), http.StatusNotFound, nil})
+			c.reply(reply)
+			continue
+		}
+
+		ctx, cancel := context.WithCancel(c.ctx)
+		call := &wsCall{ctx: ctx, cancel: cancel, log: log, endpoint: e, msg: msg}
+		c.track(call)
+		select {
+		case c.pending <- call:
+		default:
+			c.release(call)
+			log.Warn("[WS] backlog full, request rejected")
+			reply := Message{Method: msg.Method, RequestID: msg.RequestID}
+			reply.encodeError(httpError{errors.New("too many requests"), http.StatusTooManyRequests, nil})
+			c.reply(reply)
+		}
+	}
+}
+
+// track registers the request to be canceled by its request id
+func (c *Client) track(call *wsCall) {
+	if call.msg.RequestID != "" {
+		c.requestsMu.Lock()
+		c.requests[call.msg.RequestID] = call
+		c.requestsMu.Unlock()
+	}
+}
+
+// release cancels the context of the request and removes it from the registered requests
+func (c *Client) release(call *wsCall) {
+	call.cancel()
+	if call.msg.RequestID != "" {
+		c.requestsMu.Lock()
+		if c.requests[call.msg.RequestID] == call {
+			delete(c.requests, call.msg.RequestID)
+		}
+		c.requestsMu.Unlock()
+	}
+}
+
+// dispatch executes the pending requests as soon as a worker is available
+func (c *Client) dispatch() {
+	for {
+		select {
+		case call := <-c.pending:
+			select {
+			case c.workers <- struct{}{}:
+			case <-c.done:
+				return
 			}
-			c.out <- msg
+			go c.execute(call)
+		case <-c.done:
+			return
+		}
+	}
+}
+
+// wsCall is a pending or running request
+type wsCall struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	canceled atomic.Bool
+	log      *logrus.Entry
+	endpoint endpoint.Endpoint
+	msg      Message
+}
+
+// execute runs the endpoint and sends the response unless the request has been canceled by the client, the worker is
+// released afterwards
+func (c *Client) execute(call *wsCall) {
+	ctx, log, msg := call.ctx, call.log, call.msg
+	defer func() {
+		c.release(call)
+		<-c.workers
+	}()
+	if call.canceled.Load() {
+		// canceled while waiting for a worker
+		return
+	}
+
+	reply := Message{
+		Method:    msg.Method,
+		RequestID: msg.RequestID,
+		Status:    http.StatusOK,
+	}
+	data, err := c.pool.decoders[msg.Method](c.codec, msg.Data)
+	if err != nil {
+		log.WithError(err).Info("[WS] error decoding message")
+		reply.encodeError(err)
+		reply.Status = http.StatusBadRequest
+		_ = c.send(ctx, reply)
+		return
+	}
+	response, err := call.endpoint(ctx, data)
+	if call.canceled.Load() {
+		return
+	}
+	if err != nil {
+		log.WithError(err).Info("[WS] error executing endpoint")
+		reply.encodeError(err)
+	} else {
+		reply.Data, err = c.codec.marshal(response)
+		if err != nil {
+			log.WithError(err).Error("[WS] error marshalling response")
+			reply.encodeError(err)
 		}
 	}
+	_ = c.send(ctx, reply)
+}
+
+// cancelRequest cancels the context of a running request, no response is sent
+func (c *Client) cancelRequest(id string) {
+	c.requestsMu.Lock()
+	call, ok := c.requests[id]
+	c.requestsMu.Unlock()
+	if ok {
+		call.canceled.Store(true)
+		call.cancel()
+	}
 }
 
 func (c *Client) writeMessages() {
@@ -188,22 +637,21 @@ func (c *Client) writeMessages() {
 
 	for {
 		select {
-		case message, ok := <-c.out:
-			if !ok {
-				if err := c.connection.WriteMessage(websocket.CloseMessage, nil); err != nil {
-					c.log.WithError(err).Info("[WS] error closing connection")
-				} else {
-					c.log.Info("[WS] connection closed")
-				}
-				return
+		case <-c.done:
+			if err := c.connection.WriteMessage(websocket.CloseMessage, nil); err != nil {
+				c.log.WithError(err).Info("[WS] error closing connection")
+			} else {
+				c.log.Info("[WS] connection closed")
 			}
-			data, err := json.Marshal(message)
+			return
+		case message := <-c.out:
+			frameType, frame, err := c.codec.write(message)
 			if err != nil {
 				c.log.WithError(err).Error("[WS] error marshalling message")
 				continue
 			}
 
-			if err = c.connection.WriteMessage(websocket.TextMessage, data); err != nil {
+			if err = c.connection.WriteMessage(frameType, frame); err != nil {
 				c.log.WithError(err).Info("[WS] error writing message")
 			}
 		case <-ticker.C:
@@ -234,6 +682,7 @@ func (p *Pool) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	client := p.AddClient(ctx, conn)
 	go client.readMessages()
 	go client.writeMessages()
+	go client.dispatch()
 }
 
 func (m *Message) encodeError(err error) {
@@ -251,11 +700,346 @@ func (m *Message) encodeError(err error) {
 	m.Status = code
 }
 
-{{range $i := .Service.Methods}}
-	{{ if and (not $i.RequestStream) (not $i.ResponseStream) }}
-		func (p *Pool) decoder{{$i.Name}}(data json.RawMessage) (interface{}, error) {
-			r := &pb.{{GoName $i.Request}}{}
-			return r, json.Unmarshal(data, &r)
+// wsCodec encodes the messages of a connection according to the subprotocol negotiated by the header
+// `Sec-WebSocket-Protocol`
+type wsCodec interface {
+	// read decodes a frame, the payload is kept encoded in Data
+	read(frame []byte) (Message, error)
+	// write encodes a message to a frame of the returned type
+	write(msg Message) (int, []byte, error)
+	// marshal encodes a response or event
+	marshal(m interface{}) ([]byte, error)
+	// unmarshal decodes a request, empty data is decoded to an empty request
+	unmarshal(data []byte, m interface{}) error
+}
+
+func codecOf(subprotocol string) wsCodec {
+	switch subprotocol {
+	case ws.SubprotocolJSON:
+		return wsJSONCodec{}
+	case ws.SubprotocolProto:
+		return wsProtoCodec{}
+	}
+	return wsDefaultCodec{}
+}
+
+// wsDefaultCodec is used without subprotocol, the messages and payloads are encoded by encoding/json
+type wsDefaultCodec struct{}
+
+func (wsDefaultCodec) read(frame []byte) (msg Message, err error) {
+	return msg, json.Unmarshal(frame, &msg)
+}
+
+func (wsDefaultCodec) write(msg Message) (int, []byte, error) {
+	data, err := json.Marshal(msg)
+	return websocket.TextMessage, data, err
+}
+
+func (wsDefaultCodec) marshal(m interface{}) ([]byte, error) {
+	return json.Marshal(m)
+}
+
+func (wsDefaultCodec) unmarshal(data []byte, m interface{}) error {
+	if len(data) == 0 {
+		return nil
+	}
+	return json.Unmarshal(data, m)
+}
+
+// wsJSONCodec implements the subprotocol `hawk.json`, the payloads are encoded by protojson like the HTTP transport
+type wsJSONCodec struct {
+	wsDefaultCodec
+}
+
+func (wsJSONCodec) marshal(m interface{}) ([]byte, error) {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil, errors.Errorf("cannot marshal %T", m)
+	}
+	return marshaler.Marshal(msg)
+}
+
+func (wsJSONCodec) unmarshal(data []byte, m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return errors.Errorf("cannot unmarshal %T", m)
+	}
+	if len(data) == 0 {
+		return nil
+	}
+	return unmarshaler.Unmarshal(data, msg)
+}
+
+// wsProtoCodec implements the subprotocol `hawk.proto`, the messages are binary frames containing a ws.Envelope and
+// the payloads are encoded as protobuf. Errors are encoded as JSON.
+type wsProtoCodec struct{}
+
+func (wsProtoCodec) read(frame []byte) (Message, error) {
+	var e ws.Envelope
+	if err := proto.Unmarshal(frame, &e); err != nil {
+		return Message{}, err
+	}
+	return Message{
+		Method:    e.Method,
+		Data:      e.Data,
+		Command:   e.Command,
+		RequestID: e.RequestId,
+		Status:    int(e.Status),
+		Topic:     e.Topic,
+	}, nil
+}
+
+func (wsProtoCodec) write(msg Message) (int, []byte, error) {
+	e := &ws.Envelope{
+		Method:    msg.Method,
+		Command:   msg.Command,
+		RequestId: msg.RequestID,
+		Status:    int32(msg.Status),
+		Topic:     msg.Topic,
+	}
+	if msg.Status >= http.StatusMultipleChoices {
+		e.Error = msg.Data
+	} else {
+		e.Data = msg.Data
+	}
+	frame, err := proto.Marshal(e)
+	return websocket.BinaryMessage, frame, err
+}
+
+func (wsProtoCodec) marshal(m interface{}) ([]byte, error) {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return nil, errors.Errorf("cannot marshal %T", m)
+	}
+	return proto.Marshal(msg)
+}
+
+func (wsProtoCodec) unmarshal(data []byte, m interface{}) error {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return errors.Errorf("cannot unmarshal %T", m)
+	}
+	return proto.Unmarshal(data, msg)
+}
+
+{{- range $svc := .Services}}
+	{{- if $svc.WSPath}}
+
+// {{$svc.GoPrefix}}Events pushes the events of the {{$svc.Name}} service to the WebSocket clients
+var {{$svc.GoPrefix}}Events = &{{$svc.GoPrefix}}EventPublisher{}
+
+// {{$svc.GoPrefix}}EventPublisher provides a method per event of the {{$svc.Name}} service
+type {{$svc.GoPrefix}}EventPublisher struct {
+	eventPublisher
+}
+		{{- range $e := $svc.Events}}
+
+// {{$e.Name}} pushes the event {{$e.Name}} to the clients of the target and returns the number of clients it has been
+// sent to
+func (e *{{$svc.GoPrefix}}EventPublisher) {{$e.Name}}(ctx context.Context, to Target, event *{{$e.GoRequestType}}) (int, error) {
+	return e.publish(ctx, to, "{{$e.Name}}", event)
+}
+		{{- end}}
+	{{- end}}
+{{- end}}
+
+{{range $svc := .Services}}
+	{{range $i := $svc.Methods}}
+		func decoder{{$svc.GoPrefix}}{{$i.Name}}(codec wsCodec, data []byte) (interface{}, error) {
+			r := &{{$i.GoRequestType}}{}
+			return r, codec.unmarshal(data, r)
 		}
-	{{ end }}
+	{{end}}
 {{end}}
+{{- if .StreamingEnabled}}
+
+// wsStreamEndpoint is a streaming method served by the pool
+type wsStreamEndpoint struct {
+	endpoint middleware.StreamEndpoint
+	// clientStream is true if the client sends a stream of requests
+	clientStream bool
+}
+
+// handleStreamMessage opens a stream or passes the message to an open stream, it reports whether the message has been
+// handled. Messages of open streams are identified by the request id.
+func (c *Client) handleStreamMessage(log *logrus.Entry, msg Message) bool {
+	c.streamsMu.Lock()
+	s, open := c.streams[msg.RequestID]
+	c.streamsMu.Unlock()
+	if open {
+		switch msg.Command {
+		case CommandCancel:
+			s.canceled.Store(true)
+			s.cancel()
+		case "", CommandEnd:
+			// the reader never waits for the method, a message which cannot be received ends the stream
+			if err := s.receive(msg); err != nil {
+				log.WithError(err).Info("[WS] stream aborted")
+				s.abort(err)
+			}
+		default:
+			return false
+		}
+		return true
+	}
+
+	e, ok := c.pool.streams[msg.Method]
+	if !ok {
+		return false
+	}
+	reply := Message{
+		Method:    msg.Method,
+		RequestID: msg.RequestID,
+		Command:   CommandEnd,
+	}
+	if msg.RequestID == "" {
+		reply.encodeError(httpError{errors.New("streams require a request_id"), http.StatusBadRequest, nil})
+		c.reply(reply)
+		return true
+	}
+	var request interface{}
+	if !e.clientStream {
+		var err error
+		if request, err = c.pool.decoders[msg.Method](c.codec, msg.Data); err != nil {
+			log.WithError(err).Info("[WS] error decoding message")
+			reply.encodeError(err)
+			reply.Status = http.StatusBadRequest
+			c.reply(reply)
+			return true
+		}
+	}
+
+	s = c.openStream(msg, e.clientStream)
+	if e.clientStream && len(msg.Data) > 0 {
+		// the data of the first message is the first request of the stream
+		_ = s.receive(Message{Data: msg.Data})
+	}
+	go func() {
+		err := e.endpoint(s.ctx, request, s)
+		s.cancel()
+		c.streamsMu.Lock()
+		delete(c.streams, s.id)
+		c.streamsMu.Unlock()
+		if s.canceled.Load() {
+			return
+		}
+		reply.Status = http.StatusOK
+		if err != nil {
+			log.WithError(err).Info("[WS] error executing stream")
+			reply.encodeError(err)
+		}
+		_ = c.send(c.ctx, reply)
+	}()
+	return true
+}
+
+func (c *Client) openStream(msg Message, clientStream bool) *wsStream {
+	ctx, cancel := context.WithCancel(c.ctx)
+	s := &wsStream{
+		ctx:          ctx,
+		cancel:       cancel,
+		client:       c,
+		method:       msg.Method,
+		id:           msg.RequestID,
+		clientStream: clientStream,
+	}
+	if clientStream {
+		s.in = make(chan json.RawMessage, streamBuffer)
+	}
+	c.streamsMu.Lock()
+	c.streams[s.id] = s
+	c.streamsMu.Unlock()
+	return s
+}
+
+// wsStream implements grpc.ServerStream on top of the WebSocket connection, the messages are multiplexed by the
+// request id.
+type wsStream struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
+	client   *Client
+	method   string
+	id       string
+	canceled atomic.Bool
+
+	// clientStream is true if the client sends a stream of requests, in is nil otherwise
+	clientStream bool
+	// in is written and closed by the goroutine reading the connection only
+	in    chan json.RawMessage
+	ended bool
+}
+
+func (s *wsStream) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *wsStream) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *wsStream) SetTrailer(metadata.MD) {}
+
+func (s *wsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *wsStream) SendMsg(m interface{}) error {
+	if err := s.ctx.Err(); err != nil {
+		return err
+	}
+	data, err := s.client.codec.marshal(m)
+	if err != nil {
+		return err
+	}
+	return s.client.send(s.ctx, Message{Method: s.method, RequestID: s.id, Status: http.StatusOK, Data: data})
+}
+
+func (s *wsStream) RecvMsg(m interface{}) error {
+	select {
+	case data, ok := <-s.in:
+		if !ok {
+			return io.EOF
+		}
+		return s.client.codec.unmarshal(data, m)
+	case <-s.ctx.Done():
+		return s.ctx.Err()
+	}
+}
+
+// receive passes a message sent by the client to RecvMsg, the command end closes the requests. It never blocks: a
+// message is rejected if the stream does not accept requests or its buffer is full.
+func (s *wsStream) receive(msg Message) error {
+	if !s.clientStream {
+		return httpError{errors.New("stream does not accept requests"), http.StatusBadRequest, nil}
+	}
+	if s.ended {
+		return httpError{errors.New("requests of the stream ended already"), http.StatusBadRequest, nil}
+	}
+	if msg.Command == CommandEnd {
+		s.ended = true
+		close(s.in)
+		return nil
+	}
+	select {
+	case s.in <- msg.Data:
+		return nil
+	default:
+		return httpError{errors.New("stream buffer full, the requests are not read fast enough"), http.StatusTooManyRequests, nil}
+	}
+}
+
+// abort cancels the method and ends the stream with the error, the result of the method is not sent
+func (s *wsStream) abort(err error) {
+	if s.canceled.Swap(true) {
+		return
+	}
+	s.cancel()
+	reply := Message{
+		Method:    s.method,
+		RequestID: s.id,
+		Command:   CommandEnd,
+	}
+	reply.encodeError(err)
+	s.client.reply(reply)
+}
+{{- end}}
\ No newline at end of file
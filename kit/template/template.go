@@ -1,18 +1,20 @@
 // Code generated by go-bindata. DO NOT EDIT.
 // sources:
+// NAME-service/cmd/NAME-cli/main.go.tpl (16.01kB)
 // NAME-service/cmd/NAME/main.go.tpl (429B)
-// NAME-service/handlers/handlers.go.tpl (1.312kB)
-// NAME-service/handlers/handlers.methods.go.tpl (785B)
+// NAME-service/handlers/handlers.go.tpl (1.331kB)
+// NAME-service/handlers/handlers.methods.go.tpl (1.166kB)
 // NAME-service/handlers/hooks.go.tpl (402B)
-// NAME-service/handlers/middlewares.go.tpl (3.217kB)
-// NAME-service/svc/client/grpc/client.go.tpl (3.191kB)
+// NAME-service/handlers/middlewares.go.tpl (4.766kB)
+// NAME-service/svc/client/grpc/client.go.tpl (5.512kB)
 // NAME-service/svc/client/http/client.go.tpl (101B)
-// NAME-service/svc/config.go.tpl (270B)
-// NAME-service/svc/endpoints.go.tpl (9.506kB)
-// NAME-service/svc/server/run.go.tpl (4.269kB)
-// NAME-service/svc/transport_grpc.go.tpl (3.018kB)
+// NAME-service/svc/client/ws/client.go.tpl (22.28kB)
+// NAME-service/svc/config.go.tpl (423B)
+// NAME-service/svc/endpoints.go.tpl (12.89kB)
+// NAME-service/svc/server/run.go.tpl (5.318kB)
+// NAME-service/svc/transport_grpc.go.tpl (4.396kB)
 // NAME-service/svc/transport_http.go.tpl (101B)
-// NAME-service/svc/transport_ws.go.tpl (6.42kB)
+// NAME-service/svc/transport_ws.go.tpl (28.88kB)
 
 package template
 
@@ -80,7 +82,27 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _cmdNameMainGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\x3f\x8f\xe2\x30\x14\xc4\xeb\xbc\x4f\xf1\x94\x2a\x14\x67\xf7\x48\x54\xe4\x0a\x9a\x03\x01\xb7\xbd\x49\xc6\x8e\x45\x70\x90\xed\x04\xad\x22\x7f\xf7\x95\x03\xbb\xa2\xd8\xca\x7f\x7e\xf3\x34\xf3\x46\x4a\xde\x0e\x2d\xd8\xc0\xc1\xab\x88\x96\x2f\x9f\xdc\xa9\xc7\x55\x70\xbd\xe7\x7f\xfb\x33\xff\xad\x77\x67\x41\x52\xf2\x11\x7e\x74\xce\x3a\xb3\x70\x7e\xd8\xbe\xe7\x61\x82\x7f\x78\x1b\xc1\xb1\xb3\x81\xb5\xed\xb1\x68\x3f\xe0\x83\x1d\xdc\x9a\xe7\x59\xbc\xee\x29\xbd\x01\xae\x55\xc4\x3b\xcd\xef\x94\x88\xee\xaa\xb9\x2a\x03\xbe\x29\xeb\x88\xec\xed\x3e\xf8\xc8\x15\x15\xa5\xee\x95\x29\x89\x0a\x29\xf9\x9c\xad\x4e\xf0\x93\x6d\x40\x45\x39\xcf\x62\xb7\xe8\x0e\x2a\x76\xfc\x27\x25\x96\x61\x6a\x64\x80\x9f\xe0\xcb\xdf\x05\x9d\x72\x6d\x0f\x1f\x4a\x5a\x11\xe9\xd1\x35\x8b\x61\xb5\xe2\x79\x71\xf8\x7f\x6f\x55\x04\xab\xb6\xf5\x08\x01\x81\xad\xe6\xd8\x21\x37\x33\x81\x2f\x80\xfb\xd9\x3c\xc2\xe5\xca\x72\xbc\x40\x45\x3e\xc4\x41\xf9\x80\x6a\x45\x54\x34\xda\xf0\x7a\xc3\xcf\x28\xa2\x86\x56\x63\x1f\xb7\x83\xd3\xd6\x3c\xe1\x86\xbf\x93\x88\x13\x5e\xa4\x6a\xb4\xc9\xc3\xaf\xa9\xe3\xe8\x9e\x3f\x89\xbe\x02\x00\x00\xff\xff\xa6\x55\x21\xaa\xad\x01\x00\x00")
+var _cmdNameCliMainGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x7b\x6b\x73\xdb\xb6\xd2\xf0\x67\xf2\x57\x6c\x39\xad\x0f\xd9\xd0\x54\xfa\x9e\x39\x5f\xd4\x6a\xa6\x89\x93\xb4\x39\x6d\x1d\x4f\xec\xa6\x1f\xfc\x7a\x12\x98\x04\x25\x26\x14\xa0\x02\x90\x2f\xa3\xea\xbf\x3f\xb3\xc0\x82\x04\x65\x49\x56\x3a\xe9\x33\x4f\xa7\x49\x24\x5c\x16\x7b\xc7\x5e\xa0\xd1\x08\x4e\x64\xc5\x61\xca\x05\x57\xcc\xf0\x0a\xae\xef\x61\xc6\x6e\x3f\x15\xf0\xe2\x0d\x9c\xbe\xb9\x80\x97\x2f\x5e\x5f\x14\xf1\x68\x04\x6f\xb9\x5a\x0a\xd1\x88\xa9\x9d\x87\xdb\xa6\x6d\x41\xde\x70\x75\xab\x1a\xc3\xc1\xcc\x1a\x0d\x75\xd3\x72\xbb\xf6\x1d\x57\xba\x91\x62\x0c\xab\x55\x41\x9f\xd7\xeb\x60\x02\x5e\x30\xc3\xc3\x59\xfc\xbe\x5e\xc7\xb8\xe4\x44\xce\xe7\x4c\x54\xd0\x36\x82\x43\xd9\x36\x5c\x18\x28\x59\xdb\xe2\xc9\x66\xc6\x61\xce\xcd\x4c\x56\x1a\x64\x6d\xbf\x6a\xae\x6e\x9a\x92\xe7\x20\x71\x39\xed\x5d\x70\x45\xeb\x2c\x3a\x17\x33\x0e\x75\xc3\xdb\x7e\x97\xe2\x7f\x2e\xb9\x36\xc0\x14\x42\x30\x48\x75\xdd\xb2\xa9\x06\xa9\x2c\xd4\xff\x9e\xbf\x39\x85\x05\xd3\xda\x71\xe4\xf8\xb8\x62\x86\xe5\x38\x85\xf0\x14\xd7\x0b\x29\x34\xd7\x76\xff\x42\x35\x02\x39\xc7\xb4\xdd\x56\xc4\x0b\x56\x7e\x62\x53\x0e\x73\xd6\x88\x38\x6e\xe6\x0b\xa9\x0c\xa4\x71\x94\x5c\xdf\x1b\xae\x93\x38\x4a\x4a\x29\x0c\xbf\x33\xf8\x91\x8b\x52\x56\x8d\x98\x8e\x3e\x6a\x29\x70\xa0\x9e\xdb\xf1\x46\xe2\xdf\x52\xbb\xbf\x47\xba\x99\x0a\xd6\xe2\x97\x05\x33\xb3\x11\x72\x1a\x3f\xe0\x80\x36\xaa\x94\xe2\x86\x3e\x36\x62\x6a\xf7\x98\x66\xce\x93\x38\x8e\x92\x69\x63\x66\xcb\xeb\xa2\x94\xf3\xd1\xe2\xd3\x74\xc4\x95\x92\x4a\x27\xc3\x09\xbd\xa8\xbf\xfb\xf7\xa8\x94\xd7\x8a\x6d\x9d\x59\x20\x73\xec\x8c\x94\xd3\x96\x17\x53\xd9\x32\x31\x2d\xa4\x9a\x8e\xa6\x6a\x51\xee\x9e\x19\x95\x8a\x57\x5c\x98\x86\xb5\x7a\xd4\x08\xcd\xcb\xa5\xe2\xdb\x97\x2f\x94\x34\xf2\x7a\x59\x8f\x3a\x8e\xd8\x11\xcf\x96\x3d\x1b\xec\x87\x47\xd6\x28\x5e\xb7\xbc\x34\x6e\x80\xbe\x24\x71\xb4\x5a\x1d\x43\x53\x43\xf1\xf3\xc5\xc5\xd9\x6f\x56\x61\x34\xaa\x61\x34\x33\x66\x61\x14\x13\xda\xca\x2e\x64\xc8\x54\x1e\x7f\x6a\xcc\x08\xff\x74\x0b\x46\xb8\x9c\xa0\x71\x51\x59\x08\x56\xed\x1a\x0d\xe7\x4e\x3f\xe3\x08\xd9\x41\xea\x9c\xac\x56\xc5\x6b\xab\x16\x67\xcc\xcc\xe0\x78\xbd\x86\x91\xbe\x29\x47\x6e\xda\xb3\x74\x3b\x6e\x16\xb5\xc3\xe0\x3c\xc0\x2a\x5a\x5c\xdb\x3d\x67\xcf\x87\xbb\x68\x91\x62\x62\xca\xa1\xf8\x49\xba\x59\x7b\x5a\xb4\x5a\x15\xcf\xda\x86\xe9\xf5\xda\x6d\x65\x66\xd6\x6d\x70\xb4\x66\xd6\x6c\xe5\xc2\x34\x52\x68\xd0\x33\xa6\x9c\xd1\x98\x59\x67\x90\x3a\xf7\x56\x86\x83\x0b\xb4\x79\x6d\xd0\xb0\x51\xaf\x74\x7c\xc3\x14\xda\x07\xab\x2a\xc5\xb5\x06\x00\xa7\xc7\x71\xd4\x8b\xa0\x1b\x69\xe6\x5c\x2e\x0d\x00\xa0\x82\x17\x2f\x96\x8a\xe1\xb9\x71\x34\xe3\xac\xe2\x0a\x37\x5f\x5e\xd1\x62\x87\x18\xd9\x35\xd9\xbd\x94\xc6\x23\x65\xcd\x79\x63\x9a\xdc\x02\x39\x8b\xdb\xc6\xcc\xec\x22\xcd\xe6\x1c\x04\xfe\xe5\x0c\x9e\xd7\xcd\x9d\x23\x32\xb1\x4b\x8f\x13\x4b\x83\xe2\xe8\x8d\x78\xf5\xca\xc2\x9c\xc0\x9c\x2d\x2e\x1d\x2e\x57\xd7\x52\xb6\xab\x38\x4a\x90\xc6\x64\x0c\xf6\x3f\xa3\x96\x3c\x47\x53\xf5\x54\x26\xe3\x7e\xcc\xd1\x99\x8c\x83\x75\x8e\x44\x3b\xd4\x8d\xa1\x5f\xda\x84\x37\xe3\xed\x62\x38\xb6\x8e\xe3\x7a\x29\x4a\xeb\x91\xd2\x0c\x56\x71\x64\x19\x31\x9e\xc0\x91\x35\xfa\x82\x9c\xee\x2a\x8e\xa2\xdf\x35\xa7\xbd\xf6\x7f\xef\x69\x8a\xe7\x4c\xf3\x54\xea\xe2\x99\x9a\xea\xcb\xa7\x57\x59\x1e\x47\xd1\xf9\x4c\x2a\xd3\xaf\x4e\x4e\x58\xdb\xea\x3d\x5e\x3a\xb1\x9b\x9a\x96\x8b\x92\xff\xae\xd9\x94\x8f\x3b\xac\xfd\xf0\x4b\xeb\x9f\x3a\x46\x44\x67\x9d\xb2\x9c\x29\xfe\x76\x29\x5e\x8e\x01\x49\x49\xcb\x79\x05\xdf\x0e\x90\xcf\x81\xa9\xa9\xee\xc4\x9f\x81\x75\x75\x48\x6c\x14\x35\x35\xf4\xba\xf4\xd5\x04\x12\x6b\x63\x70\x74\xb4\x31\x6c\x4d\xc6\x6d\x89\x14\x37\x4b\x25\x1c\x14\x5d\x9c\xf2\xdb\xb4\x9e\x9b\xe2\xdc\xba\xfb\x3a\x4d\x96\xe2\x93\x90\xb7\x22\x00\xf0\xe1\x1b\xfd\x21\x87\xa5\xe6\xf0\x01\xc1\x7f\xc0\xcb\xe4\x03\x42\xfc\x90\xe4\xfd\xb2\x2c\x43\xe8\x68\x5a\x51\x2d\x15\xbc\xcf\x61\x06\xe3\x09\x19\x9f\xd7\x62\x87\x41\x53\xc3\x57\xe4\xd2\x8b\x13\x29\x0c\x6b\x84\x4e\x67\x39\x24\x93\x24\xa3\x25\x8f\x61\xd9\x88\x1b\xd6\x36\x15\x01\x0e\x51\xfc\xc4\xef\x27\x37\xac\x5d\x72\xc4\x6e\xe6\xb0\x8a\xd6\x1d\x6e\x04\x57\x34\x6d\x1c\x45\xeb\x3c\xc6\x51\x54\x9a\xa2\x17\x88\x55\xf3\x34\x2b\xce\x2d\x8a\xef\x98\x3a\x4b\x8f\xc8\x86\x73\x70\x9a\x8e\xff\x26\x39\x24\xad\x2c\x59\x3b\x93\xda\x8c\xff\xf3\xf4\x3f\x4f\x13\x9a\xe6\x7a\x53\x41\x9c\x4d\xa2\xbe\xa1\xe6\x30\x01\xe8\xfc\xc0\xaf\x6d\x34\x2c\x14\x5f\x70\x51\xf1\x0a\x8c\xec\xd6\xea\x24\x3b\x08\xb9\x4e\x06\x39\x04\x46\x87\x5f\x10\x23\x94\x59\x12\xce\xa0\x28\xed\x39\x18\x7e\x84\x58\x8e\x01\xd7\xa2\x78\x51\xba\x7b\x0e\xf7\xee\xe9\x1d\x53\xe9\x11\x3a\x2c\xb9\x34\x39\x74\xc6\x9d\xc3\xd3\xfe\x9b\xe7\x04\x1e\x96\xc3\x53\xb8\x65\x8d\xd1\xb0\x14\xa6\x69\xbb\x71\x68\x34\x54\x52\xf0\x47\xe9\x7d\xa6\x14\xbb\x77\x12\x21\x95\xca\xc1\xfb\x8f\x1c\x92\x9f\x93\x1c\x44\xd3\x76\x63\x90\x22\x9f\x33\xa4\x68\xce\x0d\x43\xa7\x02\xe9\xf4\xed\xd9\x49\x06\x1a\xdd\x74\xe7\x08\x11\x39\x60\x1a\x3a\xe5\x49\xb2\x78\xb5\x72\xba\xfb\xb5\xbe\x29\x51\x93\x0b\xba\xf2\xec\xfd\x41\x97\x18\xce\x15\x3f\xc9\x33\xeb\x39\xdd\xf8\x85\xfc\x55\xde\x72\xe5\xa6\x4e\xd9\x9c\xaf\xd7\x7b\x1d\x52\x82\x5b\x7e\xe1\xd7\xec\x3a\xdc\x92\x04\x6e\x68\xb7\xfb\x59\xad\xc2\x53\x48\x8a\xb8\x75\x17\x26\xc5\xb3\xaa\x22\x14\x52\xc1\x6f\x57\xab\x0d\x02\x68\x4e\xa7\x59\x51\x14\x5e\x18\xc1\x9e\xad\x40\x33\xba\x35\x5b\xcd\xd7\xeb\x87\x7b\x0e\x39\xa7\xbf\x75\xfb\x4f\x31\x7a\x37\xae\x14\x72\xcf\xc2\x7c\x79\xc7\xcb\xa5\xe1\x69\xf6\x3d\x3a\x2f\xf4\x6b\xa2\x69\xad\xbf\x40\x07\xf6\xca\xba\x86\x56\xa0\x2f\x3f\x37\x15\x57\x2a\x87\xc4\x7a\xdd\x71\x92\xe3\x06\xf4\x04\x52\x17\x2f\xef\x1a\x93\x7e\x97\x21\x8f\xd6\x7b\x65\x3c\x1a\xc1\x3e\xd4\xc1\x79\x12\x3d\x88\x07\xbc\x60\x0e\x91\x93\xbb\xba\xf6\x73\x07\x2e\xaf\x86\x97\x01\x92\x4b\x2e\x6c\x73\x6a\x15\x46\x3b\x5f\xcf\x91\x1c\x24\xab\x08\x62\x2c\x1f\x7b\x7d\x3d\x2f\xde\xba\x90\xe0\xdc\x28\xce\xe6\x28\xb6\x48\xf0\x5b\xf7\x8d\xe0\xa5\xa1\x66\xce\x09\x79\x74\x24\xab\x55\xf8\x75\xb5\xb2\x8c\xaf\x21\xf9\xe6\xcf\x04\x17\xe2\x76\x2e\x8c\x26\x93\x5d\xaf\x73\xba\xd9\xcc\x1d\x50\x82\x60\xbd\x3e\xbf\x33\x83\xbb\xac\x94\x42\xe4\x5e\xe0\x55\xc3\xda\x34\xa3\x2b\x6e\x43\xda\xe1\xe5\xd0\xf9\xf5\x8a\xd7\x5c\x21\x7c\x51\x9c\xb4\x52\x73\xb7\xd9\x05\x94\x1d\xd4\x3e\x56\x2d\x4e\xb7\xf0\x9d\xc8\xb7\x7b\x52\x04\x95\x87\x3b\x4e\xcc\xdd\x3b\xbc\x57\xf4\x85\x3c\xe7\xa2\x4a\x9d\xdb\xf9\x85\xdf\x93\x16\x7f\x0e\xb6\xa1\x1c\x5c\xce\x15\x08\x22\x1a\x8d\x06\x9e\xb1\x64\xa2\xe4\x2d\xaf\x50\x72\xac\x8b\xe5\x4a\x26\x84\x34\x70\x8d\x41\x88\x30\xb8\xad\x34\x77\x39\x2d\x46\xe1\x7b\x5e\xff\xd1\x98\xd9\x89\x1d\x4d\x4b\x73\x97\x05\xcc\x72\x83\x76\x44\xdb\xe3\x3b\x46\x11\xc9\x81\xa4\x89\x5c\x12\x9c\x85\xf4\x39\x04\x73\xa5\xac\x13\x9d\xb3\x4f\x3c\x2d\x67\x8c\x6e\xf6\x1c\xd0\x12\xa3\x68\x2a\x9d\x8e\xf8\xeb\x9f\xd0\xd0\x5c\x54\xcf\xda\x36\xf5\xd8\xd1\x9a\x6f\x2d\x5e\x3f\x49\xd2\xe1\x8b\xfb\x05\xba\xbf\x15\x59\x24\x1c\x6d\x9b\x5e\xad\x61\x9d\x79\xd8\x25\xfc\x70\xec\xd1\xdb\x4a\x41\x14\xf2\x86\xa2\x07\xf7\x05\x97\x4e\x40\xf1\xf2\xa6\x47\xac\x78\xcb\xcb\x1b\x3b\xab\x39\x66\x63\xa4\xcf\x4c\x5b\xd9\x54\x2f\x1d\x31\x3f\x1c\xe3\xc9\x63\x7f\xa6\x9f\x19\x9e\x4b\x24\xd0\x64\x7f\x7a\xc5\x6b\xb6\x6c\xcd\xb8\x63\xe8\x90\xc7\xa1\xff\xfd\x5f\x11\x26\xfc\x33\xc2\x39\x10\x01\xc5\x75\x47\x1d\x89\xc0\x1a\xfd\x33\x51\xa1\x28\x3e\xcb\x71\xd0\x90\x75\x62\xbf\x71\x8d\xd1\x7b\xaa\xb8\xce\x3a\xb6\xda\x64\x30\x8a\xd6\x36\x33\x18\x30\x5a\xf0\xdb\x2f\xef\x2b\x77\x30\x88\xf8\xbb\xc5\x89\xe6\x9d\x4b\xb0\x45\x80\x82\x88\x18\xf8\x56\xc5\xff\x44\x15\xa4\x85\x45\xba\x55\x48\x9e\x6b\x7d\xa0\x38\xd9\x48\x1d\x90\x7a\x1b\x33\x59\xc7\x89\xb1\xd5\xcf\xbc\x5d\x70\x45\x77\x4c\x4f\x7a\xb7\x1a\x13\x7d\x8c\x9b\xb8\x0a\x1d\xdc\xa6\x5f\xee\x73\xff\xc7\xfd\x32\x05\xcd\xb9\xdd\xf4\xc6\x65\xe7\x3e\x8c\xd8\x21\xf5\x0d\xb1\x3b\x25\x3e\xc0\x4a\xac\x43\x55\xfc\xcf\xcf\x85\xec\xee\xa2\x50\x31\xc9\x91\xd4\xb2\x1b\x3e\x45\xc3\xeb\xb2\x1d\x82\x3d\x9e\x0c\xf5\x90\xd6\xfe\xa6\xa7\x69\xf6\x30\xea\xd9\x72\x3e\x21\xb0\x0e\x95\x9d\x80\xbc\x54\x8a\xb0\x18\x3a\x8b\xcf\x94\xc5\x97\xe3\x7f\x68\xc4\x5f\x90\xf9\xfb\xec\x79\x60\xd0\x0f\x18\xf1\x30\xe5\x4c\xfa\x58\x0e\x3e\x04\xc8\x7d\x80\x19\xd3\x20\xa4\x4b\xe3\xae\x1b\x81\x75\xbd\x64\xcb\x11\xeb\xf8\x9f\x8a\x6c\xf6\x47\x10\xff\x57\xe3\x9e\xbf\x73\x33\xf5\x5a\x70\xe0\x21\x34\xb4\xeb\x8e\x1e\x4a\xfd\xf3\x38\xf5\xcf\x72\xe7\x11\x8b\xf8\x72\xac\xd9\x6a\x1c\x3b\x2e\x3b\xa7\xca\xc1\x47\x97\x37\xb9\x2f\x2e\x47\xa2\x4b\x70\x5b\x46\x84\x09\x11\x23\x13\xca\x07\xb5\xc8\x46\xc3\xf5\xb2\x69\x0d\xd4\x4a\xce\x87\x25\x4b\x2c\x10\x50\xa9\x12\xc1\x76\x8d\x0b\x59\x53\xc7\xa2\x4b\x9d\xfc\xf5\xbb\xd4\x3c\xef\x4e\xa9\x64\x49\x75\xd5\x1d\x57\x23\x46\xc9\x6d\xfb\x37\x2f\xd4\x6c\xa3\x3c\x87\xe2\xc4\x22\xa9\x2d\x2e\xf8\x72\x2e\x56\xf1\xf6\x25\xfd\x88\xef\x9e\x04\x1f\x12\x78\xe2\xc9\x89\xa3\xe8\x57\x29\xa6\x63\x80\x4a\x96\x28\x15\x2c\x54\x8e\x01\x1c\xe8\x53\x89\x5f\x5d\xc6\x5f\xce\xab\x62\x58\x2e\x71\x95\x12\xc4\x2c\x87\x04\xff\xc1\x90\xa4\xc2\xbf\xf0\x8f\xe7\x69\x20\x95\x1c\x7e\xc4\xc2\x28\x28\xce\x2a\x0d\x0d\x09\x87\xd9\x0e\x18\x20\xb1\x3f\x1e\xf7\xf2\xd2\x86\x89\x8a\xa9\x0a\x1a\xb1\x58\x1a\x74\x7f\x24\xb4\xf1\x04\x4b\x5b\xaf\xf0\x8b\xc3\x27\xc0\xac\x63\x6e\x71\x86\xcc\x7d\xeb\x5a\x16\x58\x53\xe2\xba\x54\xcd\xc2\x48\x95\x62\xd1\x0e\xb7\x60\x59\x14\x26\x7f\xa3\x2e\xda\xd4\x80\xd4\xa2\x39\x24\x14\xbb\x28\x76\xdb\xd9\x16\x52\xf7\x82\x19\x96\xe2\xa2\xcf\x31\x1f\x5c\x33\x81\xae\x7f\x53\xfc\x2e\xe6\x4c\xe9\x19\x6b\x53\x0b\x9e\x28\x3b\x00\x22\x5e\x30\x7f\x28\xb6\x48\x5d\x99\xc2\x57\x33\x09\x40\xd2\x95\x52\xf1\x0f\x21\xad\xb9\xb1\x1c\xdd\xce\xcd\x9c\x0c\x26\x8b\xb7\x1e\x3d\xa0\xc5\x5e\x4a\x9b\xc9\x22\x6b\x5b\xef\x59\xb2\xb8\xbb\x74\xfa\x2c\x88\x20\xa0\xe1\x74\x57\x33\x11\xbb\xee\x4a\x12\xe5\xbc\x8a\x5d\xb7\x73\xb3\x98\xf0\x98\x6f\xa0\xb0\xc8\x37\x43\x09\xb8\xb6\x2e\xe3\xde\xb6\x23\x51\x68\xbb\x74\xaf\xf3\x07\x83\x33\x77\x7b\x85\xbd\xe6\xbf\xc7\xce\x89\xcc\x2f\x60\xd6\xf0\x04\x92\x81\x3f\xd4\x8f\x12\xe9\x1b\xb1\xc9\xa1\x2e\x21\xfa\xd2\x6d\x85\x55\x7c\x40\x65\x3e\x20\x17\xab\xf2\x24\x58\xbd\x21\x56\x2c\xd5\xe3\x48\x7f\x8c\xeb\x2d\x24\x5e\x60\x41\x3b\xe1\x11\x55\x7d\xa8\xab\x9b\xca\x9a\x75\xa5\x7e\xa7\x9c\x01\x84\x0d\xbd\x74\x63\xf6\xce\xc2\x45\x39\xba\xc0\xb0\x02\x73\x7d\x0f\x4c\x00\xf6\xc5\x95\x5a\x2e\x8c\x6f\xab\x53\xa9\xdb\xa9\xe1\x00\x3f\x48\x37\xb4\x2b\xef\xd5\xcd\x02\x7d\xb5\x14\xa5\x4d\x03\x2c\x95\xda\xc8\x05\xde\xfe\xae\x2d\x5e\x9c\x4a\xd3\xd4\xf7\x1e\x98\xdf\xf8\x9c\x95\x9f\xa6\x4a\x2e\x45\x95\x66\x39\x48\x5d\xbc\xf6\xf8\x64\xb6\x66\xea\x0b\xef\x3f\x4c\xe0\x29\x42\xee\xd8\xe1\x4f\x40\x4e\xec\xab\x16\x5d\x38\x00\xc8\xba\xdc\xd3\x96\xf5\x46\x6e\xee\xba\x64\x7f\x15\x0f\x4a\x25\x08\x3c\xa5\xd2\x2a\x32\x1a\xab\x78\x03\x0e\x63\xed\x9d\x42\x1b\xe4\x83\xe0\x25\xe6\x0f\xfe\x12\xa2\xd4\xc2\xb1\x11\xf7\xa6\x19\xa4\xdf\x4e\xd5\xa2\x2c\x5c\x70\x7a\xe2\x03\x69\xa9\x1c\xcf\xc2\xc0\xda\xae\x3b\xe5\xb7\x9b\x79\xa2\x1d\xb7\x64\x79\x5d\x3b\xe9\x9b\xf7\xa9\x6f\xde\x63\xb2\x13\x8e\x67\xa8\x80\x0f\x3d\x29\xf1\xc0\x36\x19\x02\x3f\x5e\x93\x23\xa7\xa2\x1c\x91\x86\x3d\x96\x6f\x74\x92\x7b\xc2\x86\xbe\xd2\xe2\x8e\x7d\x28\xc7\xab\x3e\x70\x1c\x70\xec\x13\xbf\xef\xca\xc8\x5d\xcb\xc3\xcc\x78\xa3\xc0\x36\x2a\x34\xbd\xf5\x10\xd6\x3f\x58\xfe\x76\xad\x8e\xeb\xfb\x50\xad\x1d\x5b\xc3\xf8\xb4\x33\x7e\xe4\xa5\x3d\xc8\x57\xe8\xfc\x84\xed\xe4\xb4\x5c\x50\x58\xab\x91\x29\xfb\xbb\x7b\x9f\xf8\x7d\x8e\xcd\xbf\xf7\x54\x9b\xc1\x77\x1b\xc5\xc9\xd2\xf8\xfe\x9e\x5b\xa2\x61\x02\x6c\x81\x1d\xaf\x14\xbf\xe5\xd8\x78\x19\x70\x07\x47\x07\x8c\x21\x23\x40\x56\x3a\x5f\x42\xd4\x0f\x59\xe3\xbb\x67\x44\x32\xb6\xe7\xfd\x1c\x92\x1c\xb2\xa0\xb3\xaa\x6d\x37\xc0\xc6\x00\xac\x0e\x22\xdb\xa2\xb4\x97\x74\xbc\x6e\x86\xb6\x66\x4b\xca\xce\xd2\x7a\x18\x43\x45\x31\x77\x36\xe2\xde\xfa\xa2\x63\x34\x0a\xf3\xf0\x81\xe6\xf8\x97\x0b\xc4\x21\xdc\xe8\x4d\x4f\x73\x63\xfc\x55\x4b\x54\x10\x6b\xc2\x9c\x1e\x2e\xaf\x06\xef\x45\xc8\x08\x5d\xce\x8f\x2c\xf1\x27\xf4\x5a\xb3\x7b\xfd\xdf\xd4\xa4\xc7\x59\xea\x91\xe8\x14\x8a\x06\x72\xd8\x86\xcc\x73\x5e\x4b\xc5\xd3\xe1\xd4\x39\x37\x54\x08\xfb\xd9\x22\x97\x06\x92\xc8\x06\xb2\x20\xd8\xf1\xa0\x4f\x65\x5f\x4e\xb9\x88\x72\x20\x80\x1d\x8f\xad\xfe\x76\x84\xed\x44\x34\x08\x5e\x89\x27\x19\xa4\x97\x57\xf8\x0a\x2b\x74\x8e\x61\x97\xfd\x67\xa6\x5d\x0a\x9b\x52\x32\xf0\x23\x75\xda\x89\x2e\xb7\xdb\x4e\x66\xb6\x85\x6a\x89\xf6\x61\x34\x96\x01\x7f\x3c\x4e\xc2\x0d\x8d\x2c\xde\x72\x66\x8b\xf3\xae\xdf\xd6\x88\x21\xa3\xb4\x9d\x7f\xd5\xb4\x0e\xea\xe5\x77\xe3\xab\x8c\x0c\x3a\xcc\x3e\xc1\x3e\xb3\xf3\x41\x92\xcd\xce\xd0\x8f\x35\x02\x1d\x34\xaf\x1c\x0f\x8d\x1c\x72\x43\x2e\x4d\xc7\x8e\x41\x2a\x3b\xdf\x9a\xb1\x21\xde\x61\xf8\xdf\xc7\xef\xbf\xb9\xe8\x9d\x34\x7e\xf5\xbb\xe6\x36\x29\xc1\x7c\x9b\x9e\x6a\xac\xfd\x9a\x74\xbe\xef\x3e\xb0\x49\xc2\xda\x25\x82\x78\xf5\x22\x37\x75\xf1\x7c\x59\xd7\x5c\xc5\x94\x36\xe0\x8b\xaf\xe2\xb5\x25\x2c\x3d\xb2\x0d\x73\x8b\x94\x4d\xc5\x00\x92\x43\xc0\xcb\xa5\x29\xfe\x40\x86\x3d\xbf\x37\x3c\xfd\xd7\xff\x17\xff\xca\xe2\xe8\xbd\x15\x3a\x4c\xa0\x9b\xbd\x90\xd4\x04\x1d\xdc\xdb\x88\xa3\xd7\x56\x5b\x19\x71\x35\xb2\x01\xef\x35\x76\x36\x78\x73\xc3\xab\xa0\x43\xcf\x45\xe5\x9d\x88\x0b\xe6\xbc\x22\x5a\x28\x97\x17\x43\xa6\x5f\xa5\x38\xe1\x03\x84\xf4\xc2\xab\x64\x20\x8c\x9a\xe2\xcc\xbe\x10\xa4\x7c\xd5\x9e\x58\x30\x99\x40\x23\x8b\x97\x6f\x5e\xc1\xea\xe1\xa3\x8d\x83\x52\x9c\x3e\x59\x0b\xf5\xe3\xb0\xf4\xa8\x8b\x5f\xa8\xc5\x61\x5b\x1d\x81\x4d\xd3\xd5\xb3\x37\x58\x77\xec\xc3\x4a\x06\xa7\x27\x59\x82\x9e\x5a\xd8\x62\x21\xe8\xa6\xe2\x43\xae\xe2\x23\x88\x12\x0b\x7c\x95\xe3\x2f\x1d\xfe\x90\xbf\x7e\x39\x46\x7d\x35\x2b\x39\xd2\x6f\x3b\x92\x17\xc4\xe3\x38\xb2\xdd\x10\x3b\xe6\x87\xd6\x39\x56\x6a\xc8\xcd\x79\xe9\xf8\x0d\x08\xa1\xe2\xb6\x41\x67\xb5\xf4\x94\xdf\xbe\xe0\xa5\x44\x57\x18\x58\xb7\x17\x1b\x2a\xb9\x62\xb7\x6e\xe9\x5b\x76\x4b\x88\xf5\xa9\x6a\xc5\xcb\xc2\xed\x4f\x8f\x14\xbb\x7d\x54\xae\x54\xa1\x0b\x90\x3e\x44\xcc\x07\xe4\xd0\xeb\xb8\x6b\x7d\xf4\xc4\xa7\x59\xa0\x1c\xbb\x33\xf9\xec\x0b\x9d\x4f\xcd\x32\x47\xa2\x15\xc9\x21\xc0\x87\x7a\x68\x73\x7b\x1b\x29\xa0\x92\xf8\x5a\x19\xc8\xb0\x1f\x6c\xd7\x60\x5a\xbe\xe0\xf6\xc1\x73\x50\x40\x9b\xb3\x85\x86\x52\xb6\x58\x69\xd9\x88\x9d\x30\x11\x96\x65\xb9\x54\x0a\x1f\xa9\xe9\xd8\xdc\x2f\xe8\x4d\xb1\x3b\x4e\x1b\xb5\x74\x0d\x4d\x3b\x08\x10\x3e\x34\x2d\x6c\x41\xa2\x2f\xdc\xa0\xff\xb3\x96\xe1\xc3\xc7\xee\x81\x5e\x7a\x03\xdf\xf6\x50\x33\x70\xe5\xa9\x34\xa3\xfb\x2b\xc8\xad\xfd\x8d\xf5\x5f\xd9\x88\xf4\xa6\x70\x00\x73\x48\xf2\x24\xdb\x0d\x8d\x9b\xf4\xc6\xa3\x3b\xcc\x65\xf1\x12\xbc\x29\xec\xe2\xe2\xb5\xfe\xb5\xd1\xbe\xe9\xe2\x41\xc3\x04\xfc\xc7\xcb\xf1\xd3\x2b\xe4\x79\x38\x47\x31\x85\x1f\xf1\x51\x41\x87\x2e\xc5\xef\x5b\xd1\xc2\xd6\xe7\x80\x44\x7d\xdb\x98\x72\x86\xc7\xdb\xde\x70\x8f\xd7\x6f\x6c\x91\x66\xe3\xde\xdf\x27\xf4\x3a\x78\xb0\xec\x97\xc6\x9a\xf3\x64\x32\x94\x01\x59\x1f\xce\x06\x10\x1c\x23\x52\xbf\x97\x16\xa5\x99\x2d\xb3\xbb\x02\xdb\x10\x05\xc7\x9a\x00\x82\x9f\x42\xc0\x5d\x41\x31\xcd\xb0\x70\x81\xcf\xac\x83\x86\xf4\xfe\x0d\xbd\x16\x0f\x6a\x82\x2e\x90\x67\xb6\xe8\x0b\xe8\x59\x38\x2b\x67\x4e\xf3\xbc\x5b\xa4\x3b\x29\x7c\xcf\x8a\xb9\x8e\x3b\xae\x7f\x8b\xeb\xf6\xe0\x2b\x56\x8a\xef\x87\xb5\x47\xb7\xf1\x5b\xfb\xd4\xdb\x96\x47\xcf\xb9\xc9\xa1\xe2\xba\xdc\xca\xc7\x5e\x9b\xb3\xf0\x9d\x6b\x20\x58\x94\x1f\xe9\x87\x0f\x7c\xb7\x2f\x24\x97\xd9\xa0\xfb\x79\xfa\x3d\x34\xf0\x83\x3d\xb6\xa0\x32\x5e\x56\xfc\xca\x05\x3e\x66\x6a\x9e\x3c\x41\x98\x51\x6d\x6b\xc6\xc3\x25\x3f\x71\x93\x36\xe8\x4a\x90\xbe\x30\x08\x7e\xcb\x17\x2d\x2b\x39\x35\x38\x50\x34\x75\xe5\x85\x9b\x43\xf2\x1e\xa3\x8a\xe3\x84\xfc\xcc\xe0\x01\xef\x25\x82\xba\xb2\x27\x46\xf8\x11\x26\xdd\x6b\x5f\x78\x62\x5f\x03\xfb\x1a\xe1\x12\xef\x1b\x3c\xd4\xcd\xdb\xf2\xd5\x83\xc3\xe2\x28\xd0\x6b\xa7\x55\xf5\x40\xa7\x09\xcc\x93\x09\x24\xbe\x84\x05\xf2\xfa\xa3\x7b\xa8\xde\x6d\xd8\xa1\xdd\x2f\xc5\x72\xee\x55\xdb\x62\xbb\x23\x47\xad\x2b\xbb\x34\xcd\x0a\x2b\xa3\x8e\xbb\x99\x7f\x8f\xfa\x91\xa4\xf0\x11\x7e\xd8\xb9\xf8\x7b\xf8\x48\xa2\xf0\x67\x75\x0e\xc0\x7e\xcd\x89\xfb\xe9\x16\x00\x28\xa8\x8f\x9d\x79\xf5\xc5\xab\x9e\xf8\x71\xc0\x3f\xf2\x6f\x04\x35\xf9\x2b\xc9\x1e\x67\x05\x29\x28\x72\x03\x1f\xf8\xd6\xa1\x55\x9f\x31\x85\x6f\x25\x9b\xd6\x7d\xb1\xbf\xd3\x48\x33\xbc\xb8\xfc\x8f\x07\xfc\x2f\x06\x92\xc7\x45\x42\x37\x6f\xdd\xbb\x05\x58\x0d\x37\xf9\x6b\xa6\xbf\x8a\xd0\x82\xe7\xcb\xd6\x34\x8b\xd6\x5f\x2f\x01\x28\x29\xb8\xac\x51\x00\x75\xe5\x1f\xfc\x36\x62\xfa\x06\x47\x91\xeb\x6e\x9a\x2e\xc2\xa3\x23\xf8\xca\x0e\x14\xaf\xf5\xf9\xbd\x30\x33\x6e\x9a\xf2\x21\x0a\x90\xda\x45\xa1\x4e\xda\x01\x2f\x01\xf4\x53\x59\xe2\x35\xf9\x06\x0f\x3f\xea\x4d\x73\x65\x3f\x8e\xa1\xae\x10\x43\x74\x0d\xb8\x00\xff\xd5\x05\x36\x4d\x5e\xa5\x37\xb9\x35\x05\xd7\x2a\xb1\x94\x93\x2d\xed\x16\xd0\x73\x29\x5b\x2f\x9d\xaf\x1e\x70\x0f\x81\x17\xa7\xf2\xcd\xc2\xbc\xe0\xf5\x3b\xd6\xa2\xd9\x61\x86\xe1\xd9\x44\x77\x10\x1e\x7a\x85\x97\x52\x98\x4d\xb9\x39\x72\xa3\x5d\x23\x00\x7f\x7b\x40\xd1\xc0\xe0\x47\x40\xf8\xbc\x6a\x8a\x11\x00\x92\x43\x15\x74\xe5\xdc\x45\x78\xfb\x6f\x66\xa6\x3e\xea\xf4\x6d\x86\xed\x8e\x73\x23\xc5\xa2\x0b\x51\xef\xf0\x95\xc1\x4d\x8c\x1a\x82\xc4\xe5\x70\xd3\xe7\xfb\xb4\x99\x7a\x36\x5f\xe1\x51\xba\x38\x71\xf8\xa7\xb8\x9a\x98\x87\x15\x93\x46\x2c\x79\x27\x4f\x46\xe5\xaf\x2d\x41\x28\x86\xa7\x18\x58\x51\x28\x8c\xfe\xcf\x5f\x4d\x43\x89\xf0\x96\xdb\xc7\x37\x81\x47\xd9\x80\xd6\x15\x2d\xfc\xfd\xdf\xfb\x93\xf7\x39\xe8\x80\x0e\x9a\x27\xe7\x41\x90\xbb\xf4\x06\xc1\x5a\x7e\xf8\x0b\x39\x07\x7a\x90\xb0\x25\x08\xdc\x16\x63\xd6\x1b\x41\x26\x32\x0a\x8e\x8f\x6d\x71\xd1\xb2\x29\x78\x00\xd1\x91\xd5\x39\x2f\x3f\x92\x03\x7d\xea\xdd\x13\xd5\x57\x82\x0c\xd5\xe7\xbb\x7e\x13\xae\x5d\xdb\x86\x39\xac\xb6\xee\xd8\x20\xcc\xb3\x02\x7f\x4b\xd1\x7b\x80\xc7\xc3\xe8\x43\x48\xb4\xb6\x1c\xa6\xf2\x03\x8c\x03\x15\xdc\x90\xe3\x8a\x3c\x04\x21\xe9\x7d\xc4\xd8\xe9\xd0\x3a\xfb\xb2\x38\x46\x14\x2b\xa3\x5a\x6d\x76\x39\xb1\x5d\x92\xb9\x82\x3d\xa6\x6e\x87\xe4\x22\x16\xd8\x17\x47\xf1\x01\x66\x27\x2d\x67\xca\x8b\x11\x8f\xf3\x76\xae\x30\x71\xee\xd1\x58\x6f\xc6\xbe\xa3\x51\xaf\x06\x0f\xeb\x5f\xe4\x93\x2c\xa7\x2c\xb7\x31\xba\xc3\x72\x97\xf5\xbf\xf8\xf3\x21\xce\x84\xce\x41\x2c\xe7\xd7\x58\xf9\x93\x35\x70\xb1\x9c\xeb\xbc\x2f\x49\x74\x29\x0c\x53\x1c\xe3\x48\x72\x5c\x74\x71\xe5\x20\xcd\x8c\x77\xd5\xf0\xb4\x11\x65\xbb\xac\x7c\x75\xf3\x96\xb7\xed\x31\xfd\xa0\xe5\x7e\xc1\xb1\xb2\x84\xe3\x8d\xa2\x4b\x03\x43\xcf\x79\x66\x6b\xe8\x7f\x2e\xa5\xf1\xb9\x77\xaf\xd7\x75\xb5\x37\xe5\x21\xff\x47\xd0\x32\x48\x1f\x78\x90\xbe\x20\xb7\x11\xfe\x0f\xa3\x24\xe2\xe9\xc6\x76\x97\xd6\xf8\x92\xdc\x23\x01\x82\xbf\x7f\x10\xdc\x75\x67\x22\xf4\x33\xca\xe2\x8c\x29\xcd\x71\x09\xc1\xdc\xab\x50\xbe\xbf\x41\xca\xb2\x03\x39\x0f\xfa\x95\x54\x73\x66\x2c\xec\xeb\xec\x40\x64\xc3\xc0\xae\xa9\xe1\xfd\x76\x7c\x5f\x0b\xca\xec\x72\xf8\xee\x69\x0e\xff\xfe\x7f\xf4\x0a\x6e\xf2\x10\xe5\xbd\x9c\x8b\xd6\x8f\x22\x44\xfb\x3c\x4e\x46\x35\xf3\x39\xaf\x08\x23\x1b\xb2\x5d\xa8\x66\x7e\xbe\x40\xab\x0d\x39\xf8\xb0\xc4\x4a\x5b\xf1\xa5\x7c\x92\xc1\x5f\x7f\xed\x5d\x72\x99\x64\x9f\x47\x06\xbe\x0e\xbf\x55\xe8\xd9\x55\x77\xe3\x3f\xd0\x72\xd4\xe7\xde\x4c\x82\x76\x51\x1c\x45\x6e\x73\x45\xe1\x98\x3f\x27\xeb\x73\x8e\xe7\xf7\xd6\x3f\x26\xfe\x87\x30\x3e\xec\x79\x34\xdc\x9c\x6c\x09\x37\x31\x18\xf2\x27\x92\xa2\x1d\x1d\xed\x38\xd8\x46\xec\x28\x98\xef\x1e\xb0\xc4\x19\x23\x01\x22\xa3\xf3\xae\x6c\x13\xbb\x57\xcb\xb6\xb5\x14\x6c\xc5\xc8\x85\xec\x16\x2f\xab\x32\xef\x58\xdb\x54\x54\x3e\x27\x6e\x7f\xa6\x44\x7a\x8f\x38\xb8\x8e\x6e\x58\xbb\xe4\x59\xbc\x8e\xff\x67\x00\xbd\xfd\xb0\xa2\x8d\x3e\x00\x00")
+
+func cmdNameCliMainGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_cmdNameCliMainGoTpl,
+		"cmd/NAME-cli/main.go.tpl",
+	)
+}
+
+func cmdNameCliMainGoTpl() (*asset, error) {
+	bytes, err := cmdNameCliMainGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cmd/NAME-cli/main.go.tpl", size: 16013, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb7, 0x9, 0x8, 0xd, 0x47, 0x2d, 0x3f, 0x9c, 0x9e, 0xec, 0xe0, 0x95, 0xbe, 0x2f, 0x55, 0xbe, 0x1e, 0x6d, 0x78, 0x9c, 0xd3, 0xb9, 0x91, 0x58, 0xc3, 0xf5, 0x94, 0x1e, 0x76, 0x8b, 0x70, 0xd2}}
+	return a, nil
+}
+
+var _cmdNameMainGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\xb1\x6e\xc2\x30\x18\x84\xe7\xfc\x4f\xf1\x2b\x53\x32\x34\xde\x91\x98\x48\x07\x96\x82\x80\x76\x37\xc9\xd9\xb1\x08\x0e\xb2\x9d\xa0\x2a\xf2\xbb\x57\x0e\xb4\x62\xe8\x64\x5b\xdf\x9d\xee\x7c\x42\xf0\x66\x68\xc1\x1a\x16\x4e\x06\xb4\x7c\xfe\xe6\x4e\xde\x2f\x15\xd7\x3b\xfe\xd8\x9d\xf8\xbd\xde\x9e\x2a\x12\x82\x0f\x70\xa3\xb5\xc6\xea\x85\xf3\xdd\xf4\x3d\x0f\x13\xdc\xdd\x99\x00\x0e\x9d\xf1\xac\x4c\x8f\x45\xfb\x05\xe7\xcd\x60\x57\x3c\xcf\xd5\xf3\x1e\xe3\x0b\xe0\x5a\x06\xbc\xd2\xf4\x8e\x91\xe8\x26\x9b\x8b\xd4\xe0\xab\x34\x96\xc8\x5c\x6f\x83\x0b\x5c\x50\x96\xab\x5e\xea\x9c\x28\x13\x82\x4f\x29\xea\x08\x37\x99\x06\x94\xe5\xf3\x5c\x6d\x17\xdd\x5e\x86\x8e\xdf\x62\x64\xe1\xa7\x46\x78\xb8\x09\x2e\xff\x5f\xd0\x49\xdb\xf6\x70\x3e\xa7\x92\x48\x8d\xb6\x59\x02\x8b\x92\xe7\x25\xe1\xf3\xd6\xca\x00\x96\x6d\xeb\xe0\x3d\x3c\x1b\xc5\xa1\x43\x5a\x66\x02\x9f\x01\xfb\xf7\xf3\x00\x9b\x26\x4b\xf5\x3c\x65\xe9\xa8\xf6\xd2\x79\x14\x25\x51\xd6\x28\xcd\xab\x35\x3f\xaa\x54\x35\x94\x1c\xfb\xb0\x19\xac\x32\xfa\x01\xd7\xfc\xdb\xa4\x3a\xe2\x49\x8a\x46\xe9\x64\x7e\xba\x0e\xa3\x2d\x1a\xa5\x4b\x8a\xf4\x33\x00\xa6\x55\x21\xaa\xad\x01\x00\x00")
 
 func cmdNameMainGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -100,7 +122,7 @@ func cmdNameMainGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _handlersHandlersGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x94\x41\x8f\xd3\x3c\x10\x86\xcf\x99\x5f\x31\x8a\xaa\x55\xb2\xea\xba\xf7\x4f\xfa\x2e\x20\x16\x81\x96\xb2\xa2\x20\x8e\xc8\x9b\x4c\x13\x8b\xc4\x0e\xf6\xa4\xdd\x95\xe5\xff\x8e\xdc\x98\x92\x76\x55\xb8\xac\x84\x2f\x89\xc7\xf3\x8e\xfd\xbc\x63\x79\x90\xd5\x77\xd9\x10\xb6\x52\xd7\x1d\x59\x07\xa0\xfa\xc1\x58\xc6\x02\xb2\xbc\x32\x9a\xe9\x91\x73\xc8\xf2\x46\x71\x3b\x3e\x88\xca\xf4\x2b\xa7\xec\x38\x38\xd2\xab\xce\x34\x76\x74\x39\x40\x36\x3c\x60\xee\xbd\xb8\x7f\xf5\xee\x20\xbe\x97\xdc\xe2\x4d\x08\x39\x94\x00\x3b\x69\xf1\xce\x34\x0d\x59\xbc\x9e\x14\xe2\x8d\x66\xfb\x04\xb0\x5a\xe1\x9a\xf6\x1b\xb2\x3b\x55\x11\x5a\xe2\xd1\x6a\x87\x12\xb5\x54\x3b\x5a\xa2\x63\xc9\xd4\x91\x73\xa8\xfa\xa1\xa3\x9e\x34\x4b\x56\x46\xa3\xd9\x62\x12\x09\xd8\x8e\xba\x9a\x55\x29\x4a\x1c\x1e\x84\xf7\x6f\xcd\x5a\xf6\x84\xe2\x57\x5e\x9c\x85\x10\x67\x64\xd1\x43\xd6\x99\x06\xff\xfb\x1f\xd3\x79\xd6\xb4\x2f\xca\x43\x50\x6c\x88\x6f\x8d\xed\x25\x33\xd9\xe2\x2a\xad\xbf\xdf\x7c\x5c\x1f\xa3\x3e\x94\x90\x25\xa0\x43\x05\xf1\x55\x71\x7b\xab\xa8\xab\x8b\xdc\x4d\xfb\xe5\xcb\xe8\xc7\x67\x73\x67\xf6\x64\xcf\x4f\x91\x97\x00\xd9\x44\x8b\x17\x93\xd2\xcc\x07\x08\x00\xfc\x34\xd0\x5f\x53\xd1\xb1\x1d\x2b\xf6\xb1\x1b\xe2\x8b\x3e\x7a\x46\xf5\x9f\xed\x88\x1b\x78\xbf\x57\xdc\xe2\x82\x29\xba\x22\x30\x04\xc8\xbc\xb7\x52\x37\x84\x0b\x15\x63\x0b\xa6\xa3\xfa\x03\x71\x6b\x6a\x17\x93\x32\xef\x51\x6d\x71\xa1\xc4\x27\xfa\x31\x92\xe3\x0d\x5b\x92\x7d\x2c\x80\xb3\x71\xe8\x52\xe1\x66\x0c\xf3\x7a\x27\x18\x25\x7a\x9f\x22\x85\x9b\x8a\xcd\x3b\xfa\x5c\xf7\xed\x37\xde\x1c\xab\x44\xb2\xd6\xc4\x66\xe3\xd9\x48\xd6\x6b\xd5\x9d\x2c\x25\x1a\xea\x1c\x1d\x91\xdc\x60\xb4\xa3\x17\x65\x52\x1a\xaf\x4f\xae\x68\x32\x2e\x84\x78\xe3\xff\x11\xef\xcb\xa0\x55\xfc\x88\xe9\xc9\x10\xaf\xa7\xef\x12\x2f\xf3\x96\x58\x9c\xaf\x4c\x7e\x47\x2b\x0e\x30\xe5\x73\x98\xf8\x9a\x58\x72\x03\x5e\x50\x5e\xa2\xbf\x8a\xa2\xe5\x45\x13\x74\x9d\xee\x3c\xe9\x3a\x04\xf0\xfe\x06\xa7\xbf\x9f\x01\x00\x00\xff\xff\xc5\x90\xf0\xc5\x20\x05\x00\x00")
+var _handlersHandlersGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x94\x41\x6f\xd4\x30\x10\x85\xcf\xf1\xaf\x18\x45\x55\x95\x54\x5b\xaf\xb8\x22\x71\x00\x44\x57\xa0\xb2\x54\x6c\x11\x47\xe4\x26\xb3\x59\x8b\xc4\x4e\xed\xc9\xb6\xd5\xc8\xff\x1d\x39\xce\x86\x02\xed\x1e\x40\x9c\x22\x8d\xdf\x9b\xf9\xe6\xc5\x72\xaf\xaa\xef\xaa\x41\xd8\x29\x53\xb7\xe8\xbc\x10\xba\xeb\xad\x23\x28\x44\x96\x57\xd6\x10\xde\x53\x2e\xb2\xbc\xd1\xb4\x1b\x6e\x64\x65\xbb\xa5\xd7\x6e\xe8\x3d\x9a\x65\x6b\x1b\x37\xf8\x5c\x88\xac\xbf\x81\x9c\x59\x5e\xbd\x79\x3f\x9a\xaf\x14\xed\xe0\x3c\x84\x5c\x64\xcc\xe7\xe0\x94\x69\x10\xe4\xca\xa6\x53\x1f\x82\xc8\x32\x66\xf9\xba\xd5\xca\x87\x90\xac\x8a\x76\xb3\x01\x4d\x1d\x82\x28\x85\xd8\x2b\x07\x97\xb6\x69\xd0\xc1\x59\x9a\x26\xdf\x19\x72\x0f\x42\x6c\x07\x53\x81\x36\x9a\x8a\x12\x58\x64\xad\x6d\xe0\xe5\x2b\x98\x34\x6b\xbc\x2b\xca\xb1\x28\x37\x48\x17\xd6\x75\x8a\x08\x5d\x71\x3a\x9d\x7f\xd8\x7c\x5a\xcf\x55\x0e\x65\xc2\xd4\x5b\xc0\x5b\x28\x5a\x34\x20\x37\xe8\xf6\xba\x42\x5f\xc2\x8b\x91\x76\x82\x18\x27\xc8\xaf\x9a\x76\x17\x1a\xdb\xba\xc8\x7d\xd2\xe5\x0b\xc8\x99\xaf\xed\xa5\xbd\x43\x37\xbb\xe5\x5a\x75\x18\x42\x3e\xf5\xc7\xd6\xe3\x1f\xcd\x26\xdc\x71\xab\xa2\xb5\xcd\x41\x3b\x26\x10\x04\x73\x0a\xef\xc4\xef\xab\xb8\xe0\x0c\x16\x82\x58\x2e\x61\x8d\x77\xcc\xf1\x4c\xae\xec\x95\xc3\xad\xbe\x0f\x61\x52\x80\x43\x1a\x9c\xf1\xa0\xc0\x28\xbd\xc7\x05\x78\x52\x84\x2d\x7a\x0f\xba\xeb\x5b\xec\xd0\x90\x22\x6d\x0d\xd8\x2d\x4c\x5d\x12\xb0\x4c\xe9\x1e\x69\x5e\x94\xd0\xdf\x48\xe6\x95\x8d\x0e\x78\xe4\x8d\x02\x74\xf1\x97\xa4\xf9\xf0\x33\x96\xdf\x54\xba\x42\x0e\x22\x08\x41\x0f\x3d\x1e\x95\x81\x27\x37\x54\xc4\xf1\x9e\xc9\x2f\x66\xa6\xc7\xfa\x79\x82\xd8\x78\x0e\x4f\xc7\xe8\xc6\xf1\x1f\x91\x76\xb6\x1e\x6f\x20\x33\xe8\x2d\x9c\x68\xf9\x19\x6f\x07\xf4\xb4\x21\x87\xaa\x83\x78\x94\x8d\xfb\x17\xfe\x28\x55\x09\xcc\x53\xa5\xf0\xc9\xfb\x4c\x26\xdf\xe6\xe2\x54\x88\x1d\xd0\x95\x80\xce\xd9\x31\xab\xec\x90\x96\xd1\xad\xc8\xb2\x44\x17\xef\xcb\x8c\xe8\x7b\x6b\x3c\xfe\x0b\xa3\x36\x70\xc6\x2c\x57\x76\xda\xf7\xfa\xa1\xc7\x10\x16\xf0\xff\xd8\xff\x0a\xb3\xa2\x7b\x98\xde\x1d\xf9\x36\x7d\x17\xf0\x34\x7b\x09\xc5\xa1\x9a\xe2\x39\xac\x34\xe6\x5a\x8e\xb9\xc6\x17\xc4\xa1\xef\xe1\x09\xe1\x23\xf6\xd3\xa8\x59\xfc\xba\x82\xa9\x63\xd0\xcc\xe9\x35\x62\x3e\x07\x34\x75\x08\xe2\xc7\x00\x4a\x1c\x71\xca\x33\x05\x00\x00")
 
 func handlersHandlersGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -115,12 +137,12 @@ func handlersHandlersGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "handlers/handlers.go.tpl", size: 1312, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x0, 0xb5, 0xc3, 0x96, 0xb1, 0xf7, 0x54, 0xe5, 0x4d, 0x90, 0x66, 0x21, 0xf, 0x70, 0x79, 0x62, 0xd7, 0xc9, 0x6d, 0x4e, 0xd1, 0xd7, 0xa2, 0x8d, 0x4a, 0xa2, 0xee, 0x13, 0x88, 0x32, 0x75, 0xdb}}
+	info := bindataFileInfo{name: "handlers/handlers.go.tpl", size: 1331, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd6, 0x4a, 0x8b, 0x33, 0x29, 0xd2, 0x9d, 0xb2, 0x7f, 0xcb, 0x8d, 0xf1, 0xc3, 0x39, 0x24, 0xb5, 0x57, 0xc7, 0xef, 0x32, 0x70, 0x4d, 0xc6, 0x7f, 0xfb, 0xe4, 0x5e, 0x2a, 0x50, 0x1a, 0x2c, 0x34}}
 	return a, nil
 }
 
-var _handlersHandlersMethodsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x92\xc1\x4e\xf3\x30\x10\x84\xcf\xcd\x53\xcc\x21\xfa\x95\xfc\x0a\x7e\x00\x24\x4e\x1c\xb8\x00\x07\xca\x1d\x85\x64\x4b\x2d\xb5\x76\x58\x3b\x6d\xa5\xd5\xbe\x3b\x4a\x9d\x06\x4a\x95\x5b\x25\xf6\x62\x7b\x47\xb3\xf6\x37\x72\x26\x82\xbd\x8d\x6b\xe4\x91\x70\x7b\x07\xa3\x9a\x01\x80\x08\xd7\xee\x83\x90\xdb\x63\xf7\x89\xe2\xda\xb7\x41\x35\x5b\x2c\x44\x60\x57\xc8\xad\x79\xa1\xcf\x9e\x42\x5c\x46\xa6\x7a\x8b\xd1\x78\xaa\x55\xef\x1a\x14\x01\x22\xaf\xfe\xd1\xef\x89\x87\x1b\xcc\x92\x78\x67\x1b\x7a\xae\xb7\xa4\x3a\x1e\x4a\x88\x98\xd4\x29\x42\x9a\xd5\xbd\x1b\x91\x07\x3f\x34\x2f\x6d\x6f\x93\x64\xbe\xe7\x10\x97\x20\x66\xcf\x90\xb3\x67\x0c\xc5\x14\x7b\x76\x70\x76\x73\x26\x8d\x2c\xb4\x09\x34\x01\x85\xce\xbb\x40\xd7\x24\xb2\x0e\xff\x7f\xe2\x9c\x52\x53\xad\xf0\x57\xb4\x57\x01\x6b\xe2\x01\x8d\x77\x91\x0e\xd1\xdc\xa7\xb5\xc2\x3c\x6d\x89\xe2\xb7\x92\xc2\x1e\x82\x38\xb2\x94\x97\x2c\xbb\x9a\xc1\x14\x3a\xcc\x38\xe7\xe0\xff\x0d\xa6\x6a\x36\x03\xd7\x62\xfa\xe6\xe4\x5a\xd5\x4c\xe4\x06\x69\xf7\x15\x00\x00\xff\xff\x66\x64\x40\x3b\x11\x03\x00\x00")
+var _handlersHandlersMethodsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x93\x4f\x6b\xdc\x30\x10\xc5\xcf\xf2\xa7\x78\x87\x50\xd6\x65\xe3\xdc\x0b\x3d\xf5\xb0\x97\x36\x94\x26\x3d\x17\xc7\x7e\xdb\x08\x76\x25\x57\x9a\xfd\x13\x06\x7d\xf7\x22\xcb\x5e\x68\xb2\x49\x73\x08\xc4\x3e\x18\x66\x34\xe3\xf7\x7b\xcf\xae\x54\x71\xb0\x72\x8f\x0b\x21\x3e\x7d\x46\x93\x52\x65\x54\x2f\x61\xd7\xb9\xd4\x7c\xf1\x2e\x4a\xd8\x75\xe2\x43\xee\x98\xab\x2b\x5c\xf3\xa0\x9a\x7b\x2b\xff\x3d\x70\x6d\x8f\x29\xdd\x30\xec\x6d\x47\x04\xca\x2e\xb8\x88\x16\xae\xb5\x7b\x2e\x11\xa5\x15\x6e\x18\x23\xec\x76\xd8\x70\x4b\x27\xad\x58\xef\xe0\xd7\x28\x4b\xa6\xd1\xeb\x76\xcb\x94\x9a\xca\x98\xf5\xce\x75\x2f\xbc\x63\x51\x63\xb8\x6b\x54\x57\x3e\x8f\xe0\xc9\x8a\x7c\x8c\x01\x5a\x19\x63\x8a\x1c\xa8\xde\xfa\xaf\xfe\xc0\x70\xfe\xb4\xed\xa8\x19\x6d\x02\xa7\xeb\x1f\x79\x70\xfb\x30\x30\xa5\xaa\x32\x46\x1e\x06\xbe\x62\x1d\x8a\x65\xa3\x86\xe1\xae\xf9\xe9\x4e\xf0\xec\xff\xa7\xfc\xb1\x10\x00\x50\x0d\xad\xfb\x4d\x5c\xd8\x31\xa2\x6f\x94\x7b\xdf\xc7\xac\xd2\xa8\x8e\x49\xd9\xe6\x07\xff\xec\x18\xe5\x46\x02\xdb\x2d\xa6\xc1\xf9\x1e\x3d\x5d\xc4\x57\x48\xaf\xa1\xda\x94\xca\x22\x96\x5d\x2f\xda\xfd\xeb\xd4\x9a\xa6\x72\x97\xa1\x06\x43\xf0\x39\x86\x59\xc2\x7c\x4d\x99\x38\xbb\xf9\xa7\x35\xb1\x70\x13\x79\x02\x8a\x83\x77\x91\x6f\x49\x64\x1d\x3e\xaa\x36\x2b\x3f\xb9\x55\x92\x5d\xe2\xbd\x48\xdf\x04\xaa\x93\x23\x3a\xef\x84\x47\xc9\xbf\x6b\x7e\x2e\x71\x9e\xb4\xc6\x62\xae\x16\x73\x67\x03\xc6\xb4\xea\xa7\x0c\xfb\x36\x20\x30\x0e\x38\x33\xf5\x1c\xf0\x87\x3c\xb0\x7c\x96\xdb\xf5\x33\xb6\x6a\xf9\xc4\x55\x2f\x41\xd7\xa7\x54\xfd\x1d\x00\xf5\x8c\xdb\xaf\x8e\x04\x00\x00")
 
 func handlersHandlersMethodsGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -135,12 +157,12 @@ func handlersHandlersMethodsGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "handlers/handlers.methods.go.tpl", size: 785, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x26, 0x2c, 0x57, 0x30, 0xb3, 0xdb, 0xb5, 0x5a, 0x84, 0x38, 0x24, 0x7c, 0x45, 0x1c, 0x2, 0x1e, 0x1d, 0xec, 0x1, 0xa6, 0x6a, 0xba, 0xc4, 0x6d, 0x9c, 0x2, 0x42, 0xf3, 0x4e, 0x1, 0xb6, 0x47}}
+	info := bindataFileInfo{name: "handlers/handlers.methods.go.tpl", size: 1166, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x66, 0xa6, 0xa1, 0xc2, 0xc3, 0xce, 0xb4, 0x6a, 0x75, 0xa9, 0xba, 0x6, 0xea, 0x19, 0x3c, 0x9c, 0x62, 0xb7, 0x12, 0xe3, 0xa2, 0xb4, 0xcd, 0x6b, 0xca, 0x85, 0x1c, 0x16, 0x62, 0xac, 0x5c, 0x62}}
 	return a, nil
 }
 
-var _handlersHooksGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x90\xd1\xaa\xe2\x30\x10\x86\xaf\x33\x4f\x31\x14\x16\x5a\xb0\x2d\x7b\xbb\xb8\x57\x22\xbb\xbd\x38\x22\x47\x5f\x60\x88\x93\xa6\xd8\x26\x32\x99\x2a\x22\x7d\xf7\x43\xad\x07\x8e\x37\x21\x5f\x86\x3f\xff\x97\x5c\xc8\x9e\xa9\x65\xf4\x14\x4e\x3d\x4b\x02\xe8\x86\x4b\x14\xc5\x1c\x4c\xe6\x06\xcd\xc0\x64\x8f\x47\xd5\x3c\x0f\xf7\xa4\x1e\xcb\x69\xc2\x3a\x5d\xed\x3c\x89\x69\x59\xeb\xd4\xb5\x81\xfa\x19\xd2\x3d\x59\xea\xfb\x0c\x0a\x00\x37\x06\x8b\x07\xd6\x4d\x0c\xae\x6b\x73\xeb\x5a\x4c\x57\x5b\x2d\x58\xfc\xd8\xe3\x03\x8c\xb0\x8e\x12\xd0\xba\x16\xa6\x57\xb4\x09\xca\x22\xe3\x45\xff\x2f\x76\x39\x8b\x6c\x3c\x05\xb4\x9e\xc2\xba\x44\x16\x89\x52\xcc\x61\x8b\x7f\xfe\xe2\x40\x67\xce\xe7\x11\xc6\x54\x1d\x9e\x46\x2b\xfc\x5d\x80\x59\xec\xaa\x5d\xd4\xce\xdd\x73\xbb\xc2\x97\x64\x75\x68\xfe\x35\xbb\xe3\x1b\x1f\xb7\x9f\x1f\x05\x18\x65\x19\xba\x40\xca\xdb\xb9\x63\xbe\xdd\x0d\x5a\x3d\xc1\xe5\xd9\xaf\x94\xad\x70\x5d\xda\x02\xc0\xd4\x35\xee\x7b\xb2\x8c\x37\x4f\xca\x57\x16\x4c\x7e\xd4\x53\xbc\x85\xe5\x53\xbb\xd0\xe2\x3d\x8e\x78\xa3\xa0\xe8\x59\x18\xc0\x7c\xbf\x63\x5d\xe2\x7b\x11\x4c\xf0\x15\x00\x00\xff\xff\xf0\x19\x98\x3b\x92\x01\x00\x00")
+var _handlersHooksGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x90\xd1\xaa\xe2\x30\x10\x86\xaf\x33\x4f\x31\x14\x16\x5a\xb0\x2d\x7b\xbb\xb8\x57\x22\xbb\xbd\x38\x22\x47\x5f\x60\x88\x93\xa6\xd8\x26\x32\x99\x2a\x22\x7d\xf7\x43\xad\x07\x8e\x37\x21\x5f\x86\x3f\xff\x97\x5c\xc8\x9e\xa9\x65\xf4\x14\x4e\x3d\x4b\x02\xe8\x86\x4b\x14\xc5\x1c\x4c\xe6\x06\xcd\xc0\x64\x8f\x47\xd5\x3c\x0f\xf7\xa4\x1e\xcb\x69\xc2\x3a\x5d\xed\x3c\x89\x69\x59\xeb\xd4\xb5\x81\xfa\x19\xd2\x3d\x59\xea\xfb\x0c\x0a\x00\x37\x06\x8b\x07\xd6\x4d\x0c\xae\x6b\x73\xeb\x5a\x4c\x57\x5b\x2d\x58\xfc\xd8\xe3\x03\x8c\xb0\x8e\x12\xd0\xba\x16\xa6\x57\xb4\x09\xca\x22\xe3\x45\xff\x2f\x76\x39\x8b\x6c\x3c\x05\xb4\x9e\xc2\xba\x44\x16\x89\x52\xcc\x61\x8b\x7f\xfe\xe2\x40\x67\xce\xe7\x11\xc6\x54\x1d\x9e\x46\x2b\xfc\x5d\x80\x59\xec\xaa\x5d\xd4\xce\xdd\x73\xbb\xc2\x97\x64\x75\x68\xfe\x35\xbb\xe3\x1b\x1f\xb7\x9f\x1f\x05\x18\x65\x19\xba\x40\xca\xdb\xb9\x63\xbe\xdd\x0d\x5a\x3d\xc1\xe5\xd9\xaf\x94\xad\x70\x5d\xda\x02\xc0\xd4\x35\xee\x7b\xb2\x8c\x37\x4f\xca\x57\x16\x4c\x7e\xd4\x53\xbc\x85\xe5\x53\xbb\xd0\xe2\x3d\x8e\x78\xa3\xa0\xe8\x59\x18\xc0\x7c\xbf\x63\x5d\xe2\x7b\x11\x4c\xf0\x35\x00\xf0\x19\x98\x3b\x92\x01\x00\x00")
 
 func handlersHooksGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -160,7 +182,7 @@ func handlersHooksGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _handlersMiddlewaresGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x57\xdd\x6e\xdb\xb8\x12\xbe\x96\x9e\x62\xaa\x9b\x23\x1d\x38\x52\xaf\x7b\x10\xe0\xec\x06\x69\x53\x20\x6d\x83\x26\x45\x17\x28\x8a\x0d\x2d\x8e\x24\x22\x14\xa9\x92\xa3\x38\x46\xe0\x77\x5f\x0c\x45\xd9\x56\xda\x66\xbb\xd8\xab\x58\x1c\xce\x37\xdf\xfc\x33\x83\xa8\xef\x44\x8b\xd0\x09\x23\x35\x3a\x9f\xa6\xaa\x1f\xac\x23\xc8\xd3\x24\xab\xad\x21\x7c\xa0\x2c\x4d\x86\x35\x64\x8f\x8f\xe5\xd5\xef\x6f\x83\xf4\x4a\x50\x07\x27\xbb\x5d\x96\x26\x7c\xbc\x3c\x84\xca\xdf\xd7\x2c\x31\x48\xd5\xe8\x34\xff\xf4\xe4\x94\x69\x7d\x96\xa6\x49\xd6\x2a\xea\xc6\x75\x59\xdb\xbe\x6a\xad\x6d\x35\x56\xe3\xa8\x64\xb6\x94\x18\xa5\x54\x6b\x6d\xd5\x89\xcd\x5d\x35\xdc\xb5\x55\xaf\xa4\xd4\xb8\x11\x0e\x9f\xdc\xf4\xca\x8d\x83\x47\x53\x69\xdb\xba\xd1\xcf\x86\x3b\xa2\x21\x4b\x8b\x34\xad\x2a\xf8\xec\xc4\x70\x6e\xe4\x60\x95\x21\x0f\xa2\xae\x71\x20\x0f\xd4\x21\x78\x74\xf7\xaa\xc6\xff\x78\x40\x43\xca\x21\xd4\x56\x6b\xac\x49\x59\x03\xb6\x01\x9c\x95\x56\xe0\x2d\x50\x27\x08\x04\x03\x7a\x24\x16\x1f\x38\x79\xa8\x85\x81\x35\xc2\xc6\x89\x61\x40\x09\xc2\xd9\xd1\x48\xc0\x7b\x74\xdb\xa3\x7b\x90\x63\xd9\x96\xab\xc0\xc1\x7b\x86\xd2\xb6\x6d\x95\x69\x41\x18\x09\xca\x78\x72\x63\x8f\x86\x04\x33\x28\x56\xe1\xd4\x52\x87\xce\xef\x91\x3d\x06\x82\xf7\xa8\xb7\xb3\x15\x6f\x7b\x64\x2c\x3c\xf8\x68\x24\x18\x4b\xb3\x6e\xb4\x7a\x90\x3b\xfc\x36\x2a\x17\xec\x8e\xd4\xb1\xef\xb5\x20\xa6\x1d\x78\x15\x25\xa3\xbd\xb7\x84\x93\xcf\x1c\xa9\x46\x19\xa1\x8f\x3d\x99\xf9\x6c\x94\xd6\xec\x39\x5f\xb2\x23\xa1\xeb\xad\xa7\xa3\x8b\x0c\x95\xab\x12\x4b\x10\xc3\xa0\x15\x4a\x68\x94\xf3\x54\xa4\xcd\x68\xea\x65\x6a\xf2\x98\x0e\x18\xd6\xe5\xe3\x63\x79\x3d\x7d\x95\xef\x45\x8f\xbb\x1d\x7f\xa1\x5b\x81\x32\xe0\xef\xeb\x72\xaf\x54\x2c\x3f\xe1\x31\x4d\x94\x29\x19\xf7\x37\xad\x2f\xc5\x1a\x35\xca\xf3\x07\x4e\x79\x7e\x20\x55\x9e\x09\xaa\xbb\x2b\x61\x54\x5d\xa4\x69\x52\x55\x70\x25\xbc\x07\x71\xec\xe0\xd6\x8e\xb0\x11\x86\xf6\xbc\xc9\xc6\x7c\xce\x71\x2c\x83\xa6\x1d\x38\x59\x42\xeb\x2d\x0c\x0c\xa2\xcc\x51\xa0\xd7\x5b\x30\xa2\x8f\x71\xdc\x23\x92\xe5\x88\xe1\x43\xad\x47\x89\x32\xa0\x70\x86\xc2\x8f\x03\xf9\xc8\x9a\x33\xf4\x6e\x4f\x6b\x05\xd9\x35\x09\x1a\x7d\xb6\x82\xec\x4a\x99\x36\x3b\x76\x40\x19\x10\x21\x1e\xd1\xf1\x77\xff\xdc\x9d\x9b\x0e\x3d\x2e\x4a\xbb\x45\x0a\x9e\xb1\x4e\x87\x47\xce\x05\xcf\x44\xe8\x24\xe5\xa6\xb4\x82\x70\x6d\xa8\x60\xd8\x74\x68\x66\x5b\x33\xb2\xda\xf7\xc9\xe8\x27\x06\x1b\xa7\x08\xa1\x45\x83\x4e\xd5\xd0\x23\xf1\x9f\x56\x70\xd9\x72\x75\x1e\xd3\x08\x21\xac\x85\x09\x58\x0e\xc3\x94\x3a\xe6\x33\xd1\x69\xac\x83\xc6\x21\x4e\x26\x7f\x36\x51\x0e\xb8\xd5\xde\xfb\xd6\x06\x65\x61\x00\x1f\x44\x3f\x68\x7c\x9a\x8f\x65\x31\xa1\x73\xd6\x9d\xd9\xd1\x10\xba\xdc\x93\x20\x2f\xe3\x57\xf1\xd3\x1c\x5d\xd8\x0d\x3b\xcd\x51\xd9\x2e\x8b\x8d\x4f\xc1\x2b\xd3\x6a\x7c\x92\x0f\x65\xca\xf3\x89\xcf\x5c\xe3\x70\x0a\xcb\x9a\xc8\xbf\xbf\x53\xa4\x29\x00\x40\x55\xc1\xb5\xed\x97\xe9\x24\x0b\xaa\x1f\x9c\xbd\x9f\x7a\x76\x1e\x40\xb6\x09\x53\x01\x3d\xf9\x59\xf5\x93\x47\xb8\x3d\x6a\x9a\x37\x48\x97\xb6\x6d\xd1\xdd\x4e\x15\x6c\xb0\x51\x04\x8d\xb3\x3d\x28\xfa\xa5\xb6\x9b\xe9\x5d\x4e\x46\xf3\x09\x6e\x05\x46\xe9\xa2\xf8\x25\x84\x49\xe3\xc6\x9e\x4d\xbb\x29\x22\x2c\x95\x3f\x2b\xea\x2e\x88\x86\x0f\xa1\x33\xff\x16\xe3\xe2\xe6\xe6\x6a\xcf\x84\xa7\x52\xee\xe0\xbf\xbc\x3f\xca\x8f\x53\x40\x0a\x98\x76\x4b\xf9\x5a\xa1\x96\x61\xc4\x24\xcd\xf4\xf3\xd5\xe9\x52\xc6\xa2\x24\xeb\x91\x3a\x2b\xb3\x57\xe0\xca\x77\xe1\xe7\x2a\x1c\xf3\x32\x7c\xc5\xb1\x75\xe5\xa7\x8f\x97\xe5\x75\x58\x8a\x79\xc1\xc2\x5d\x9a\x26\x9c\xed\x98\xc6\x50\x89\x31\x1d\x27\x6b\x11\x9a\xc5\x89\x5a\x99\x36\x4d\x12\xd5\x80\x92\x6c\xd9\x95\x17\x28\x24\x3a\x4e\x4c\x9e\xfd\x71\x12\xe9\x9e\xbc\x95\x59\xf1\x3f\xbe\xf3\xe2\x14\xb2\x2c\xd0\x8d\x7c\xbf\x64\x11\xf4\x4f\x25\xb3\xaf\x70\x0a\x4a\xb2\x71\x40\xed\xf1\xd9\x7b\xbc\xa2\xcb\xf7\xb8\x99\x39\xff\x84\xb2\x47\xef\x95\x35\xbf\x4e\xf9\x3a\x2a\x3c\x47\x39\x82\x2e\x29\xb3\x71\x87\x34\x3a\x03\xd3\xbd\x34\xd9\x15\xdc\x66\xf1\x50\x99\x74\x97\x1e\x76\x4c\xdc\x25\xb9\x32\xcf\x2c\x97\xe2\x19\x19\x53\x5a\x40\xf3\xbb\x02\xd7\xd7\xb6\xbe\x43\x7a\x33\x0a\x27\x61\x70\x96\xb0\x8e\x2f\x8b\xcd\x2c\x3b\xb4\x33\x8f\x56\xa8\xad\x31\xf1\x81\xa1\x3c\x58\xa3\xb7\x30\x0e\xad\x13\x12\x25\xa8\x26\xa8\xb6\x8c\xc6\xf8\xcc\x3e\xdc\x94\x16\x7d\xd8\xe7\x71\xec\xf1\x8c\xe2\xf1\x33\x41\x4e\xb4\x50\x42\x7c\xaf\x31\xf0\xbd\xd0\x4a\x86\x94\x84\x1e\x57\x0d\x92\xea\x91\x9b\x9c\x16\x24\xc2\xa6\xbf\x8d\x7b\xf7\x16\xba\x69\x9a\x03\x6d\x07\x84\xdb\xc7\xc7\x1b\x7b\x69\x37\xe8\xe0\x07\x21\x09\xf7\xf9\x9d\x11\x27\x7a\x2d\x3c\x95\x31\xe0\x8b\xb8\xe4\x35\x3d\xcc\xd4\xca\xd8\x72\x2b\x58\xac\xfa\x37\x96\x71\x7f\x64\x85\x5b\xf2\xbb\x6e\xcc\xbf\x43\x0b\xd1\x28\x8e\x72\x54\xd3\x43\x98\x2a\x4f\x33\xf5\xc1\xa9\x56\x99\xb3\x0e\xeb\x3b\x74\x50\xf3\xdf\xc9\x63\x1b\x04\xd0\x85\xea\x9c\xe3\x14\x9b\x00\xd6\xd8\x58\x87\x31\x51\x3c\x2c\x7f\x10\xc4\xff\x7b\x44\x58\xbc\x6d\x9d\xd2\x5a\x54\x1b\x5c\xfb\xa9\x10\x38\x1b\x3d\xe3\x48\x24\xa1\xb4\x7f\x12\xac\x05\xb5\xfc\xdf\xc4\x67\x6d\xad\xe6\x58\x44\x9f\x8e\xda\xee\x4b\x36\x59\xc9\xbe\xa6\xdc\x93\x1a\x4d\x3e\x5d\x2a\xe0\xf4\x14\x5e\x86\xbe\x8b\x11\x24\x37\x62\x9a\xec\xd2\x64\x0c\xe1\x65\x94\xd1\xe9\xf2\x4a\x38\x8f\x51\xe9\xcb\xcb\xaf\x45\xc0\x61\xf9\x8b\x53\x8e\xf7\x31\x42\x23\xb4\x9f\x20\xe2\x41\xfc\x2f\xa0\x3c\xff\x36\x0a\xfd\xda\x6a\x99\x8f\xe5\x85\xf5\xb4\x62\x7e\x96\xdf\x84\xbb\xf4\xaf\x00\x00\x00\xff\xff\x7e\x81\x2b\x55\x91\x0c\x00\x00")
+var _handlersMiddlewaresGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x4b\x6f\xdc\x38\x12\x3e\x4b\xbf\xa2\x46\x58\x60\xa5\x45\x5b\x9a\x73\x16\x06\x76\x36\xc8\xd8\x03\x24\x19\x63\xec\x20\x0b\x04\xc1\x9a\x2d\x96\x24\xae\x29\x52\x43\x52\x6e\x37\x1a\xfa\xef\x8b\x22\xa9\x6e\xb5\xdb\xaf\x1c\xe6\x94\x8e\x58\x8f\xaf\xaa\xbe\x7a\x24\x03\xab\xef\x58\x8b\xd0\x31\xc5\x25\x1a\x9b\xa6\xa2\x1f\xb4\x71\x90\xa7\x49\x56\x6b\xe5\xf0\xc1\x65\x69\x32\xac\x21\xdb\xed\xca\xab\x7f\xff\xe6\x5f\xaf\x98\xeb\xe0\x6c\x9a\xb2\x34\xd9\xed\xce\xc0\x30\xd5\x22\x94\x17\x3a\xbc\xda\x69\x4a\x93\x64\xb7\x2b\x7f\x91\x82\xd9\x69\x0a\xaa\xcc\x75\x7b\x05\x54\x9c\x64\xe8\xfb\xb1\x41\xa8\xec\x7d\x9d\xa5\x49\xa6\xd0\x55\xa3\x91\xf4\xd3\x3a\x23\x54\x6b\xb3\x34\x4d\xb2\x56\xb8\x6e\x5c\x97\xb5\xee\xab\x56\xeb\x56\x62\x35\x8e\x82\x67\xc7\x2f\x4a\x08\xd1\x6a\x5d\x75\x6c\x73\x57\x0d\x77\x6d\xd5\x0b\xce\x25\x6e\x98\xc1\x47\x92\x56\x98\x71\xb0\xa8\x2a\xa9\x5b\x33\xda\xd9\x71\xe7\xdc\x90\xa5\x45\x9a\x1e\x82\xfb\x9b\xbd\xaf\xe1\xdd\x39\x94\xd7\x68\xee\x45\x8d\x14\x63\x55\xc1\x57\xc3\x86\xdd\x8e\x1e\xcb\x0b\x7d\x65\xb0\x11\x0f\xd3\xf4\x41\xf1\x41\x0b\xe5\x2c\xb0\xba\xc6\xc1\x59\x70\x1d\x82\x0d\x8a\x7f\xb7\x80\xca\x09\x83\x50\x6b\x29\xb1\x76\x42\x2b\xd0\x0d\xe0\xac\xb4\x02\xab\xc1\x75\xcc\x01\x4b\xab\x0a\x2c\x3a\x7a\x3e\x84\x60\xa1\x66\x0a\xd6\x08\x1b\xc3\x86\x01\x39\x30\xa3\x47\xc5\x01\xef\xd1\x6c\x17\x72\x90\x63\xd9\x96\x2b\x8f\xc1\x5a\x32\x25\x75\xdb\x0a\xd5\x02\x53\x1c\x84\xb2\xce\x8c\x3d\x2a\xc7\x08\x41\xb1\xf2\x5f\xb5\xeb\xd0\xd8\xbd\x65\x8b\x1e\xe0\x3d\xca\xed\xec\xc5\xea\x1e\xc9\xd6\x1e\xae\xd7\x53\xda\xcd\xba\xd1\xeb\xe1\xdd\xe0\x9f\xa3\xa0\x0a\x02\x1b\x5d\x47\xb1\xd7\xcc\x11\x6c\x8f\xab\x28\xc9\xda\x67\xed\x30\xc4\x4c\x99\x6a\x84\x62\x72\x19\xc9\x8c\x67\x23\xa4\xa4\xc8\x49\x48\x8f\x0e\x4d\xaf\xad\x5b\x08\x92\xa9\x5c\x94\x58\x02\x1b\x06\x29\x90\x43\x23\x8c\x75\x45\xda\x8c\xaa\x7e\xa5\x56\x79\xac\x0f\x0c\xeb\x32\x4a\x7d\x66\x3d\x4e\x13\x15\x1c\xcd\x0a\x84\x02\x52\x7d\xde\x42\xf1\xca\x3b\xec\x42\xb7\x88\x06\x1e\x89\xa4\x49\x42\xb5\x41\x43\x0c\xfb\xe8\x7f\x95\x5f\x85\xeb\x7e\x15\x28\x79\x9e\x45\x64\xd9\x8a\x1a\xe9\x46\x7f\xd4\x1b\x34\xb0\x40\x98\x15\xb1\xab\xa4\xc5\x27\x8d\x1d\x35\x9d\x50\x25\x65\xe2\x17\x29\x3f\xb2\x35\x4a\xe4\x1f\x1e\x88\xa4\xf9\x21\x8d\xe5\x7b\xe6\xea\xee\x8a\x29\x51\x17\x69\x9a\x54\x15\x5c\x31\x6b\x81\x2d\x4b\xb2\xd5\x23\x6c\x98\x72\xfb\x4c\x3b\x1d\x19\x38\x57\xbe\xf4\x9a\x7a\x20\x7a\x31\x29\xb7\x30\x90\x11\xa1\x16\xd4\x59\x6f\x41\xb1\x3e\x56\x7e\x6f\xd1\x69\xaa\x31\x3e\xd4\x72\xe4\xc8\xbd\x15\xe2\x94\xff\x71\x00\x1f\x51\x13\xa7\x3e\xed\x61\xad\x20\xbb\x76\xcc\x8d\x96\x72\x75\x25\x54\x9b\x2d\x03\x10\x0a\x98\xaf\x51\x0c\xfc\xd3\x8f\x87\x73\xd3\xa1\xc5\x45\x1e\x2c\xb4\xe8\x7c\x64\x94\x82\x0e\x17\xc1\xf9\xc8\x98\xef\x7d\x61\x02\x11\x81\x99\xd6\xf7\x1c\x6c\x3a\x54\xb3\xaf\xd9\xb2\xd8\x77\xf6\xe8\xad\x69\xd8\x18\xe1\x10\x5a\x54\x68\x44\x0d\x3d\x3a\xfa\xa3\x65\xd4\x68\xd4\x4f\x4b\x18\x3e\x85\x35\x53\xde\x96\x41\x9a\xa9\x47\x78\x42\xa2\x1b\x6d\xa0\x31\x88\xc1\xe5\x73\x23\xf3\x60\xb7\x9a\xd5\xcb\x56\x7b\x65\xa6\x00\x1f\x58\x3f\x48\x7c\x5c\x8f\x63\x32\xa1\x31\xda\xbc\xd7\xa3\x72\x68\x72\xeb\x98\xb3\x3c\xfe\xad\x78\xb6\x46\x97\x7a\x43\x41\x53\x56\xb6\xc7\x64\xa3\xaf\x60\x85\x6a\xe5\x21\xa0\xbd\xff\x0f\x01\xcf\xdc\x66\x70\x0e\xc7\x9c\xc8\x4f\x65\x8a\x34\x05\x00\xa8\x2a\xb8\xd6\xfd\x71\x39\x9d\x06\xd1\x0f\x46\xdf\xa3\x2f\xe7\x3c\x32\x75\xe3\xe7\x18\x5a\x67\x67\xd5\x2f\x16\xe1\xf6\xa0\x5a\x5e\xa0\x0b\xad\x7b\x4b\x51\xac\x51\x61\x23\x1c\x34\x46\xf7\x20\xdc\x9b\xda\x6e\x86\x47\x66\x84\x6a\x73\x72\x4e\x93\x47\x09\x59\x14\x6f\xb2\x40\x9a\x68\x6e\xf4\xfb\xb0\xb8\xa3\x85\x63\x65\x1a\x2c\x97\xce\x0d\xbf\xfb\xce\x7c\xd5\xc6\xe5\xcd\xcd\xd5\x1e\x09\xcd\xd1\xdc\xc0\x3f\x68\x41\x96\x7f\x84\x84\x14\x10\x96\x67\xe9\xa7\x95\x9f\x72\x49\x13\x7e\xbe\x3b\x3f\x7e\xa3\xa7\x24\xeb\xd1\x75\x9a\x67\xef\xc0\x94\x9f\xfc\xcf\x95\xff\x4c\xdb\xfe\x1d\xe5\xd6\x94\x5f\xfe\xf8\x58\x5e\xfb\xad\x9f\x17\xf4\x38\xa5\x69\x42\xd5\x8e\x65\xf4\x4c\x8c\xe5\x38\x5b\x33\xdf\x2c\x86\xd5\x42\xb5\x69\x92\x88\x06\x04\xa7\x39\x6a\xca\x4b\x64\x1c\x0d\x15\x26\xcf\xfe\x73\x16\xe1\x9e\xfd\xc6\xb3\xe2\x9f\x24\xf3\xd3\x39\x64\x99\x87\x1b\xf1\x7e\xcb\xa2\xd1\xff\x0a\x9e\x7d\x87\x73\x10\x9c\x9c\x03\x4a\x8b\x2f\xca\xd1\x0d\x52\x7e\xc6\xcd\x8c\xf9\x19\xc8\x16\xad\x15\x5a\xbd\x1d\xf2\x75\x54\x78\x09\x72\x34\x3a\x43\x09\x90\xc9\xb9\x41\x37\x1a\x05\x41\x2e\x4d\xa6\xa2\x38\x5e\x3f\xd7\xce\x20\xeb\x85\x6a\xbf\x58\xa4\xc5\x30\xcf\x37\xb0\xf3\x03\x4d\x9c\x4e\x73\x0b\x1d\x0b\xed\x20\x0c\xe8\x8d\x5a\xf6\xcb\x8a\x3e\x6f\x1f\x0f\xc1\x60\x01\x98\x85\x0d\x4a\xb9\x24\x5f\x70\x6a\x5f\x5a\x39\x41\xa4\x78\x93\x56\x90\xbd\x18\x99\xe1\xb9\xe7\x66\xed\x1e\x20\x5e\xad\x65\x24\xf0\x2a\x86\x41\x71\x09\xd5\x16\x90\x9f\x08\xf8\x69\x55\xc0\xee\x90\xb5\x93\x2d\xbe\xf4\x54\xbb\x87\xd5\x7c\xce\xcd\xd6\x8b\x98\xe1\x37\x83\x7e\xb5\xc9\x9f\xd5\x9f\x1b\x34\x08\xc4\x20\xa2\x9d\xb9\xc8\x61\xd7\xa7\x73\x38\x42\xa5\x53\xfa\xc2\x15\x14\x8f\xda\x5c\xa8\x67\xce\x9f\xe2\x99\xef\xb0\x7b\xe4\xa3\xaa\x4e\x73\xf7\x15\xd7\xd7\xba\xbe\x43\xe7\xd3\x07\x83\xd1\x0e\xeb\x78\x15\x6f\xe6\xb7\xfd\x60\xdf\xed\x4e\x0f\x24\xba\x80\x8f\xbc\xef\x76\x3e\xc2\xd2\x13\xb6\xd6\x4a\xc5\x43\x5a\x58\xd0\x4a\x6e\x61\x1c\x5a\xc3\x38\x72\x10\x8d\x77\xd3\x92\x67\x3a\x0f\x29\x07\x5e\x92\x6b\xb4\xfe\x6e\x8d\xcb\x92\x36\x1b\xd1\x20\x98\x0c\x79\x43\x3e\x93\x09\x84\x85\x7b\x26\x05\xf7\x8d\x4c\x16\xa5\x68\xd0\x89\x1e\x09\x9a\x3b\x02\xe1\x7b\x02\x46\x8b\x26\xbe\x91\xdf\x05\xc6\xb8\xe4\xe9\xb0\x5f\x6f\xe1\x96\x22\xa5\x99\xbc\xcf\xd2\x17\x1b\x77\xc8\x30\xda\x8e\xae\x10\xba\x1d\x69\x05\x4a\xb9\x30\x63\x67\xc7\xe4\xc8\x1f\xd1\xb7\x91\x92\xb7\xd0\x85\xb3\x03\xdc\x76\x40\xb8\x7d\xf2\x66\x8c\x15\xbf\xf5\xe7\x7b\x44\x54\x33\xeb\xca\xc0\x92\x57\x6a\xf8\x74\x9f\x45\xff\x81\x2a\x17\x9a\x8e\xd3\xc7\x2e\x89\xe9\x27\xfb\xe3\xa5\x86\x8c\xe4\xf2\x2d\xa7\x84\x24\x1a\xbf\x34\xc4\xaa\xea\xc5\xd6\x3d\xe6\xde\xc9\xa0\xfb\x11\xea\xad\xfc\x51\x0a\xeb\x2d\xd4\x1d\xd6\x77\x64\x85\x6c\xf6\xe8\x18\x67\x8e\xcd\xd5\x99\x43\x4b\x8f\x86\x2b\xd1\xc9\xe0\xff\xb0\x76\x8f\x28\x7a\xe0\x67\xa0\xa5\xdd\xf3\x32\xb0\xea\x29\x5e\xce\x83\x57\xcf\x00\x3a\xcd\xff\x7a\x42\x3c\x1a\x88\x70\x52\xc3\x37\xb2\xe1\x07\x86\xf3\x93\x5c\x40\xc5\xe1\xec\x99\xca\xef\x49\xfb\xbb\x11\xad\x50\xef\xa9\x50\x68\x42\xc1\x02\x05\xb4\x7f\x80\xce\x5f\x0a\x73\xcd\xe2\x76\x87\x35\x36\xda\x60\x9c\x25\x73\x81\x0f\x1d\xe8\x73\xfc\x2f\x8b\xb8\x3c\xa4\x5b\x6d\x84\x94\xac\xda\xe0\xda\x7a\xd7\x7e\x60\xf4\x64\x87\xa3\x63\x42\xda\xd7\x1a\xec\x08\x6b\xfe\xc6\x2c\x9e\xf4\xd4\x5a\x6b\x49\xfd\x13\x03\x5c\x1c\x17\xdf\xb2\xe0\x21\xfb\x9e\xd2\xe5\x21\x51\xe5\x41\xa8\x80\xf3\x73\xf8\x79\xb9\x05\x9d\x19\x31\x4d\xa6\x34\x19\x7d\x4b\xd2\x89\x32\x1a\x59\x5e\x31\x63\x31\x2a\x7d\xfb\xf9\x3b\x6d\xad\xc6\xbf\xff\x74\x0e\x4a\xc8\xa5\x85\x86\x49\x1b\x4c\xc4\x0f\xf1\x3f\x73\xca\x0f\x7f\x8e\x4c\xfe\xaa\x25\xcf\xc7\xf2\x52\x5b\xb7\x22\x7c\x9a\xfe\xad\x3e\xa5\xbb\xdd\x19\xa0\xe2\xd3\x94\xfe\x7f\x00\x19\x59\x04\x29\x9e\x12\x00\x00")
 
 func handlersMiddlewaresGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -175,12 +197,12 @@ func handlersMiddlewaresGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "handlers/middlewares.go.tpl", size: 3217, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd9, 0xd, 0x6, 0x59, 0xcb, 0x7c, 0x30, 0xa3, 0x7, 0xac, 0x3d, 0xa6, 0xf, 0x9b, 0x57, 0x3e, 0x75, 0xcb, 0x9c, 0x53, 0x43, 0xee, 0x71, 0x75, 0xfc, 0xf0, 0x5f, 0xcc, 0xcc, 0x88, 0xb0, 0x8f}}
+	info := bindataFileInfo{name: "handlers/middlewares.go.tpl", size: 4766, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7f, 0x23, 0x84, 0x94, 0xab, 0xb7, 0x93, 0xce, 0x58, 0xea, 0x48, 0x7e, 0x9c, 0x39, 0x63, 0x71, 0x1b, 0x51, 0x8c, 0xe2, 0x91, 0x2, 0x5a, 0x89, 0xb7, 0x71, 0x90, 0x81, 0x94, 0xa5, 0xab, 0xd5}}
 	return a, nil
 }
 
-var _svcClientGrpcClientGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x4f\x6f\xdb\x3a\x12\x3f\x8b\x9f\x62\xd6\x08\x16\x52\xa0\xd0\xf7\x2e\x7c\xa9\xd3\x2d\xba\xd8\xa6\x46\x1a\xf4\x1d\x8a\xa2\x60\xa8\x91\x4c\x58\x26\x55\x92\xb6\x13\x08\xfa\xee\x0f\x43\x52\x8e\x9c\x3a\x7e\x3d\x18\x16\x39\xc3\xf9\xf3\xfb\xcd\x70\x38\x9f\xc3\xd2\x54\x08\x0d\x6a\xb4\xc2\x63\x05\x8f\xcf\xb0\x16\x87\x0d\x87\xdb\x2f\x70\xf7\xe5\x01\x3e\xdc\x7e\x7a\xe0\x6c\x3e\x87\x7b\xb4\x3b\xad\x95\x6e\x82\x1c\x0e\xaa\x6d\xc1\xec\xd1\x1e\xac\xf2\x08\x7e\xad\x1c\xd4\xaa\xc5\xa0\xfb\x0d\xad\x53\x46\xbf\x83\xbe\xe7\xe9\x7b\x18\x26\x02\xb8\x15\x1e\xa7\x52\x5a\x0f\x03\x23\x95\x95\x90\x1b\xd1\x20\x34\xb6\x93\xd0\x59\xb3\x57\x15\x3a\x10\xd0\xdc\xaf\x96\x20\x5b\x85\xda\x43\x6d\x2c\xf8\x35\x92\x81\xaf\x68\xf7\x4a\x22\xbf\x13\x5b\x1c\x06\x70\x69\xc9\xba\x89\x19\xc6\xd4\xb6\x33\xd6\x43\xce\xb2\x99\x34\xda\xe3\x93\x9f\xb1\x6c\xd6\x18\xd3\xb4\xc8\x1b\xd3\x0a\xdd\x70\x63\x9b\x39\x69\xbf\x2d\x99\x6f\xd1\x8b\x4a\x78\x11\x54\x94\x5f\xef\x1e\xb9\x34\xdb\x79\xb7\x69\xe6\x68\xad\xb1\x6e\xc6\x4e\x25\x8d\xb9\xd9\x28\x3f\xa7\x1f\xea\xaa\x33\x4a\x93\x63\xb2\xe5\xad\xd0\x2e\x04\xf5\x86\xfe\x51\x21\x05\xc5\xb2\xf9\x1c\x1e\x08\xe6\x94\x32\xcb\x66\x7d\xcf\x3f\x85\xcc\x56\xc2\xaf\xe1\x66\x18\x60\xee\xf6\x94\x40\xf7\x08\x24\x5c\xbd\x3f\x15\xcf\x58\x11\x30\xbe\xc3\x03\x58\xf4\x3b\xab\x1d\x08\x3d\x82\x06\x8f\x42\x6e\x62\x09\x9c\xc2\x2d\x8d\xd6\x28\xbd\x32\x9a\xc3\x27\x0f\xca\x11\xf8\x64\xc7\xa2\xeb\x8c\x76\xea\x51\xb5\xca\x3f\x83\xa9\x03\x2b\x52\xb4\x2d\x5a\xf0\x06\x2a\x25\xda\x12\x84\xae\xa0\x15\x1e\x2d\xc8\xd6\x38\x2c\xa3\xd2\x8b\x4d\x56\xef\xb4\xa4\x98\x72\xda\x84\x6b\xca\x97\x2f\x83\xeb\xa5\xd1\xba\x04\xd3\x91\x9e\x03\xce\xd3\xf6\x97\xb0\x51\x40\xde\x3d\xf2\xdf\x6a\x80\x56\x68\x4b\x08\x8c\x14\xd0\xb3\x6c\x2f\x2c\x48\x99\xb2\x59\x1a\x5d\xab\x86\xb1\x8c\x8a\xe8\x67\x09\x35\xbc\x5b\x80\x15\xba\xc1\xa3\x9f\x9e\x65\x19\x5a\x4b\x82\x3a\xff\xb7\x94\x05\xcb\x32\x55\x93\x41\xf8\xd7\x02\xb4\x6a\x83\x46\x16\x11\xa4\x75\x72\xe6\xf8\x5f\x56\x74\x39\x5a\x5b\xc2\x4c\x0a\xad\x8d\x07\xd1\x75\xed\x73\xb2\x3c\x23\x43\x03\xcb\x06\xc6\x32\x39\x49\xc4\x91\xa7\xef\x3f\x4e\xca\xe2\x24\x53\x72\x77\x4e\xfa\x1e\x6b\x63\x31\xa7\x60\x52\x59\x7f\x13\xed\x0e\xdd\x83\xf9\x78\xbf\x5a\x7e\x4e\xd5\x9a\x4b\xc9\xd7\x28\x2a\xb4\xae\x28\x4a\x72\x9f\xf5\xfd\x0d\x1c\x94\x5f\xc3\x95\x47\x72\xce\x87\x81\x65\x93\xdd\x6e\xd3\x10\x98\x24\xba\xf2\xc8\x53\x4f\x46\x7c\xc9\x1b\x69\x46\xcc\xae\xd4\xa8\x34\xb2\xf0\x19\xfd\xda\x54\x2e\x2a\x06\xec\xfb\xfe\xc1\xfc\xdf\x1c\xd0\xc2\x95\x4a\x24\x7d\x48\xdd\x00\x63\x5b\xf0\x71\x27\x9c\x0a\xf8\x92\x9b\xb7\x0f\x2e\xe0\x14\x91\x3b\x3c\x44\x50\xf2\x78\x96\x10\xd1\x65\xfa\x9e\xf5\xfd\x98\xd3\x30\xf0\xbe\x9f\xc6\x1b\x37\x67\x53\x55\xf5\x7a\xf3\x83\x96\xa6\x42\x02\x75\x22\xbd\xc7\x5f\x3b\x74\x7e\xd4\xb9\xc5\xb3\x3a\xa1\x43\x70\x54\x0a\x05\xfb\xd1\x04\x70\xaf\x14\x1f\xc5\xc3\xd0\x0f\xa3\xca\x49\x65\x70\xce\xd3\x7e\x71\x44\x28\x2f\xc2\x4e\x22\x02\x75\x95\xc8\x4b\x5f\xe3\x07\x03\x80\x23\xbc\xa1\xc4\xdc\x5e\x12\x4c\xa3\x1d\x47\x86\xfa\x7e\xca\xe3\x6b\x12\xe9\xd2\xa0\x66\x18\x4f\xf0\x49\x6a\x13\x26\x2e\xf0\x34\x89\x67\x6c\x98\xa3\xb5\x92\x7a\x87\xc5\x6b\x9f\x70\x83\x48\x20\x44\x24\xd9\xe5\xd0\xe2\x40\xb9\x08\x3a\xdd\x54\x02\x4e\x2f\x52\x1e\x4f\x8c\x2a\xff\xa5\xab\xc7\xaf\x45\xb8\xe4\xf6\x68\xbd\x03\x41\x76\xc3\xf5\x77\x26\x2d\xb0\x48\xfd\xec\x0d\x08\xd8\x39\xb4\x37\x95\xd9\x0a\xa5\xdf\x50\x8d\x3e\x38\xac\xac\xda\x0a\xab\xda\x67\x3a\x53\xef\x5a\x50\x1a\x44\xba\x8f\xd2\xf5\x77\x31\x91\xfc\x27\xa4\xfe\xe6\xcb\xf8\x5f\x86\xea\xbf\x0f\xc1\x28\xed\xd1\xd6\x42\x62\x3f\x14\x90\x4f\x56\xd3\x3b\x30\xc6\xfd\x6e\xf1\x72\x8e\xe7\xd7\x6f\x56\x63\x71\x24\x2b\x9c\x1b\x89\x3a\x52\xf9\x8a\xb0\xd8\x1e\x7f\x44\xd8\xa5\x4e\x3a\xcb\x57\x3c\x90\x34\xde\xa2\xeb\x9f\xa9\x88\x0e\x02\x6f\x17\xb8\x0d\x5a\x7f\xc4\xd7\xa5\x3c\xce\xd1\x35\x46\xf0\x87\x64\xfd\x0a\x53\x29\xc5\x73\x86\xa8\x20\x38\xe5\xe9\xd7\x6f\x2c\x31\xff\xdc\xe1\xc9\xd8\x03\xe7\xed\x4e\x7a\xf2\x91\x26\x02\x7c\xff\xe1\xbc\x55\xba\x49\x7d\x38\x1d\x3b\x91\x0f\x4a\x37\xac\x02\xee\x5b\x53\xa9\x5a\xa1\x8b\x43\xfc\xf8\x3e\xa0\x91\x1a\xbc\x9d\x9c\xa7\xa3\xf9\xf5\x34\x80\x22\x66\xc9\x22\x88\x4b\xff\x34\x0e\xac\xaf\xa8\xab\x7c\x83\xcf\x61\xca\xc7\x88\x8a\x53\x63\xfd\x31\xd7\x60\xd6\xc0\x39\xc3\x61\x32\x9b\x71\xdc\xc1\x02\xc8\x24\x9b\xce\x6a\x9a\x7f\x43\xf2\x7f\x69\x68\x86\x58\x46\x70\x0a\x38\x37\x7e\xa7\x45\xf9\x2a\x3a\xe9\x9f\x7e\xaf\x81\x6d\x05\xd7\xe3\x13\x92\x7f\xbe\x2d\x5e\x6b\x84\xe0\x69\x60\x76\x42\x4d\x99\xc9\xc6\xb7\xca\xe6\xe5\xad\x12\xc2\x0b\x63\x52\xd5\xb0\x2f\xc1\x04\x99\xf4\x4f\x3c\x64\x93\x6f\x0a\x9e\xa7\xd8\xff\x43\xc2\x38\x51\xa3\xe1\x05\xbd\x4a\x08\xef\xb0\x2c\x61\x53\xc2\x3e\xcc\x94\x21\xbc\x4f\xe2\x6b\x27\xaa\x4e\xdf\x3b\xd7\xdb\x0a\x16\x70\x4c\xe0\x7f\x46\xe9\xfc\x7a\x5b\x95\x2f\x5b\x2b\x3a\x13\xad\x72\xce\x8b\x62\x34\x97\x90\x91\xfe\x29\xa2\xff\x77\x00\x00\x00\xff\xff\x8b\x8c\x74\x6f\x77\x0c\x00\x00")
+var _svcClientGrpcClientGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\x4b\x6f\xe3\x38\x12\x3e\x8b\xbf\xa2\xd6\x68\x34\xa4\xc0\xa1\xef\x59\xf8\xb0\xe3\xf4\x04\xbd\xd8\x4e\x07\xe9\xec\xec\x61\x30\x68\x30\x52\x59\x26\x2c\x93\x6a\x8a\x76\x12\x08\xfa\xef\x8b\xe2\xc3\x92\x1c\x25\x9d\x00\x33\xc0\x1c\x82\x58\x64\xbd\xeb\xab\x07\x17\x0b\x58\xe9\x02\xa1\x44\x85\x46\x58\x2c\xe0\xfe\x09\x36\xe2\x61\xcb\xe1\xf2\x2b\x5c\x7f\xbd\x83\x4f\x97\x9f\xef\x38\x5b\x2c\xe0\x16\xcd\x5e\x29\xa9\x4a\x77\x0f\x0f\xb2\xaa\x40\x1f\xd0\x3c\x18\x69\x11\xec\x46\x36\xb0\x96\x15\x3a\xda\xdf\xd0\x34\x52\xab\x0b\x68\x5b\x1e\x7e\x77\xdd\xe0\x02\x2e\x85\xc5\xe1\x2d\x7d\x77\x1d\x23\x92\x1b\x91\x6f\x45\x89\x50\x9a\x3a\x87\xda\xe8\x83\x2c\xb0\x01\x01\xe5\xed\xcd\x0a\xf2\x4a\xa2\xb2\xb0\xd6\x06\xec\x06\x49\xc0\x37\x34\x07\x99\x23\xbf\x16\x3b\xec\x3a\x68\xc2\x27\xab\x07\x62\x18\x93\xbb\x5a\x1b\x0b\x29\x4b\x66\xb9\x56\x16\x1f\xed\x8c\x25\xb3\x52\xeb\xb2\x42\x5e\xea\x4a\xa8\x92\x6b\x53\x2e\x48\xe9\xcb\x37\x8b\x1d\x5a\x51\x08\x2b\x1c\x89\xb4\x9b\xfd\x3d\xcf\xf5\x6e\x51\x6f\xcb\x05\x1a\xa3\x4d\x33\x63\xe3\x9b\x52\x9f\x6f\xa5\x5d\xd0\x1f\xaa\xa2\xd6\x52\x91\x62\x92\x65\x8d\x50\x8d\x33\xea\x05\xfa\x23\x41\x30\x8a\x25\x8b\x05\xdc\x51\x98\x83\xcb\x2c\x99\xb5\x2d\xff\xec\x3c\xbb\x11\x76\x03\xe7\x5d\x07\x8b\xe6\x90\xcf\x58\x52\xdf\x03\x5d\xde\xfc\x32\xbe\x9e\xb1\xa4\x6d\xcf\xc1\x08\x55\x22\xf0\x2b\xed\x6f\x9b\xae\x63\x49\xd2\xb6\xfc\x5f\x95\x14\x4d\xd7\x79\x56\x61\x37\x47\x06\x54\x45\xd7\xb1\x8c\xb1\x9e\xfb\x43\x73\xc8\xe1\x62\x09\x31\x01\x4d\x48\xdf\x35\x3e\xb4\x2d\x5d\xf2\x2b\x7d\x63\x70\x2d\x1f\xbb\x0e\x0c\xda\xbd\x51\x0d\x88\xb6\x95\x6b\x38\xbd\x0d\xf4\x3e\x83\x6d\xeb\x94\xc5\x44\xc2\xbd\xc8\xb7\x1e\x96\x63\x08\xe4\x5a\x29\xcc\xad\xd4\x8a\xc3\x67\x0b\xb2\x21\x40\x90\x01\x06\x9b\x5a\xab\x46\xde\xcb\x4a\xda\x27\xd0\x6b\xba\x80\x5c\x54\x15\x1a\xb0\x1a\x0a\x29\xaa\x39\x08\x55\x40\x25\x2c\x1a\xc8\x2b\xdd\xe0\xdc\x13\xf5\x32\xd9\x7a\xaf\xf2\x49\x67\x52\xd2\x0c\x67\xa5\xa9\x73\xbe\x72\xb6\xac\xb4\x52\x73\xd0\x35\x19\xd3\x00\xe7\xe1\xf8\xab\x3b\xc8\x20\xad\xef\xf9\xc8\x45\x8a\x18\x9a\x39\x38\xc8\x64\xd0\xb2\xe4\x20\x0c\xe4\x79\x70\x6d\xa5\xd5\x5a\x96\x8c\x25\x84\xf2\xef\x73\x58\x53\x98\x7d\xd0\xa3\x8e\x96\x25\x09\x1a\x43\x17\xeb\xf4\x63\x9e\x67\x2c\x49\xe4\x9a\x04\xc2\x3f\x96\xa0\x64\x45\x42\x93\xc4\x87\x9d\xbe\x83\xb2\x86\xff\xcf\x88\x3a\x45\x63\xe6\x30\xcb\x85\x52\xda\x82\xa8\xeb\xea\x29\x48\x9e\x91\xa0\x8e\x25\x1d\x63\x49\x3e\x70\xa2\x21\x4d\xbf\xff\x31\xc2\xed\xc8\x4b\x52\x37\x75\xfb\x0b\xae\xb5\xc1\x94\x8c\x09\x75\xf7\x9b\xa8\xf6\xd8\xdc\xe9\xab\xdb\x9b\xd5\x97\x50\x4e\x69\x9e\xf3\x0d\x8a\x02\x4d\x93\x65\x73\x52\x3f\x80\xe9\x07\x49\xca\x5d\xf8\xbe\xa0\xdd\xe8\x22\xc2\xf5\x1c\x08\x4b\x92\x7f\xb3\x06\xc5\x4e\xaa\x92\xc0\x43\x5a\xa4\xda\xf7\x40\x62\x89\x8b\x6e\xdb\xde\xe9\xff\xe8\x07\x34\xc4\xe1\x91\xf6\x29\x14\x24\xc4\xca\xe4\xf1\x84\xaa\x81\x4c\x7e\x8d\x69\x09\x63\x7f\xaf\xf1\xc1\xbb\xec\x9c\x4d\x08\x24\x73\xf7\x6b\xd6\xb6\x1f\x38\x79\x1b\x0a\x85\x94\xfb\x12\x08\x07\x5d\x37\xeb\x29\xa3\x9e\x70\xf4\x49\xe5\xba\x40\xe2\x7e\x86\xc3\x01\xf1\x2d\xfe\xd8\x63\x63\x3d\xcb\x25\xbe\x8d\xc5\x55\x09\x7a\x1e\x27\xeb\x4a\xc7\xc3\xbb\xa7\x9a\x02\xd8\xf9\xcb\x11\x10\x38\xe7\xee\x34\x3b\x06\x2b\x0d\x98\x89\xf1\x66\x49\x8c\xa7\x43\x0d\x39\x3a\x55\x46\x91\xbd\x21\xfe\xb6\x7d\x29\xd7\x70\xfe\xde\x6c\x1f\xb5\xf3\x81\xbb\x51\x1b\x2c\xe1\x95\xa4\x0e\x9d\x08\xb5\x73\x94\x36\xa7\x32\x62\x1d\x8b\x96\x90\x37\x47\x5b\xfe\xdb\x60\x11\xfa\xdf\x33\x47\x3d\xd1\x2a\x74\x2d\x51\x55\xae\x53\x41\x13\x79\x61\x17\x3c\x0d\x9d\x2a\x08\x18\xcf\xb3\x39\x3c\x6c\x64\xbe\x01\x61\x10\x5c\xd1\x1e\x84\xac\xc4\x7d\x85\xd4\x18\x43\xe3\x8b\x1d\xd3\x5b\xee\x7b\xe6\x54\xe4\x39\xdc\xf9\x5e\x47\x63\x10\x0e\xae\x1e\xe9\x73\x2d\xcb\xbd\xf1\x6c\x2b\xfb\x18\xeb\xf4\x1b\xaa\xc2\xa9\x6d\xa8\xeb\x8a\x06\xe2\x0c\xe4\xcc\x3e\xd5\x08\xcf\xc4\x8f\xfc\x6d\xac\xd9\xe7\x96\xba\x51\x68\xdb\xe0\x9a\xe1\x95\xee\xab\x80\x7e\x75\x9d\xa7\x67\x49\x68\x03\xf0\xfb\x1f\x8d\x35\x52\x95\xec\xe5\xa9\x32\x52\x74\x9c\x30\x71\x3e\xe8\xf5\x74\x98\x27\x27\xd0\x6b\xa1\x0f\x90\x08\x53\x86\x8c\xa1\xf4\xfd\xd5\x53\x66\xe8\xdc\xfb\x27\xce\xd9\xab\xf2\xfe\xee\x93\x27\x30\x7f\x7c\xd5\x09\x52\xe3\x53\x7d\xe1\x40\xe5\x82\xf8\x12\xae\x5c\x04\x69\xb2\x44\x7c\x5d\x40\x3f\x72\x68\xe0\xf4\xd5\xfd\xca\xd0\x09\xa5\x4f\xa6\xff\xbc\x11\x2d\x16\x30\xe8\x3f\xa0\x6b\xa4\xfd\x27\xe0\x31\xe2\xc5\x97\xfe\x90\xf0\xd8\x5f\x24\x0f\x5d\xdd\x23\xa1\xeb\x3c\x56\xd2\x1c\x5e\x4f\x6e\x36\x94\x96\xe6\xf6\x31\x56\x3a\x5f\xf9\xff\x0e\x37\x0e\x34\x34\xc0\xf8\x4a\x54\xd5\x78\x53\x79\x1e\xc4\xef\xfd\x61\x94\xfc\x1c\x49\x21\x6b\x39\xf7\x59\x19\x76\xdf\x54\xef\x6d\xa9\xa5\x2a\x83\x09\x64\xd6\x1c\xfa\x99\xef\x4d\xe2\x9c\x67\xa1\xc1\x62\xd5\xe0\x9f\xe9\xb1\x54\x4e\x86\x9f\x71\x2e\xaa\x7e\xc4\xfd\x2d\x63\x21\xd5\xf3\x78\xb8\x4d\x26\x42\xab\x3f\xa1\x6e\x44\x93\x1e\x42\x17\xf4\xb3\xff\x4d\x28\x7e\x13\x82\xdf\xb7\x4b\xd0\x12\x2e\xe0\xb8\x12\xb9\x77\x0b\xf7\x22\x22\xc9\xaf\x94\x51\xbb\x11\x6e\x7f\x3f\xa0\xb1\x0d\x08\x72\xc2\x6d\xf6\x13\x93\x19\x0c\x52\x8f\xb0\x1a\x04\xec\x1b\x34\xe7\x85\xde\x09\xa9\xa6\x86\x78\x6c\xc9\xc8\xe1\xc6\xc8\x9d\x30\xb2\x7a\x22\x9e\xf5\xbe\x02\xa9\x8e\x83\x21\xf4\xdc\xf7\x79\x96\x7e\x7f\x8e\x29\xf2\xee\xd6\x59\x27\x95\x45\xb3\x16\x39\xb6\x5d\x06\xe9\xe0\x6b\x0c\x09\x22\xbd\x58\xf6\x7c\x3c\x3d\x9b\xdc\xbb\xb2\x23\x7e\x1c\x4f\xdf\x9a\xa6\x73\xfe\x49\xfd\xb9\x39\x7f\xd7\xca\x39\x99\x72\x2f\x21\x50\xbc\x94\xf1\x9f\x67\xd3\xb1\xd3\x2c\x0d\x0f\xbf\x57\xa8\xde\x94\xf2\x77\x39\x36\x95\xf1\x68\xd2\x1b\xf3\xfd\x83\x46\x48\x34\x30\x9d\xec\x3f\xc3\x54\xff\x38\x4d\xf4\xa0\xcc\xdd\xa6\x35\x9c\xd1\x83\xc5\xea\x85\x8d\x69\xb8\x11\xf8\x2c\x51\x10\xdc\x97\xab\xbf\x9d\x2e\xe4\x5a\xa2\x5f\x46\xc3\xce\xe4\x97\x40\xbf\xd7\x8d\xf8\x89\x35\x3d\x1b\x1a\x90\x79\x57\x99\xaf\xa6\x93\x7d\x31\xdd\xe2\x93\x9b\x30\xde\xa2\x6c\x2c\xac\xef\x8f\xc4\x9b\x6a\x98\x12\x4c\x25\x93\xe8\xd8\x15\x61\x09\x24\x92\x0d\x17\x0b\xda\x15\xba\xa0\x3f\xa4\x2a\xda\x30\x7a\x5b\x12\xe3\x31\x38\xd9\xc9\xab\xcd\x1b\x16\x72\xe2\xa0\x7a\x62\xdd\xe4\x00\xdd\x15\x70\x76\x5c\x83\xbf\x5c\x66\xa7\x14\xce\x78\xda\xac\x6a\x21\x87\x99\x49\xe2\x62\xb5\xed\x17\x2b\x67\x1e\xd1\xd3\x03\xfe\x30\x07\xed\xee\x72\xfb\xc8\x9d\x37\xe9\x36\xe3\x69\xb0\xfd\x9f\x74\xe9\x48\x13\x2f\x78\x49\x8f\x77\x8a\xb7\xfb\x9c\xc3\x76\x0e\x07\x5a\xc8\x68\x91\x72\x6f\x78\x92\xe9\xee\x46\xcb\xd9\xd9\xae\x80\x65\xbf\xc7\xff\x5b\x4b\x95\x9e\xed\x8a\x79\x7f\x74\x43\x3c\xa9\xe3\xa4\xa9\x9c\x45\x71\x21\x32\xb9\x7d\x0c\xd1\x5f\x2c\xe0\x64\x9e\x81\x28\x8a\x00\xaa\xf1\x0b\x23\xac\x3c\xce\x5f\xab\xdd\xef\xc8\x7a\x54\x1c\x89\x42\x3c\x7d\x72\x4f\x14\x4c\x4f\xf8\x93\x2c\x9f\xdc\x53\x42\xc8\xc1\xef\x14\xdb\xa3\x97\xbf\x1a\xbd\xfb\xfa\x5c\x78\x46\xb4\x14\xa0\x82\xaf\x74\xfd\x44\xef\x53\x52\xb9\xfc\x29\xca\x32\xe2\x9e\xc3\xc7\x5d\xd1\xd7\xf5\x51\xd9\x35\x3e\x4c\xe8\x9a\xc3\xae\xc8\x58\xc7\xfe\x3f\x00\xb5\x4e\xfc\xa9\x88\x15\x00\x00")
 
 func svcClientGrpcClientGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -195,12 +217,12 @@ func svcClientGrpcClientGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "svc/client/grpc/client.go.tpl", size: 3191, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe1, 0x9c, 0x1e, 0xf9, 0xb2, 0x33, 0x92, 0xf4, 0x36, 0x92, 0x5e, 0xbc, 0x90, 0xf6, 0xad, 0x67, 0x85, 0xc4, 0x65, 0xd0, 0x49, 0x51, 0x6d, 0x79, 0xcc, 0xd1, 0xa4, 0x63, 0xb, 0xf3, 0x0, 0x2e}}
+	info := bindataFileInfo{name: "svc/client/grpc/client.go.tpl", size: 5512, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x4b, 0xf1, 0x53, 0x61, 0x37, 0x3f, 0xe1, 0xac, 0xd0, 0xb3, 0xa1, 0xd4, 0xf9, 0xd7, 0x13, 0x53, 0xdc, 0x63, 0xb9, 0xfb, 0xd1, 0x0, 0xd4, 0x62, 0x1a, 0x34, 0x12, 0xde, 0x35, 0x6a, 0x83, 0xf4}}
 	return a, nil
 }
 
-var _svcClientHttpClientGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcb\x31\x0e\xc2\x30\x0c\x05\xd0\xbd\xa7\xf0\xdc\xc1\x3e\x04\x4b\x47\x24\x72\x81\x28\x7c\x5a\x84\x49\x22\x63\x89\xc1\xf2\xdd\x91\x50\xf7\xf7\x22\x64\xa5\x1b\x40\x47\xfd\xbe\x64\x47\x87\x55\x1f\x26\x87\xfb\x14\xc7\x7b\x6a\x75\x7c\xb8\xe9\x13\xdd\x79\x1f\xf4\x18\x46\x6d\xdc\x41\xab\x64\x2e\x11\xad\xaa\x12\x6f\xa5\x5c\x37\xe8\x84\xf1\xe5\x4f\xcb\x59\x89\x33\x97\x5f\x00\x00\x00\xff\xff\x39\x4e\x2a\xed\x65\x00\x00\x00")
+var _svcClientHttpClientGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x65\x00\x9a\xff\x7b\x7b\x2f\x2a\x20\x53\x65\x65\x20\x68\x61\x77\x6b\x2f\x67\x65\x6e\x65\x72\x61\x74\x6f\x72\x2f\x68\x74\x74\x70\x2f\x74\x65\x6d\x70\x6c\x61\x74\x65\x73\x2e\x63\x6c\x69\x65\x6e\x74\x2e\x67\x6f\x20\x66\x6f\x72\x20\x63\x6f\x64\x65\x20\x2a\x2f\x7d\x7d\x0a\x7b\x7b\x63\x61\x6c\x6c\x20\x2e\x48\x54\x54\x50\x48\x65\x6c\x70\x65\x72\x2e\x43\x6c\x69\x65\x6e\x74\x54\x65\x6d\x70\x6c\x61\x74\x65\x20\x2e\x7d\x7d\x0a\x03\x00\x39\x4e\x2a\xed\x65\x00\x00\x00")
 
 func svcClientHttpClientGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -220,7 +242,27 @@ func svcClientHttpClientGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _svcConfigGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8e\xcf\x4a\x34\x41\x0c\xc4\xcf\x93\xa7\x08\x7b\xfa\xbe\x83\xdb\xcf\x20\xfe\x3d\x8a\xee\x0b\xf4\xa6\x33\x3d\x61\xdd\x74\x9b\xa4\x07\x44\x7c\x77\x19\x64\x11\xc4\x82\x3a\x14\xf5\x2b\xa8\x9e\xe9\x94\x2b\xa3\xaf\x04\x20\xe7\xde\x2c\xf0\x1f\x4c\xbb\x2a\xb1\x8c\xe3\x9e\xda\x39\xd5\x76\x75\x92\x48\x9b\xc3\xb2\xfa\xc6\xa4\x25\xa2\xef\xe0\x3f\x40\x4a\x78\xd3\x74\x96\x8a\xd4\x34\xb2\xa8\x63\x2c\x8c\xc6\x6f\x43\x8c\x0b\xce\xc2\xaf\xc5\x71\x6e\x86\x36\x54\x45\x2b\x66\x74\xb6\x95\x0d\xe2\xbd\xf3\x65\xed\x61\x83\x02\x3f\x60\x7a\x61\x5b\x85\xf8\xba\x14\xc3\x5f\xf2\x30\xd1\x0a\xd3\x2d\x1f\x47\xfd\x0b\xf8\x41\x1e\x58\xd9\x84\x1e\x0f\x87\xa7\x67\xf6\xde\xd4\xf9\x4e\xa9\x15\x36\xdc\xbe\xef\xbf\xc3\xa5\xba\x1f\x4a\xf0\x09\x5f\x01\x00\x00\xff\xff\x09\x4c\x07\xd5\x0e\x01\x00\x00")
+var _svcClientWsClientGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x7c\x5b\x93\xdb\xc6\x95\xf0\x33\xf0\x2b\x8e\x59\x8e\x0a\x50\x20\x50\x4e\xbe\xa4\xea\x63\xcc\x54\xd9\x92\xe2\x78\x2b\xba\x94\x47\x5a\x3f\xa8\x54\x56\x0f\xd0\x24\x7b\x05\x36\x10\x34\x38\x9c\x09\xc3\xff\xbe\x75\x4e\x9f\x6e\x34\x2e\x1c\x8d\x9c\x6c\xed\xfa\xc1\x1a\x02\x8d\xd3\xe7\x7e\x6d\x60\xb9\x84\x67\x75\x29\x61\x2b\xb5\x6c\x45\x27\x4b\xb8\xbe\x83\x9d\x38\x7e\xca\xe1\xf9\x6b\x78\xf5\xfa\x2d\xbc\x78\xfe\xe3\xdb\x3c\x5e\x2e\xe1\x27\xd9\x1e\xb4\x56\x7a\x4b\xf7\xe1\xa8\xaa\x0a\xea\x1b\xd9\x1e\x5b\xd5\x49\xe8\x76\xca\xc0\x46\x55\x92\xd6\xfe\xa7\x6c\x8d\xaa\xf5\x0a\x4e\xa7\x9c\xff\x3e\x9f\x83\x1b\xf0\x5c\x74\x32\xbc\x8b\xbf\xcf\xe7\x18\x97\xbc\x11\xc5\x27\xb1\x95\x70\x34\xd0\xb4\xf5\x8d\x2a\xa5\x01\x01\x3f\xcb\xeb\xab\xba\xf8\x24\x3b\x28\x2a\x25\x75\x07\x9b\xba\x85\x6e\x27\x11\xc6\x95\x6c\x6f\x54\x21\xf3\x57\x62\x2f\xcf\x67\x30\xfc\x33\x6e\x3c\xa4\x38\x56\xfb\xa6\x6e\x3b\x48\xe2\x68\x51\xd4\xba\x93\xb7\xdd\x22\x8e\x16\x52\x17\x75\xa9\xf4\x76\xf9\x5f\xa6\xd6\x78\x61\xb3\xa7\xeb\xaa\xc6\xff\xef\x45\xb7\x5b\xb6\x42\x97\xf8\x43\xcb\x6e\xb9\xeb\xba\x06\xff\x36\x77\xba\xc0\x7f\x3b\xb5\x97\x8b\x38\x8e\x16\x5b\xd5\xed\x0e\xd7\x79\x51\xef\x97\xdb\xba\xde\x56\x72\x79\x38\xa8\x72\x31\xbe\xd3\xaa\xaa\x12\xcb\xa3\xbc\x36\x44\xca\x22\x8e\xa4\xbe\x91\x55\xdd\x48\x08\x17\x6a\xa5\xd4\xb6\xae\x97\xc8\xe7\x65\xf3\x69\xbb\x3c\x9a\x11\x28\xbc\x28\xdb\xb6\x6e\xed\x0d\xda\x32\xdf\xd6\x95\xd0\xdb\xbc\x6e\xb7\xcb\xa6\xad\xbb\xfa\xfa\xb0\x59\x7a\x02\xe9\x8a\xa3\xf2\x9e\x07\xe8\x0f\xa4\x69\xb9\x84\xb7\x28\x53\x66\x6e\x1c\x2d\x4e\xa7\xfc\x47\xe2\xe2\x1b\xd1\xed\xe0\xc9\xf9\x0c\x4b\x73\x53\x2c\xe2\xd3\xe9\x09\xa8\x0d\xe4\x5e\x44\x2f\xb4\xb8\xae\x64\x79\x3e\xc7\x51\x73\x0d\xf8\xdc\x9b\xef\x87\x4f\x2e\xe2\x08\x9f\x6a\x85\xde\x4a\xc8\x7f\xa8\xed\x5d\x83\x4f\x44\xa7\x53\xfe\x5d\xa5\x84\x39\x9f\xed\xa3\xa2\xdb\xf9\x07\xa4\x46\xa8\xfd\x5f\x69\x1c\x17\xb5\x36\x24\xd7\xa6\xd6\xdb\x9f\x85\xea\x00\x60\x0d\xbf\x7b\x0a\x8f\x01\xc5\x93\x5f\xc9\xa2\xd6\x65\x1c\x35\x4a\x6f\xdf\xc8\x56\xd5\x25\xac\x21\xf1\x8b\x1f\xc3\xff\x4f\x61\x09\xdf\x3c\x8d\x23\x52\x64\xba\x08\x6b\xf8\x66\x0c\x20\x8e\x4a\xb9\x11\x87\xaa\x7b\xa9\xf4\xf7\xa2\xf8\x54\x6f\x36\xb8\xcf\x1f\xfc\xba\x97\xaa\xaa\x94\xe1\xdd\xdc\x5a\x71\xdb\xaf\xfd\xfd\x14\xe4\x72\x09\xa6\x6b\xa5\xd8\x7f\x7f\xd8\x6c\x64\x0b\xca\x90\x4e\xeb\xc3\xfe\x5a\xb6\x50\x6f\x60\x2f\x8d\x11\x5b\x69\xe0\x9a\x16\xc8\x12\x1a\xd9\xf2\x33\x19\xb4\x52\xa0\x70\xe9\x99\xa2\xd6\x5a\x16\x1d\x1a\x57\x23\x0e\x46\x1a\x94\x89\xe0\xa5\x08\x78\x73\xa8\xaa\x38\x1a\x6c\xb7\x86\x6f\xfe\x18\xa7\x71\x7c\x23\x5a\xe4\xe0\x72\x09\x2f\xda\xf6\x59\x55\x1b\x59\xe2\x13\xad\xec\x0e\xad\xb6\x3e\x81\xb6\x10\x55\x65\x10\x2b\x01\x85\x5d\xd4\x6f\x1a\x47\xfd\xa3\x6b\xb0\xba\x99\xbf\x92\xc7\x64\x11\x20\x66\x9f\x5a\xa4\x7e\x2b\x7f\xeb\x6f\xb5\xe9\x2e\x6f\x29\x74\xc9\x84\x18\x70\x4e\xe8\xb8\x53\x95\x1c\x53\xae\x0c\x54\xb5\xe9\x32\xbc\x7e\x07\xa2\x95\xa0\xeb\x0e\x5a\xd9\xb5\x4a\x96\x71\x34\xdd\xf2\x22\xaa\x08\x66\x91\x22\x73\x2c\xaa\x75\x3b\x40\x4f\x6d\x70\x0b\x72\x35\xb2\x05\xa1\xcd\x51\xb6\x06\x8e\xaa\xdb\x81\xd0\x16\x26\x98\x4e\x74\x07\x13\x77\x77\x8d\x64\x08\xa6\x6b\x0f\x45\x07\xa7\x38\xba\xa2\x7b\xa0\x74\x47\xbc\xf8\xbe\x2e\xef\x9c\xf0\xed\xc3\x64\xb8\xb2\x04\x61\xe0\x3f\xae\x5e\xbf\x8a\x23\x5a\xf2\xfe\xc3\xf5\x5d\x27\xe3\x73\x1c\x6f\x0e\xba\x80\x44\xc2\x63\x82\x9c\xda\x0d\x92\x14\xd9\x84\xdc\x39\xc5\x11\x0a\xf5\x1a\x1f\xea\x77\x8d\x3c\x1a\xb8\xe6\x23\xba\x83\xd5\x82\xf6\x5b\x7c\x8c\xa3\x73\x1c\xa9\x0d\xe0\xc5\xfc\x9d\xde\x8b\xd6\xec\x44\x95\xc8\x1c\x37\xce\xe0\x11\x82\x4a\x61\xbd\x06\xad\x2a\x78\xf4\x08\xf0\x77\x6e\xe1\x7d\xb5\x86\xc5\x82\xe0\x5b\xf1\x05\xf7\x08\x2a\x5f\x45\xef\x99\x5b\xc2\xdf\xca\xdb\x2e\x91\xfc\x23\x45\x7a\x96\x4b\xb0\xbf\x28\x1c\xd9\x27\x2c\x3f\xfe\xfa\xf6\xed\x1b\xe6\x25\x20\x4f\x50\x01\x3d\x9f\x26\x7c\xe8\x81\x24\x29\xb2\x17\x4e\x7e\x7f\xb7\x9f\x67\x9f\x05\x4a\x0f\x26\x7b\xb3\x05\x73\x53\xe4\x2f\xad\xc1\xa5\x2c\x86\x13\xf1\x64\x6f\xb6\xfc\x2c\xfc\x79\x1d\xd2\xf1\xf2\x50\x75\xaa\xa9\xe4\xb3\x5d\xad\x0a\x69\x42\x1e\x3c\x22\xb8\x27\xfb\xd8\x2a\x00\x91\x01\x72\xd4\x5e\x79\x2e\x3a\x71\x0e\x79\xa4\x55\x85\xe8\x91\xce\xd8\x40\xf7\xac\xd6\x1b\xb5\x0d\x84\xb8\x93\xa2\x94\x2d\xd0\x7f\x84\xca\x5f\xe9\x42\x1c\x99\xc3\x35\x79\xef\xa2\xae\x58\xc4\x71\x54\x2a\x51\xb9\xc5\x3e\xee\xe4\xcf\xe9\x6a\x1c\xed\x7b\x57\x66\x9d\xe5\xf3\x43\x2b\x50\xfd\xe3\x68\x2f\x6e\x2f\xdd\xaa\x35\x91\x86\x20\x01\xf9\x98\xc8\xb6\xb5\xec\x72\x92\x7c\x46\x98\xbf\x6e\x9c\x4d\x0a\x5a\x47\xbf\xba\x9d\xe8\x60\x5f\x97\x6a\xa3\xa4\x95\x2f\xc7\xf3\x82\xe8\xb4\xd6\x32\x78\x1e\x1f\x4d\x1e\x87\xcc\x60\xe1\x90\xd2\x58\xe2\x71\x13\x83\x59\x01\x59\x20\x42\xdd\x09\x5d\x9a\x9d\xf8\x44\xea\x22\x45\xb1\x0b\xdc\x44\x06\x32\xdf\xe6\x3e\x83\xf0\x71\xeb\x87\x83\x68\x4b\xa7\x5e\xd6\xb4\xad\x7e\xd9\x4d\x12\xe6\x7c\xc0\xf4\x74\x88\x6a\xaf\x6b\xf8\x58\x52\xc3\x1c\xda\xa4\x24\x75\xce\xc0\xd6\xb0\x63\xf1\x85\x2a\x10\x9d\x9d\x4d\x84\x32\x95\x95\x2c\x3a\x76\x12\x1c\xd7\x1d\xb6\x2e\x4e\x64\xf0\x11\xb3\x86\x9c\x1e\xfa\x08\x09\xc7\xa1\x14\xea\x96\xef\xa0\x7d\x7f\xb4\x64\x05\xc0\x93\xa9\xf2\xfc\x6a\xda\xd4\x06\x42\x68\x5f\xad\xc1\x65\x39\x79\xb0\xe3\x1b\xfc\x17\x1d\xc9\x03\xd6\xa2\x07\x24\xd0\x0e\x85\xc0\x69\x6f\xf6\x5d\x7e\xd5\xb4\x4a\x77\x9b\x64\x71\xd0\x9f\x74\x7d\xd4\x03\x98\x1f\x7f\x63\x3e\x2e\xb2\xf0\x52\x9a\xc6\x11\xda\x5c\x54\xe7\xe1\xc2\x75\xb8\xe6\x82\x3c\xac\xe5\x80\x34\x9d\xb8\xae\x94\xd9\x39\x1d\xf6\xba\x65\x40\x69\xd3\x49\x41\x7a\x14\x58\x9c\x95\x03\x1b\x1e\x71\xdf\xfe\x9d\xb0\x85\x8e\x8d\xf3\x5f\xd0\x2c\x86\xb8\x86\x92\xcd\x7c\x96\x12\x67\xdd\x1c\x75\x4a\x59\x89\x3b\xb8\x96\x9b\xba\x95\xd0\x4a\x47\x90\xde\x66\xa0\x28\x32\x97\xf5\x01\x93\x3a\x10\x9b\x0e\x19\x80\x16\xb5\x11\x8a\xae\x74\x9d\xdc\x37\x1d\x1c\x1a\xe8\x6a\xd8\x8b\x5b\xab\x5d\xbc\x41\xb2\x57\x3a\xc3\xab\x43\x37\xf2\xaf\x68\xd7\x5e\x69\xf8\x76\x0d\x4f\xe1\x9f\xff\x24\xc0\xdf\xd2\x95\x0b\xfa\xb1\x50\xfa\x46\x54\xaa\x84\x6b\x8b\xcf\xa2\x17\x7e\xe0\xfe\xd6\x08\xc2\x5e\x0c\x53\x36\x24\x66\x9e\x7d\xaf\xd9\x09\x2a\x43\x39\x8a\x2c\x7b\xd7\x63\x37\xe7\xcc\xa3\xa2\xa2\xaa\xab\x41\xd0\x3a\x76\x3d\x6a\x33\x52\x9b\x3e\x73\x09\x79\x4f\x1c\x36\x68\xbb\x42\xe3\xa6\xf2\x06\x7d\x1c\x0a\xa3\xad\x9b\x46\x96\x96\xd1\x8c\x4a\xb2\xd1\x63\x77\xfc\x2f\x28\x91\x73\xf2\x6b\xd8\xe8\x0b\x2c\x78\x56\x6b\xf6\xee\x0d\x16\x71\xa6\x43\xe4\xbc\x33\x0d\x88\xcb\xe0\xb8\x53\xc5\x0e\xd7\xb6\xb2\x37\x1d\xca\xa2\xac\x72\x21\xe9\x39\x3c\xa3\xfc\x52\x19\x73\x40\x76\x52\x7e\x37\xe0\xc6\x51\xa8\x0e\xd9\xe0\x1c\x77\xb8\x03\xfe\x36\x87\x6b\x53\xb4\xea\x9a\x18\xde\xa8\xc2\x50\x06\x18\x5c\x15\x5b\xa1\x74\xce\x31\x06\xb1\xef\xa3\xea\xa1\xad\x00\x7c\xd8\x2c\x36\x5b\x00\x8e\x4c\x36\xe6\xc4\x11\xe6\x1e\x05\x65\x20\x45\x1c\x47\xfb\x03\x60\x15\x98\xbf\x3c\x74\xf2\x96\x72\xb8\xa3\x41\x4a\x30\x3b\x9a\xa2\x8e\x62\x15\x36\xc5\xe3\xcc\xb9\xd6\x85\x27\x00\x53\xd3\xa3\xc1\x70\x0a\xf0\xb8\x77\x04\xc8\x5f\x94\x18\x3e\x08\x50\xec\x84\xc3\xf7\x74\x8e\x23\x06\x03\xd7\x35\xfa\x2a\x79\xab\xba\xfe\x57\x59\x6b\x09\xd3\x47\x1a\xa9\xa9\x5a\xd8\x8b\xe6\xbd\x25\xf4\xc3\x63\xbe\x16\x47\xcc\xb0\xf0\xa6\x05\xbd\x5c\x52\x2c\xad\x30\xbf\x45\x76\xba\xac\x1c\xcd\xc9\xb8\xf0\x43\x8a\x69\x30\x69\xd7\x62\x2f\x33\xf7\xbb\x95\x85\x54\x37\xd2\x80\xa8\x2a\xbe\x16\x47\x1e\x5a\xb0\x15\xe9\xed\x0b\x5c\x90\xc6\x11\x3f\x0c\xf0\xfe\x03\x51\x40\xd7\x63\xae\xcf\x5e\x0e\xf8\x6e\x6d\xd1\x11\xe6\xb7\x0b\x23\x22\xd7\x2b\x88\x41\xed\x2a\x27\xab\x01\xee\xb1\x5e\x09\xf6\x66\x6b\x98\x6b\x7d\x2a\xc8\xfc\x1c\x32\xb3\x8f\x06\x4e\x88\x06\x9d\xdf\x20\x9d\x00\xa9\xcb\xa6\xc6\x1c\x94\xb9\x64\x53\x0a\x76\x01\x1f\x8f\x66\xb5\x5c\x56\x75\x21\xaa\x5d\x6d\xba\xd5\x1f\x9e\xfe\xe1\xe9\xf2\x68\x3e\xe6\xf0\x63\xe7\x9c\x72\x2b\x4d\x53\x6b\xa3\xae\x55\xa5\xba\x3b\xa8\x37\xb8\xa9\x13\x80\x6c\x71\x47\xd2\x83\x91\x35\xe4\x7d\x80\x49\x8a\xee\x16\xb8\xe1\x91\x3f\xb3\xff\x66\x80\xca\x6e\x39\x9f\x41\x4d\xa9\x8b\x81\x3c\xcf\x43\x67\x91\x42\xf2\x18\x15\x30\x63\x57\x82\xec\x41\xab\x58\xad\x07\x66\x81\x4e\x37\x88\x9a\xab\xcb\xa1\x3e\x8b\x23\xce\x46\x57\x13\x4d\x1f\x44\x47\x5c\xd8\x7b\xe6\x15\x80\xab\xa5\xfd\x35\x5a\x21\x6e\xa7\x2b\xfc\xb5\x0c\x1d\x75\x84\x7e\xe2\x97\x0c\x36\xb0\x5a\x73\xab\xc1\x11\x8b\x68\x63\xd6\xba\x5a\xc3\x26\x79\x54\x6c\xb6\x18\x14\xd4\x06\x69\x85\xaf\x6c\x89\x13\x86\x13\xad\x2a\xe6\x83\xc9\x7f\x6e\x45\x83\x2e\x36\x83\x45\x21\x34\x3a\x79\xd1\x34\xd5\x1d\xf3\xd1\x45\x17\xb4\xd0\xcd\x36\xb7\x04\x87\xbc\x30\xb0\x86\xf7\x1f\x2c\xf3\x4f\xc5\x66\x1b\x66\x20\xe7\x38\x8e\x0a\x44\xf6\x11\x72\x1e\x11\x38\xb4\x15\x73\xeb\xd0\x56\x48\x76\xb1\xd9\xf2\x85\x62\xb3\xa5\x0b\xe8\x8f\xe8\x12\x79\xa6\xd7\x9b\x64\x04\x34\xc5\x55\xe4\x44\x68\xd5\x5e\x7c\x92\xc9\x40\x97\x69\x01\xaa\x38\xdd\xbf\xb4\x80\x8d\x65\xc5\x10\x66\x7c\x08\x2d\xb3\x6e\x64\x05\xd3\x65\xe8\x4d\x68\x89\xb3\xff\xd5\x64\x49\xe0\x05\x70\xe5\x19\xbd\x22\xf1\x1d\x79\x52\x10\x33\x51\x9f\xd3\x78\x46\x54\x23\x49\xd1\xd3\x45\xce\x36\x21\xcb\xe4\x68\xd2\x38\xda\xd6\x50\xe4\xed\x41\xdb\x5f\xfc\x48\x91\xb9\xaa\x0b\x63\x1a\x99\x13\x19\xd5\x38\xb3\xcb\x7c\xeb\x61\xda\x94\xc0\x38\x6d\x13\x80\x49\x8b\x81\x2b\xd4\x02\xc8\x9c\x30\x22\xd7\x46\x26\x41\xb0\x2d\xf2\xfd\x21\xff\x5b\x5d\x7c\x4a\x2c\x61\x45\xce\xbe\x1d\xc9\xa2\x9b\xef\x74\xc5\xb7\x03\x32\x1d\x85\xae\xe5\xd2\xb5\x07\x89\x0c\xb3\xbc\x3a\x1a\x82\x75\x34\xae\x62\x27\x60\xb8\x36\x29\x72\xd2\x86\x94\x01\x0c\xe0\xbb\x15\xa8\x0e\x69\x3c\x03\x62\xb2\x3f\x3b\x65\x4f\xc0\x2f\xb0\x86\x23\x5a\x89\xea\x24\xfa\x9b\xb6\xae\x92\x20\xa4\x21\x7c\xf6\xab\xa8\x0b\xfd\x9d\xbf\xd4\xed\x5e\x74\xe1\xfd\xf1\x63\xaf\x70\x45\x85\x2b\x0e\xad\xcc\x60\xb1\x48\x33\x9b\x52\xbe\xaa\x8f\x49\x9a\x7f\x57\x96\x89\xef\xe0\xa5\x69\x88\x5b\x4f\x1f\xa3\x7f\x34\x39\x8b\x81\xc5\xfe\x5a\x53\x90\x81\x56\x6e\x95\xe9\x30\xd4\x85\x61\x6e\x10\xe5\x30\x94\x37\x95\x28\x5c\xdf\xad\x69\xe5\x8d\xaa\x0f\x06\x6a\x2d\x73\x78\x1b\x3c\x66\xe3\x25\x67\x87\x18\x92\xaf\xef\xdc\x3f\xdd\x4e\xa2\x2b\xdf\xd6\x6d\x7d\xe8\x94\x96\x97\x3a\x79\xa8\x63\xfb\x83\xe9\x28\x99\xbc\x46\x2a\xd8\xb7\xf7\xfa\xc4\xa8\x27\x18\x78\xbd\x53\x77\xa9\x20\x1b\xd3\x44\xcd\x4a\x89\xdd\xc6\xb1\xf0\x73\x67\x9a\xef\x11\xd8\x07\x9b\xfb\x59\x06\xbd\x70\x01\x1d\xf9\x67\x30\x99\xdd\x09\xad\x65\xc5\x31\x17\x99\xd1\xc7\xf8\x20\xdf\x63\xf5\xf4\xb9\x71\x4f\x5b\xee\x60\x22\x69\x1b\xd5\x61\x9a\x84\x0d\x9b\xda\x31\xc7\x76\x3c\x1d\xef\xdd\x7e\xc8\x52\xce\x7e\x27\xac\xb0\x00\x13\x7e\x50\xe9\x2e\x85\x6f\x9f\xf4\x29\x04\x71\x61\x87\xf6\xd1\xfb\x38\x7a\x24\xe3\xee\x6a\xfa\x10\x2e\x91\x85\x72\xbe\x15\x18\xd5\x0e\xed\x09\x64\x65\x24\x6e\x13\x15\x39\x67\x31\x6b\x10\x0d\x3a\xc8\xc4\x5d\xc9\xc0\xae\xed\xfd\xcf\x8e\x95\xf0\xca\x25\xaa\xc3\x44\x86\x01\x31\x1f\xc8\xc1\x66\xfd\x9f\x98\x2a\x8c\x33\x5c\xae\xd1\xc2\x04\x74\xcc\x2a\xbf\xd7\x7c\x82\x60\x41\xbb\x3e\x80\x77\x55\xec\x76\xc9\xc3\x14\xf5\x7e\x2f\x90\xae\xee\x36\xa3\xce\xd9\x33\x7b\xc1\x43\x66\x28\xe9\x9f\x2e\xb8\xea\xde\x4b\x07\x2c\x2f\x72\x7a\xc8\xbc\xa7\x7f\x3e\x78\xbf\x36\x12\xc2\xb0\x5b\xb6\x5c\xc2\x3b\xed\x99\x00\xa6\xab\x1b\x97\x7b\x3a\x9b\x9a\x61\xe2\x98\x25\x01\x88\x2f\x62\xca\x48\x65\x2a\xd9\xa1\x07\xa5\xc5\xc6\xf1\xe0\x12\x01\x97\xd9\x18\x60\xe3\x81\x9c\xe3\x31\xce\xc1\xd3\x53\x7c\xf9\xe6\x45\xc4\x55\x89\xb6\x80\x83\x2a\x2c\x94\xaf\x48\xd8\xa8\xf6\x0d\x5e\xc6\x28\x61\x7d\x61\xa2\xca\x0c\xbe\x09\xcc\xe1\xa0\x07\xb7\x9a\x34\x1e\x6a\x86\x91\x21\x3d\xec\xcd\x4f\x4c\xd7\xaa\x47\xeb\x2d\xb2\x68\xe5\x34\xfa\x27\xf9\xf7\x83\x34\xdd\x8f\xcf\x57\xa0\xca\xf3\x67\xb5\xc6\x36\xc3\xd0\xd6\x0a\x61\x24\x36\x53\x33\xa8\x3f\x21\x02\xdf\x3e\x69\x72\x4c\xe4\x57\x36\xa5\xfb\xaa\xfe\x34\xc8\xe5\xa6\x01\xda\xf6\x82\xf8\xf6\xa8\x27\x9c\x32\xfc\x6f\x9f\x14\xdd\x6d\xfe\xbc\xd6\x32\x49\x57\xfd\x6a\xbc\xf8\xa2\x6d\x91\x6b\xae\x26\x40\x7f\x0f\xf2\x56\x16\x87\x0e\x0b\x1f\xd8\xcb\x6e\x57\x97\xe4\xc9\x4b\x89\x39\xda\x20\xb5\x97\x19\xff\x22\xe2\xd1\x98\x0b\xa1\x0b\x89\x6d\x95\x5a\x07\x45\x83\x1b\x38\xb0\x90\x71\x21\xc6\xe8\x89\x3e\x88\xea\x42\xca\xcf\x68\xb8\x00\xc1\x1b\x66\x1e\x0f\x9c\xb5\x76\xf5\x4c\xfb\xbb\x14\x9d\x08\x92\x30\xa4\xa0\xc8\xdd\x74\x80\xc1\xdc\x97\x91\x5d\x4c\x9b\x19\x86\x43\x05\x33\xe7\xf3\xff\x88\x52\x5e\xd6\xc9\x97\xc4\x94\x15\xcb\x68\xa4\x83\x19\x0e\xa9\xc5\x0a\x90\x01\xff\x0b\xfa\xe8\x91\x1f\x6b\xe4\x04\x93\x01\x2a\xa1\x2e\x3b\x69\x1d\xfc\x34\xc7\xcd\x1c\x7a\xb9\x5f\x52\xf0\x22\xef\xda\xbb\x2b\xb4\xe4\x90\x61\x23\x06\x79\x9b\x0e\x1c\xd7\x33\xd2\xdf\x73\x7a\xd9\x48\x46\x3a\x1b\xc8\x8d\x7d\x94\x8b\xc7\x98\x0e\xa4\xe0\xea\x0b\xa4\x95\x5c\xd3\x23\xbe\x80\x56\x4d\x8c\x0d\x6b\x96\x1e\x59\x1f\xd5\xfb\xfa\x66\xbe\xb6\x99\xc6\x20\xde\xe0\xbd\x2a\x31\x02\x35\x97\xbc\x77\x33\x43\xcd\x41\xcf\xd1\xd3\x78\x2a\x1e\x98\x8b\x51\x96\x31\x40\x63\x0d\x0d\x3e\xda\x47\x18\xbe\x9b\x81\x2a\x53\x9f\x87\x34\x9c\xba\x7b\x77\x84\x8a\x4f\x8d\x33\x33\xd3\x36\x23\xaf\x44\x59\xf2\xa0\x67\x32\xa6\xc9\x19\xcf\x8c\x57\xb9\x38\x35\xdb\x70\x27\x71\x40\x2a\x77\xad\x32\xc0\xba\x0e\x53\xde\x3b\x76\x2a\x7c\x19\xeb\x96\x0c\xb8\x40\x99\xa9\x7e\x90\x2b\x7d\x6d\xe4\xc4\xe0\x07\xcf\xbd\xe5\x1c\xcd\x9c\x81\x70\x45\x90\xe0\x26\x68\x49\xbc\xbe\x37\x5d\x67\x0a\x84\xda\x2a\x8e\xe6\x4d\x63\x46\xaf\xa3\x73\xcf\x71\xb6\x9b\x19\xbe\xa2\x13\x67\xe6\xcb\x72\xcc\x64\x67\x6e\x93\x41\xe4\x58\x5f\xc2\x12\x6f\xaa\x35\x43\xca\xb1\x1a\x9b\x52\x3d\x67\x85\x6e\xcd\xb8\x01\x79\xaf\x8c\x5b\xb1\x97\x6f\xef\x1a\x99\x01\xfd\x39\x09\x13\x16\xa8\xdd\x94\xfd\x59\x80\xdc\x43\x03\x04\x73\x8f\x03\xc4\xb4\xe4\x74\x06\x34\x2d\xf7\xb8\x18\xbd\x92\x1d\xd5\xa3\xcf\xa5\x28\x2b\xa5\x65\x72\x5f\xd9\xe8\xfd\xae\xab\x62\x99\xee\x64\x4c\x6d\xfa\xa7\x07\x10\x34\xf1\xee\xc4\xa3\x9c\xa7\xf5\x83\x3a\x80\x93\xd9\x91\xed\xb9\xa6\xc7\xd8\xf6\xb0\x3f\x37\x96\x54\xdf\xa9\x43\x59\xff\x12\x8a\xa3\xef\x45\x61\x93\x8d\x61\x20\x5c\x34\x37\xec\x2d\xf1\x22\x3b\x8c\x7c\x48\x87\xc5\x91\xb8\x19\x0a\x8d\xf5\x1b\xba\x1a\x7e\x63\x16\x0c\x3d\x75\x87\x0b\x50\x18\x7d\x6b\x2a\x49\x91\x7b\x45\x3e\xea\x58\x79\xcd\x0d\xca\xf4\xd9\xed\x27\xe3\xbf\x10\x08\x8e\xfd\xa8\x6c\x36\x87\x06\x0f\x17\xf5\x67\x4a\x6c\x1b\x76\x91\x4d\x77\x1e\x0a\x04\x99\xd8\x57\x18\xde\x70\x29\xda\x8c\x9b\x43\x20\x6e\x84\xaa\xf0\xd8\x13\xf9\x54\x9f\xbb\x9b\xbe\xde\x30\x34\x49\x6b\x25\x22\x83\x1d\x23\x2c\x15\x39\xab\x43\xf1\xc1\x4e\x18\xb8\x96\xd2\x9d\x92\x19\x1b\xa8\xdf\x7f\xc6\x48\x53\x6a\xfc\x7f\x71\x33\xe9\x12\x8f\x09\x37\x67\x6c\xd8\xb0\x3c\x9a\xbe\x35\xe4\x9a\x47\x3c\x30\x70\xd5\xb4\xeb\x69\x66\xf0\x34\x83\x4a\x6a\x5f\x02\x21\x4f\x31\x18\xd0\xfa\xbe\x13\xeb\x6e\x13\x62\xfc\xa7\xaf\x95\xc7\xc5\xd3\x39\x1e\xe1\xee\x7b\xbb\x23\xa8\x01\x4c\x56\xe0\xd5\xd0\x01\xce\x56\x24\xf3\xd5\x6b\x58\x9e\xcc\xe4\x7f\x11\xb2\x02\x65\x99\x5c\x36\x06\xaf\x06\x4c\x3d\x59\x04\xfd\xe9\x46\xd0\xbd\xb6\x51\x9d\x6b\x55\xad\x54\xc6\x4b\x9b\x47\x80\xa8\x45\x1c\xf0\xa7\x67\xa2\xc6\xaa\x12\x3e\x1f\x4c\x03\x27\xfa\xc1\xaa\x11\x84\x60\x2f\x71\xd4\x7b\xd4\x9f\xaf\x06\xea\x43\xb2\x87\xf5\x5c\x1e\x45\xa4\x58\xcc\x08\x1e\x23\x1b\x64\x53\xb0\x9e\x34\x7c\x7d\x52\x74\x51\xbe\x4d\xa0\x31\x04\xbc\x6f\xb9\xd8\x2a\xcf\xbb\x96\x31\xa6\x63\xd1\xe0\xdf\xd9\x34\xdb\xf6\xae\x38\xc8\x99\xda\x83\xa6\x86\xdc\xd4\xc8\x75\xd9\x8f\xf5\x0c\x1c\x74\xa7\xaa\xde\x80\x95\xb9\x60\xba\xb6\xe3\x3c\x35\x5a\x4e\x93\x86\x21\xbb\xc8\x07\xe2\x43\x52\x04\xb5\xaf\x51\x63\x48\x36\x45\xee\x71\x40\xab\x3d\xc7\x23\xb1\xce\x26\x94\xbe\x67\xe5\x3a\x2a\xcc\xe0\x62\x17\x70\xd8\x35\xad\xc6\x7d\xad\x38\xec\x67\xf5\x2e\xd1\xa3\x41\x27\x0d\xf8\xf8\x9b\xbc\x6d\x6a\x2d\x75\xa7\x44\xe5\x46\xee\xec\xf8\xd0\x7f\xdb\x01\xe9\x17\xb8\xbd\x80\xd4\x31\x03\x51\x29\xed\xb1\x05\x1f\xe3\xfa\xb9\x51\x9f\x81\xf2\xc8\xcc\xae\xc4\x11\xb4\xd0\x65\xbd\x57\xff\xa0\x29\x31\x98\x06\xf9\xcb\x45\x38\x6f\x45\x7d\xa2\xbd\xd0\x77\x3c\xea\x32\xc8\x79\x3c\x17\xba\x5a\x5b\x30\xcb\xdf\xc1\x6f\x87\xc7\x19\x12\x3c\x26\x9c\xff\xa8\xbb\x3f\xfe\x5e\x27\x4a\x77\x7f\xfc\x7f\x09\xaf\x4c\x7f\xfb\x4d\x9a\xce\x66\x99\xb6\xcd\x1e\x66\x94\xc8\x5a\x7f\x9b\xe0\x7f\x87\x9d\xbc\x04\xf3\x77\x4a\x3d\x51\xd6\x73\x43\x11\xce\x0d\x70\xfa\xb5\x6d\xeb\x83\x2e\x29\xb7\xf0\xb9\x4c\xe0\xb5\xc8\x52\x86\x73\x11\xe4\xe3\x18\x87\xe8\x1c\xa0\x85\xee\x3f\x3a\x8f\xec\x8a\xe1\x13\x95\xf0\x78\x0d\xbf\xfb\x93\xe5\x0d\xfc\xd9\xc9\xc2\x4f\xe3\xec\x06\xf6\xee\x7a\x72\x77\x98\x3d\x93\x34\x4a\x65\x1a\xd1\x15\xbb\x61\xfa\xec\x0c\xce\xf5\xca\xc9\x33\x66\x63\x1b\x55\x06\x3e\xc9\xa6\x03\x51\xa9\x1b\x6a\xb9\xe3\xc1\xdf\x89\x8b\x64\xa3\x9a\xda\xa4\x4f\x6e\xb1\x8b\x08\xab\x0b\xbe\x8e\x4d\x0c\x35\x36\xc1\x85\x6e\xb6\x84\x7b\xd9\x38\x43\x17\xc3\x0c\xf4\x27\x29\xca\x4b\x09\xa8\x3b\x8d\x8c\x42\xb3\xab\xdf\xd4\x7a\xfb\x57\xdb\x8d\x4f\x10\xf5\x64\xd2\xbd\xeb\x85\xf3\x25\xd0\xcf\xfd\x0e\x6a\xb4\x03\xf6\x39\x60\xba\xcd\x97\x53\xe0\x26\xab\x97\xc7\x40\x48\x1d\x47\xe1\x8c\xcf\xb4\xd2\xf6\x9f\x99\xe8\x04\xfa\xdc\xc3\x72\xd5\xdf\x15\x77\xf8\x47\x8a\x1c\xb4\x43\xa8\x3f\x72\x76\xc1\x05\x97\xfe\x32\x2e\x5c\x8e\x26\x47\x2a\x19\x37\x57\x73\x8e\x23\x7f\x08\x2f\x3a\xf7\xc9\xea\xc3\x79\x64\x3b\x17\x81\x19\xdb\x46\x0d\x69\x25\xd5\x19\x17\x76\xbe\x1c\xd8\xfc\x31\x27\x36\x16\x37\x43\x28\x17\x24\x92\x08\xcb\x07\xa5\x0f\xd2\x5b\xb2\xb3\xb1\xe4\x72\x4d\xc8\xda\x3c\x36\x12\xab\xdd\x6e\xb4\xe2\xad\x02\x79\xd3\xa9\xe2\x93\x24\x92\x2c\xed\xf2\xf8\x96\xae\x24\xfd\xe9\x7b\x6f\x3d\x76\x6d\x7e\xd5\xd5\x4d\xc2\x39\xe2\x69\xd6\x4f\xe2\x6e\x81\x97\x0c\x3d\x24\x41\x78\xb6\x62\xc7\xf6\x79\xbd\x53\x81\xde\x51\x21\x73\x59\xdd\xa6\x09\x5f\xbf\x7f\x74\x1e\x3a\x2d\xc7\x4b\x68\x84\x31\xdc\xf5\xa5\x5d\x30\xc2\x60\xeb\x65\x78\xfc\x24\xc3\x3f\xf9\xb4\x08\x85\x55\x7f\xc2\x66\x2c\x81\x50\x48\x93\xae\x00\x1f\x4c\xe6\xcc\x15\x07\xbd\x41\x22\xeb\x27\x5e\x2e\x74\xdb\xc1\xfa\x09\xdf\x91\xb1\x67\x8f\x5f\x72\xd3\x93\xf3\x5c\xbc\x44\x7f\x66\xd4\xf1\xec\xcf\x27\x67\xf6\xcc\xd3\xca\x69\x69\xd0\xda\x9b\x49\x3e\x1a\xd7\xf6\xf4\x79\xdf\x7b\x04\xe4\x1b\x87\x1f\xe2\x68\x94\x99\x04\x3d\xd1\x1e\x6a\xa0\x06\x14\x0e\x6d\xb2\x07\xdf\x3e\x41\xb4\x56\xbe\x67\xd9\xb8\x10\x3a\xa7\xbe\xc4\xda\x44\xda\xe1\xdf\xb4\x8f\xb2\xd1\x16\x4d\x3f\xf4\xb4\x2f\x10\x21\x82\x76\xd6\x48\x99\x2c\x57\x24\xe1\x51\xa4\x44\xab\x2a\xcd\x7c\xb6\x94\xe7\xf9\x64\xbe\x43\x19\xe9\x46\x87\xea\xb3\xd1\x89\xed\xcb\xcd\x66\x5e\x6e\xc7\x39\x0b\x28\x76\x48\xb7\x44\x2d\xe7\x83\x2e\xab\x39\x5f\x30\xa9\x86\x09\x3d\x5b\x07\xf3\xc4\x34\x1b\x8c\x52\xf9\x9d\x90\x05\x1e\x48\x42\xca\xd3\xbe\x28\x99\x32\xb3\xdf\x2b\xa8\x26\xb0\x9f\x45\xb1\xde\x9d\x16\x0c\xe8\x1d\xdc\xe0\x84\xc1\xd9\xcb\x0b\x77\x8e\xb1\x39\x98\xdd\xb8\x30\xe7\x17\x26\x68\x49\x7f\x34\x0b\x11\xec\xcf\xe7\xbd\x0d\x26\x5a\x71\x84\xfa\x0a\x1c\x47\x86\xe7\xf4\xf8\x98\x16\xcd\x5d\x06\xe3\x97\x46\xdc\x55\xb5\xf0\x87\xbd\x89\x55\x4c\xb2\x57\x98\xe7\xb4\x3e\xd9\x5f\x1c\x8d\xb8\x38\x30\x69\xb3\xcb\x1c\x51\xca\x60\xef\xce\x17\x5c\xf9\xb7\x70\xfc\x1b\x39\x75\x23\xf1\x45\x12\x7c\x83\x6e\x94\xc2\xe4\x80\x7d\x77\x2a\x36\x7e\x92\xc5\x0d\x14\x42\xc3\xb5\x3f\x48\x50\xd4\xba\x38\xb4\xad\xd4\x5d\x75\x97\xf1\x51\x43\x5a\x86\xeb\xe9\x54\x03\x9d\x18\xc0\x5d\x75\xdd\x65\x3c\x4f\x72\x5b\x60\x9a\xe8\x88\x76\xaf\x06\xd9\xf3\xca\x7c\x2c\xd2\x62\xfa\xfe\x27\xf9\x77\x1c\x87\x98\x66\x48\xfc\x07\x76\xf7\x28\x11\x1c\x33\xe1\x81\xa1\x51\x63\x0a\x05\xa0\x35\xde\xa0\x28\x42\x13\x1d\xfc\xe5\xa5\xd5\x00\x9f\xff\x62\xff\x10\x47\x5a\x1e\x69\x2b\xe4\x7f\x92\xd2\xb6\x74\xee\x10\x1d\x30\x8e\xb7\x65\x67\x8f\x4b\x06\x48\x4b\x5d\xe2\xa9\x49\xaf\x8e\xcc\x67\x64\x2a\xf3\x1a\xff\xec\xd9\x3d\x19\xb3\x85\x3f\xe9\x84\x20\xcf\xd9\x18\x3e\x32\x13\x2d\x17\x6d\x95\x5f\x50\x18\x14\xdb\xfd\x46\x17\x59\x35\xdf\x31\x27\xe6\xf0\xc9\xba\xf9\x99\xdc\x10\x4c\x06\x33\xdc\xc1\xe6\xdf\x78\xf7\x0f\xdc\x16\x4b\xf9\x20\x23\x3a\x97\x07\xce\xba\xc6\xd3\x36\x1c\xc3\x6d\x3c\x3a\x81\x4d\x0f\x67\x81\xb5\xd6\xac\xf9\x4e\xef\xf9\x91\xfb\xd3\xa6\x71\x03\x71\xd8\x32\x61\x50\x6e\x77\x7f\x5a\xdb\x45\x23\x58\x53\x78\x42\x27\x15\x47\xe4\xa1\x1f\x4d\x38\x81\xbb\x15\xdd\x2d\x9e\x42\x03\x9c\x3e\xe1\x00\x08\xf9\xce\xc7\xe4\xb4\xc6\x0b\xaa\xe4\x83\x74\x83\xf0\x84\x77\x1a\xbe\x41\x4b\xfb\x89\xe3\x70\x1d\xeb\xd5\xf7\xfd\x88\x89\xe5\xb4\x72\x02\xcb\x5c\x67\x22\xe4\x57\x3f\x87\x9c\x1d\xe5\xd1\x8e\xc1\x08\xc9\xe4\x38\xcc\x34\x79\x93\xc6\x13\xfe\x85\xcd\xa3\x41\xa7\x92\xbc\x07\x6e\x84\xea\xcf\x7c\x74\xe9\x06\x9f\x7f\x45\x5d\x82\xc4\xc0\x54\x8b\x52\x72\x3e\x4e\x92\x38\x11\xbd\x34\x0b\x36\xf9\xbd\x1a\x30\x55\x00\xc6\xf5\x4b\x44\x1f\x50\x98\xf7\xfc\x33\xf9\x64\x92\xeb\x05\xb3\x02\x93\x8f\x67\xb7\xcc\x17\x57\x26\x94\x78\x30\x77\xe0\x01\xfc\xc1\x10\xe7\x2c\xc8\xa1\xba\x6b\xd6\x31\xb8\xfe\x85\xaa\xf3\x17\xaf\xff\x72\x2f\x07\xfd\x46\xe1\x31\xbf\x5f\x49\xc7\x5c\x93\xf2\x85\x2e\x3d\x51\x84\xa9\xc3\x0d\x49\xd2\xe8\xe1\xdd\x74\x37\x63\x74\x5d\xc7\x85\x3d\xce\x1c\x65\xb2\x04\x73\x28\x0a\x69\x0c\xbe\x28\x7a\x77\x2f\x81\xb8\x69\x92\x42\x82\x3f\x43\xb7\x73\x23\x5a\xf8\x87\x6c\x6b\x76\xe1\xf8\x22\x50\x3e\xaf\x04\xb8\x0a\x15\xdb\xe9\xf1\x7d\xe3\x73\x93\xcf\x0f\xd0\x4d\xbe\x51\x5a\x99\x5d\x32\x69\xea\xa5\x71\x34\xbf\x53\x38\x59\x5f\x3d\x64\xb4\xee\xf7\xe0\x8e\xc7\x10\xea\x00\xe6\x3d\xb9\xb8\x2e\x47\xd0\xac\x54\xa6\x00\x59\xb9\x5c\xd9\x6a\xa8\xfd\x69\x72\xf6\x28\x61\x3d\x3a\x36\xc0\x4b\x33\xfe\x19\x9a\xc6\x24\xcc\x5b\xa3\x07\xe8\x95\xc9\xfb\x62\x06\x80\xd7\xad\xdf\x71\xe9\xb8\xc9\x87\x93\x52\xec\x1d\xcc\xcc\x17\x9c\x48\xc6\x07\x03\x9c\x8d\x7e\xa7\x4b\xd4\xb0\x79\x33\x15\xc3\x78\xcc\x3d\xd9\x5e\xff\x1d\xb6\x9f\xb7\x50\xde\xe6\x61\x8a\xec\x79\x1e\x18\xf7\x84\xb5\x03\xfa\x7a\x17\xcd\xb0\xad\x2c\x91\x32\x2e\x7b\x1e\xf2\xb4\xda\xb8\x41\x5e\xff\xb0\xdf\x97\x8d\xfb\x9e\xad\x47\x82\x0a\x98\xcc\x89\xa1\x09\xfc\xde\xe8\xc8\xd1\x41\x57\xd2\x18\xec\xdd\x52\xb6\x05\xa2\xc2\xfe\xc4\xfd\x9e\x81\x65\xdd\xbb\x3d\xef\x03\x82\x43\xc4\xac\xb8\x6e\xe2\xfd\xc5\xfe\x2f\x38\x62\xe2\x2d\xca\x27\x5a\x7c\x7c\x6a\x10\x3c\x98\xf4\xfb\x10\x67\x30\xc3\xfa\x86\x31\x47\x6a\xf0\xc7\x3d\xd1\xf9\x1c\xc7\xfd\x07\x0d\xbe\x36\x37\x34\x93\x72\x1f\xa6\x30\xfc\xcd\x02\xb5\xa1\x81\x24\xde\xce\x7f\xbe\xc2\x6f\x21\x9c\xcf\xa7\x93\xeb\xcc\xe0\xdf\xf6\xab\x06\x28\xa3\xd3\x89\x96\xfd\x50\xbf\x69\xe5\x46\xdd\x9e\xcf\xcf\xf8\x35\x59\x9a\x82\xa0\x94\x0e\x5a\xb4\x77\xec\xd1\x7d\x00\xe3\xc7\x86\x5f\xc2\xa0\xcf\x74\x0c\xbf\xa1\xe1\xdd\x25\x25\xc6\xb1\xff\x0e\x01\xf6\x55\x1d\x48\x3c\x6a\xcb\x5f\xe0\xa0\x12\x6d\x82\x92\x35\x2c\x8b\x18\x97\x0f\x97\xd0\xee\xcb\x86\xe6\x3a\x7f\xa7\xd5\xbe\xa9\xe4\x5e\xea\x4e\x96\xa7\xd3\x0f\x35\xa2\x0b\x01\xe2\xc8\x37\x7c\x71\xb1\x4f\x96\xd1\x3d\xa0\x51\xfe\x02\xcd\x75\x7e\xf9\x11\xfc\xb4\xc3\xe3\x0b\x38\xa4\x54\xb7\x93\x01\xbc\x92\xc7\xc9\x22\xef\x44\xc4\xe9\xa4\x36\x30\xbe\xcb\xeb\xed\x66\x2c\x28\xcf\x5f\x9c\x73\xf4\x65\x6c\x58\xc9\x5d\x7a\x97\x07\xb1\xa8\x37\xa3\x77\x79\xb0\x97\x9f\x91\x43\xc3\xb7\x06\xb9\xbf\x3c\x6e\x70\xf3\xa9\xe7\x39\x12\x92\x9e\x5f\x29\x5c\x62\x43\x90\x8b\x3c\xba\xb0\x04\x55\x52\xe3\x41\x4d\xad\xb1\x62\x3f\x9d\x58\xad\x15\x2a\x35\x3d\x61\x8b\x89\x40\xaf\xbf\x56\xf9\x95\x53\xa0\x79\xa5\xf6\xfd\x84\x0b\x9b\xa6\x70\x3a\x7d\xad\x58\x9a\xf3\xb5\x93\xd2\x44\x95\xca\x7f\xa8\xd9\x4f\xe0\xe1\x93\xf3\x19\xcb\x21\x7f\x9d\xb8\x4c\xe7\x34\xce\xe7\xd0\xa5\xd7\x07\x9a\xdf\x3c\x9a\x5d\x78\x1a\x66\xea\x1c\x58\xf9\x30\x65\x06\x8b\x00\xb5\x05\xe2\x91\x41\x7d\xe8\xa6\xa1\xb5\x77\x37\x13\x1f\x5c\x1f\x3a\xe7\x82\x1d\x47\x02\x87\x21\x3d\x67\x5f\x60\x27\x02\x19\x4b\x6f\x25\x9c\x4e\x5f\xfb\xcf\xda\x38\xb7\x73\xcf\xbb\x09\x10\xac\x1f\x9d\x11\xc7\x37\x74\xef\x9c\x4b\xef\x1f\x70\xef\xcd\xd3\x0b\xaa\xb4\x38\x0f\x8e\xfb\xa3\xb7\xda\x63\xff\xd1\x1d\xa3\x76\x6d\x13\x74\x0d\xb6\x23\x64\xa7\x68\xfc\x0a\x6a\xfe\x00\x29\x0f\x88\xf2\xef\xac\x86\x47\x94\xf9\xf5\x41\x92\xb5\x9c\xc8\x9a\x84\x89\xad\x48\xad\x73\xf7\xee\xc3\x22\x00\xb9\xc8\xf8\x2d\x58\xd7\xc6\x41\xc1\x58\x80\x2c\xfe\x09\xcc\x13\x67\x70\x2c\x7e\x99\x73\xe7\x87\x9e\x9a\x4a\xd9\xed\xfe\xd9\xf1\xbf\x6d\x38\x31\x35\xbf\x31\x41\xa3\xad\x4f\xc3\x38\xa5\xc2\xee\xa0\x6b\xbf\xd2\x7a\x8c\x5f\xe9\x48\x59\x9c\x5b\xf2\x96\xf6\xce\xd0\x57\x76\x96\xcb\xfb\x7d\x33\x75\x2b\xc2\x40\xff\xeb\xc3\xc5\x05\x2f\x3f\xd8\x2d\x68\x11\x0d\xfc\xf7\x05\xc7\x3b\x78\xd6\x3b\x61\x97\xe5\x31\xa2\x93\xe8\x34\xeb\xa4\xef\xa3\x8a\x19\xc9\x2e\xd9\xbd\x48\x39\xf4\xca\xff\x7e\x97\x1c\x12\xf7\x19\xf7\x3c\xe0\xc3\x7d\x4e\x3a\x5c\xf8\x2b\x5c\x35\x1a\xf5\xe7\xdd\xf5\x72\x19\xfa\xe3\x51\x37\x0d\xea\x41\x39\x19\x2c\xf4\x7a\xaa\x5c\xaf\xc4\x62\x7b\x6f\x00\x08\x09\xfa\x6c\x18\x08\x7a\x5f\xb3\xc1\x20\x83\xc7\xb3\x2e\x7e\xd0\x1f\x73\x4e\xb9\xef\xe2\xcd\xc2\xfa\xe0\x4e\xc8\x21\x97\x27\x61\x80\x1a\x59\xdc\x95\x9b\xdf\x33\x0c\x0a\x97\x03\x0f\xdb\xf9\x13\x7a\x7b\xe8\xdf\xc7\xa8\xfb\xe3\xe5\xff\x0d\x16\x2a\xfd\x6f\xe6\x20\xfb\xca\xde\x67\x8e\xff\x42\xc3\x47\xaf\x5c\xf0\x17\x96\x46\x27\x0c\x44\x51\xd4\x2d\x1e\x49\xf2\x0d\xb3\xfe\x74\x20\x7f\x95\x87\x1e\x56\xba\x93\xed\x46\x14\xf4\xb6\x57\x3f\xb6\xe5\x21\x44\x0a\x61\x51\xe3\xb8\xc6\x6f\xb0\x4f\x67\x79\x78\x60\xc4\xcd\xc1\xfb\xc5\x5c\x7e\x4f\xe7\x0f\xc9\x78\x65\x5f\xfb\x63\xbf\xcb\x03\x9a\x1f\x5c\xf8\x42\xc8\xbd\x2f\x1c\x1e\x9a\x74\xe3\x7f\xba\xe7\xca\xb7\xe0\xfe\xfa\x33\xdf\x67\x61\x49\xe1\x37\x66\xf0\x13\x4c\xc5\x69\x70\xc4\x8d\x96\xbb\xeb\x56\x14\x7e\x25\xf8\x92\x80\x03\x55\xb0\x6b\xf8\xe1\x1a\x92\x41\xff\x94\x9b\x3e\xbb\xe2\xce\xdf\x49\x61\x4e\x2a\x23\xd6\x13\x0b\xa7\x3a\x4d\x2f\x13\x8d\xbe\x83\xc5\xe7\x04\x1e\x61\xdb\x36\x9e\xdb\xee\x0b\x64\x3b\x69\xa5\x22\x98\xfc\x25\xef\x44\x3b\x38\x54\xfa\xd9\x35\x7e\x32\xcb\xa3\xed\x9f\x9e\x45\xe5\xa1\x9a\x13\x90\xec\x3f\x0f\xe8\xd0\xb0\x5f\x01\x30\xa7\x77\x46\xd2\x97\x7a\xd0\xcf\x98\x15\x9d\x1d\x3b\xf7\xa8\xce\xb3\xe2\x8b\xf4\x91\xb5\x0c\x8f\x8c\xe2\xea\x14\xe7\xd8\x4f\xe7\xde\x35\x9e\x60\xea\x85\xe3\x70\x7d\xae\x4c\x21\xda\xf2\x9d\xfd\x08\x90\x43\xb6\x97\xe1\x68\x28\xd7\xab\xe3\xe7\x95\x8f\xd6\x7e\x64\xc6\x71\x69\x8c\xb3\x7e\xfa\x40\x1b\x76\xdb\xfa\x90\x48\xbc\xe6\x2f\x4c\xf4\x3b\x8c\x15\xb5\xdf\x7c\x5e\x53\xc7\x5a\xca\xe2\xc2\x1a\x58\xf6\x36\xf8\x82\xff\x08\xab\x17\x82\x3c\xa3\xb9\x97\x0f\xac\x07\x7b\x9d\xce\x7d\xe5\x32\x33\x44\x8a\xa3\xc8\xcd\x91\x00\x40\xba\x33\x04\x71\x14\x21\x0f\x78\x6e\x22\x73\xfc\x81\x93\x14\xdf\xbc\xc1\xb5\xfc\x03\xaf\x73\x84\xc0\x77\xfd\xa4\x9f\xa9\x10\x18\xf7\xb9\x34\x00\x7c\xf9\xa7\xff\x44\x1c\xde\xe3\x83\x0a\xb8\x87\xcb\x95\x5d\x8b\xec\x8b\x3e\xd0\x16\x8c\x92\xe4\xf4\x2b\x75\x64\xfc\x83\x86\x51\x28\xaa\x2f\xb4\x72\xaa\xeb\x1e\x4d\xc4\x35\xe2\x63\x70\x1a\x63\xc8\x49\xdf\xca\x1d\xf2\x32\xe8\x34\x87\xdc\x2c\xed\x99\x0d\xf7\xf3\xf9\x88\x67\xfd\x01\x0f\x22\x97\xd5\x62\xa0\x34\xce\xae\xe5\x9c\x03\xfa\x5e\x61\xc3\x89\x49\x0e\x0f\x4e\xcd\x73\xea\xd7\x3a\xa1\x59\xe7\x12\x02\xfe\x62\xef\x32\x80\x3e\xeb\x0d\xfe\x7b\x00\xc6\xd6\x28\x41\x06\x57\x00\x00")
+
+func svcClientWsClientGoTplBytes() ([]byte, error) {
+	return bindataRead(
+		_svcClientWsClientGoTpl,
+		"svc/client/ws/client.go.tpl",
+	)
+}
+
+func svcClientWsClientGoTpl() (*asset, error) {
+	bytes, err := svcClientWsClientGoTplBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "svc/client/ws/client.go.tpl", size: 22278, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x76, 0x75, 0xb4, 0x5b, 0x4b, 0xf8, 0x93, 0xc7, 0x75, 0xcb, 0xf6, 0xae, 0xb7, 0xe5, 0xae, 0xa1, 0x39, 0xeb, 0x13, 0x1c, 0x79, 0x6c, 0xe4, 0x51, 0x52, 0x42, 0xa6, 0x5d, 0x81, 0xea, 0x96, 0x3f}}
+	return a, nil
+}
+
+var _svcConfigGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x90\xcb\x6a\x33\x31\x0c\x85\xd7\xe3\xa7\x10\x59\xfd\x3f\xb4\xf1\x33\x94\xf4\xb6\x2c\x4d\xa0\x6b\x47\xd6\x78\xc4\x34\xd2\x54\x96\x03\xa5\xf4\xdd\xcb\x4c\x48\x02\xa5\x06\x83\x8f\xcf\xe7\xcb\x39\x53\xc2\x31\x15\x82\x7a\xc4\x10\xf8\x30\xa9\x39\xfc\x0b\xdd\xaa\xb0\x0f\x6d\xbf\x46\x3d\xc4\xa2\xb7\x23\x7b\x9c\xa7\x5b\x92\x3a\x33\x71\x70\x9f\x56\xe1\x7f\x08\x31\xc2\x46\xa5\xe7\x02\xa8\xe2\x89\xa5\x82\x0f\x04\x46\x1f\x8d\x8d\x32\xf4\x4c\xef\xb9\x42\xaf\x06\xd6\x44\x58\x0a\x24\xa8\x64\x47\xb2\xe0\x9f\x13\x9d\x4f\x57\xb7\x86\x0e\x5f\xa1\xdb\x92\x1d\x19\xe9\x2e\x67\x83\x5f\xa3\xba\xb1\x94\xd0\xdd\xd3\xbe\x95\xbf\x80\x2b\xf2\x44\x42\xc6\xf8\xbc\xdb\xbd\xbc\x52\x9d\x54\x2a\x3d\x08\x6a\x26\x83\xf9\xef\xeb\x93\x38\x5b\x8f\x4d\x30\x74\x31\xc2\x1b\xed\xb7\x8a\x23\xf9\x1c\xa7\xe7\xd2\x8c\x4e\x81\x50\x45\x08\x9d\x55\x2a\x68\xbf\x6c\x5d\xd9\x4b\x2f\x37\x8b\x51\x5a\xb2\x0c\x49\xf2\xa2\xd4\xb8\xb0\x00\x0e\x84\x23\x19\x24\x23\xa8\xe4\x30\x91\x2d\x2f\xce\x5d\x30\x52\xe8\xae\xd7\x5d\x56\x1b\x95\x9e\x4b\xf8\x0e\x3f\x03\x00\x0e\xc0\x6c\xce\xa7\x01\x00\x00")
 
 func svcConfigGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -235,12 +277,12 @@ func svcConfigGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "svc/config.go.tpl", size: 270, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xc4, 0xbe, 0x6a, 0x20, 0x49, 0x1d, 0x53, 0x4c, 0x47, 0xb2, 0x5e, 0x73, 0xe8, 0xc6, 0x49, 0x42, 0xe6, 0x1d, 0xcc, 0xc3, 0x7f, 0xed, 0x4, 0x72, 0xa3, 0xac, 0x2a, 0xb8, 0xa6, 0xf, 0x97, 0x7a}}
+	info := bindataFileInfo{name: "svc/config.go.tpl", size: 423, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb, 0x5f, 0x3f, 0x69, 0x17, 0x13, 0x3b, 0xf6, 0x8, 0x6d, 0xee, 0x3f, 0x12, 0x42, 0x9a, 0x1a, 0x20, 0x8b, 0x3e, 0xca, 0x6b, 0x88, 0x2e, 0xc9, 0x18, 0xca, 0x1b, 0x24, 0x89, 0x5d, 0x85, 0x6c}}
 	return a, nil
 }
 
-var _svcEndpointsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xdd\x6f\xdb\x38\x12\x7f\x76\x80\xfc\x0f\xb3\x46\x0f\xb5\x0b\x55\xde\x7b\x4d\x91\x87\xbb\x36\xbb\x2d\x70\xfd\xc0\xa6\x7b\x7d\x28\x82\x05\x2d\x8d\x2c\x22\x12\xa9\x25\x29\x3b\x39\xc3\xff\xfb\x61\xf8\x21\x53\x96\xe2\x38\x5b\xa0\xb8\x03\xfa\x10\xd8\x16\xc9\xe1\xfc\xe6\xf7\x9b\xe1\x87\xb2\x58\xc0\x6b\x99\x23\xac\x50\xa0\x62\x06\x73\x58\xde\x43\xc9\x36\xb7\x29\xbc\xf9\x08\x1f\x3e\x7e\x86\xab\x37\xef\x3e\xa7\xe7\x67\x8b\x05\xfc\x86\xaa\x15\x82\x8b\x95\xed\x00\x1b\x5e\x55\x20\xd7\xa8\x36\x8a\x1b\x04\x53\x72\x0d\x05\xaf\xd0\x75\xfe\x37\x2a\xcd\xa5\xb8\x80\xed\x36\xf5\xdf\x77\xbb\xb8\x05\xde\x30\x83\x71\x33\xfd\xa6\x2e\xe7\x67\x0d\xcb\x6e\xd9\x0a\x41\xaf\x33\xfa\xb9\x58\xc0\xe7\x60\x1c\x32\x29\x0c\xe3\x42\x43\x8d\xa6\x94\xb9\x06\x23\xa1\x66\xb7\x08\x5c\xe4\x7c\xcd\xf3\x96\x55\x80\x22\x6f\x24\x17\x46\x43\xa1\x64\x0d\x1a\xd5\x9a\x67\xa8\x13\x6b\x4a\xe1\x9f\x2d\x6a\x03\x4c\xe4\xa0\x50\x37\x52\x68\x04\x73\xdf\xa0\x35\x45\x7d\x09\x8b\xd4\xb8\x37\x93\x00\xd3\xb0\xc1\xaa\xa2\x4f\x14\x99\xcc\x51\x69\x32\x60\x0d\xe6\xe8\x1f\x14\x52\xf9\x91\xd6\x5c\x62\x1f\x30\x0a\x52\x01\xb2\x55\xa0\xdb\xa6\x91\x8a\x62\x6c\x14\x13\x9a\xbe\xd3\x7c\x9c\x55\xfc\x3f\xcc\x70\x29\xac\xb9\x42\xaa\x9a\x19\x9d\x12\x72\x5e\xdb\x4e\xb3\xf3\xb3\xc9\xb4\xa8\xcd\x94\x3e\x29\x00\x78\xe7\xbe\x0b\x34\x8b\xd2\x98\x86\x7e\xec\x6d\x4e\x57\xdc\x94\xed\x32\xcd\x64\xbd\x58\xc9\x97\xb7\xdc\x2c\xe8\xaf\xeb\x10\x86\x90\x85\xf1\xae\x01\xb9\xeb\xd4\x2c\x61\xba\xdd\xa6\x9f\xfe\xf9\xce\xba\xf3\x89\x99\x12\x5e\xee\x76\xd3\xf3\xb3\xb9\xa7\xe7\xaa\x0b\x78\x26\xab\x0a\x33\xa3\x03\x6e\x53\x46\x71\x04\x53\x32\x03\x99\xac\x1b\x0a\x12\x13\xc0\xf2\x3c\xb0\x93\xc2\x3b\xf3\x5c\x5b\x6b\x35\x32\x61\x88\x8c\x25\x42\xab\x31\xa7\xa8\x33\x28\xb1\x6a\x50\x81\x36\xaa\xcd\x4c\x42\xcd\x7e\xae\xf1\xa9\xb8\x30\x12\x98\xb5\xa7\xb9\x58\x55\x08\x0d\x53\xac\x46\x83\xca\x0a\xd4\xb6\xbc\x13\xc0\x1c\xe5\x2a\x01\x6e\x9e\x6b\x9a\xaf\x68\x2b\xcb\x5c\xd1\x8a\x8c\x58\xf1\x6e\x0b\x24\xe2\x24\xc8\xc6\x26\x0a\x48\x1a\xdb\xa0\x7a\x19\xe6\xb4\x16\x97\x4c\x73\x9d\xc2\x2f\x52\x01\xde\xb1\xba\xa9\x30\x81\x7b\xd9\x42\xcd\x57\xa5\x81\x86\x69\xd2\x4d\x14\x2f\x72\xb2\x9b\xc9\x4d\xd4\x28\x99\xb7\x19\xba\x58\x30\x01\xc4\x56\xfa\x96\x89\xbc\x22\x2f\x37\xdc\x94\x80\x2c\x2b\x7d\x02\xc0\x2c\xcc\x3f\x87\x0d\x57\x98\x43\xdb\x38\xab\xba\xc1\x8c\x17\x3c\x83\x86\x99\x32\x85\xd9\x3b\xe7\x21\xd7\x34\xc3\x92\x2d\xab\x7b\x60\x50\x73\x6d\x5c\xf6\x40\x8e\x9a\xaf\x04\x8d\xe5\x62\x2d\x6f\xd1\x06\xf4\xda\xb1\xd3\x65\x9b\x75\x12\x0f\x48\x77\x9c\x90\x8d\x10\xcd\x74\xde\x8f\x71\x56\x71\x14\xa6\x1f\xe3\x88\xc1\x7d\xee\x56\xf7\x94\xe1\xce\x20\xe6\x47\xf9\xa4\x24\x73\x11\xe3\x14\xe7\x1a\x9d\xc2\xf6\x3e\x73\x61\x50\x15\x8c\xb4\x35\xce\x87\xb5\xd6\x4d\x37\x5e\x41\x5a\x9a\x0d\xfa\xb9\x93\x7e\xc0\xcd\x6b\x8f\x28\x93\xf5\x92\x0b\x1b\xac\xda\x3b\x19\xf1\x9b\xf8\x32\x63\x5a\x25\x80\x5b\x51\x93\x87\x19\xab\x2a\x54\x4e\xd7\xde\xdb\xf4\xfc\xcc\x02\x1a\x44\x75\x6b\x33\x30\xfd\x5d\x74\x28\x31\xdf\x6e\x7f\x95\x1f\x58\x8d\x90\x86\xd1\xf4\x6b\xb7\xbb\xb6\xc1\x3f\x3f\x9b\x90\x97\xee\xc7\xc7\xc6\x69\x18\x00\x6a\xd6\x7c\xd5\x46\x71\xb1\xba\xf9\x7a\xd3\x21\x4a\xe3\x7e\x7e\xe8\x6f\xae\x46\xbe\x09\x95\x2d\x1e\xba\x1f\xe8\x9a\x7d\xdf\x5f\x5a\x91\x75\xa3\x5d\x55\xbd\x0a\x95\x72\x74\xb4\x6b\x0d\x7d\xa3\xe1\x5e\xea\xf4\xc4\xba\x1d\x0f\xa7\x4c\x99\x59\x0a\xc2\xc0\x2f\xb4\xf8\xa8\x04\x5e\xf8\xa7\xd6\x19\x5b\x97\xb6\x5b\xc5\xc4\x0a\xe1\x19\x87\x8b\xcb\x7d\xa4\xde\x3b\x25\xd3\x4a\x33\xd9\x6e\x81\x17\x96\xa2\x99\x90\x06\x9e\xf1\x60\xe0\xda\x28\x64\xf5\x3c\x7a\xec\x66\x0b\xcf\xed\xe8\xc9\x76\xfb\x8c\xfb\xc8\x07\xda\x26\x41\x39\xe9\x55\x57\x16\x68\x1a\x14\xb9\x1d\xb4\xdd\xbe\xa4\xef\xf4\xd5\x2e\x75\x04\x08\x3e\xe0\xa6\xa3\x7d\x36\x8f\x24\x40\xdc\x7b\xf1\x74\x0f\xe9\xd9\x90\xdf\x8b\x09\xd8\x65\x70\x76\x02\xc9\xf3\x24\x98\x38\xe0\xf9\x62\x68\xe3\x08\xdb\xb1\x99\x3e\xe1\x17\x47\xcc\x0c\x69\xdf\xdb\x89\x99\x1f\x03\x74\x2a\xfb\xd6\x62\x88\x70\x5c\xa9\xbe\x87\x28\x2c\xa3\xb3\x28\x91\xe7\x10\xe9\x64\x96\x99\x3b\xf0\x4b\x78\xfa\xda\x7d\x26\x54\x39\x5f\x34\xcb\xb4\x4b\xeb\xfd\x9c\xbb\xdd\x1c\x66\xc3\x36\x37\xf1\x6e\x97\x00\x2a\x25\xd5\xdc\x2a\x65\x32\x09\xfb\x19\xfb\x98\x00\x62\x3a\xa2\x51\xf2\x81\xe6\x9c\xdb\x31\xbc\xb0\x9d\x7f\xba\x04\xc1\x2b\x6f\x27\x88\x4e\xf0\xca\x9a\xb2\x0f\x77\x7e\x0a\xdb\x12\x66\x4a\x8f\x38\x37\x4f\xc8\x00\x8d\xda\x1d\x26\x81\x4f\x01\xcb\xce\x7b\x5a\x7f\x7a\x14\xd9\x35\xee\x99\x41\x4b\x91\xe7\x24\xa6\xed\x99\xc1\x51\xe6\xbe\x95\x3a\xc7\x1d\xf9\x33\x16\x35\x0d\x16\x69\x3c\x79\x5c\x75\xe7\x30\x48\xfd\x83\x68\x5a\xf9\x8e\xf2\x1f\xf6\xa4\xdd\x8a\xb5\x25\xda\xbb\xdd\x69\xf4\xd8\x31\xdb\x23\x9d\xec\xff\x49\x61\xf1\x56\xc6\x28\x09\x5a\xf2\x03\xd6\x9d\x40\x74\x7a\x20\x4e\xeb\x4c\xe8\x37\xaa\x8d\x71\x75\x04\x7d\x74\xad\xeb\x8e\xfd\xd0\x14\x28\x0a\x32\x98\x74\x3a\xe8\x0b\xe2\x8b\x62\xcd\x3f\xaa\xea\xea\x2e\xc3\xc6\xc0\x46\xb1\x46\xbb\x1d\x4f\x17\xd7\x82\x63\x95\xd3\x9e\xcf\xaf\x91\xfb\x8a\x69\xa5\xe3\xb6\x09\x23\xfb\xd9\xf4\x3d\xcf\xf3\x0a\x37\x4c\xf9\x53\xca\xef\x3a\x1c\x5c\x68\xab\xde\x34\xd5\x3d\xad\xf6\xb4\x8b\x31\x64\xbe\xee\xba\xdb\x9d\x1a\xae\x51\xdd\x77\x34\x53\xd6\xd2\x62\xae\xbb\x05\x7c\xb1\x00\x57\x61\x69\x1f\x93\x44\x9b\x88\x8c\x09\xda\xcc\xd2\xf6\x0f\x73\x1a\xb7\xbc\x07\x41\xe4\xb8\x4d\x2e\xde\x65\x55\x9b\x63\xee\x8e\x2b\x4b\x24\x1f\x08\x76\x83\x79\x3a\x8c\xc8\x6c\xef\x55\x02\xd3\x6b\xc3\x4c\xab\xa7\x09\x4c\x3f\x71\xb1\x9a\xce\xfd\x92\x32\x43\x78\x11\x55\xa0\x87\x0c\xc0\x48\x64\x92\xbd\x3f\x69\x9a\xba\xd2\xeb\xb4\xc6\x85\x7f\x7e\x71\x19\x2f\xca\x8e\x84\xed\x6e\xeb\xf8\x7d\x09\x8f\x96\xd8\x6f\xcf\xd4\xc9\x34\xd2\xed\xf4\x02\xb6\xbb\x24\xd8\xed\xe4\x35\x89\x16\xdb\x89\x15\xd7\x84\x68\xfe\x83\x00\xda\x7c\xb1\x6e\x76\x60\xad\xfb\xbc\xa0\x76\x79\x4b\xed\x01\xee\x57\xbc\xbb\x79\x05\x3f\xc9\x5b\x9f\x01\x0d\x13\x3c\x9b\x15\xb5\x49\xaf\x1b\xc5\x85\x29\x66\xd3\xab\x60\xa4\x13\xc7\xf3\xbf\xe9\xe7\x90\x4b\xd4\x40\x40\xf0\x8e\x6b\xf3\x0a\x34\x62\xac\xa9\x4e\x97\x3a\x5d\xc9\x29\xb9\x35\x9f\x87\x72\x39\xc9\xb1\x42\x83\xb3\xe0\x84\x6d\xed\xe1\xe0\x22\xdb\xa3\xe8\xa8\xf9\xae\x24\xf0\xc2\x7a\x71\x79\x09\x3d\x3a\xba\x4a\x31\xba\xfc\xc0\x65\x14\x83\xd9\x68\x97\x79\x5c\x36\x8e\x51\x1a\x4a\xc6\xbf\xd8\x12\x2b\xcc\xf7\x22\x76\xb7\x13\x2b\x34\x21\xe9\xe2\xd3\xa1\xcb\xbd\x4d\x89\xa2\x6b\x95\x71\x9e\x79\x6b\x2e\x5b\x12\x57\x20\x7c\x0a\xb7\xae\x37\xb8\x3b\x0f\xe6\xee\x4d\x78\x46\xe7\x23\xc5\x33\x7f\x7e\x8d\xbc\x28\x79\x56\xda\xb1\x9a\x30\x0c\x9d\xf0\x07\x02\x3f\x3c\x1c\x88\xa4\x0a\xc7\x81\x21\x30\xbb\x8a\xb8\xcc\x4b\x86\x4b\xce\xc8\x2a\xd4\xaf\xaa\x3d\x6c\x7f\xbd\xb8\x0e\xfc\x4a\x3c\x56\x1b\x77\x85\x19\xf2\xb5\x3b\x41\x5a\x98\x07\xc7\xf3\x14\xae\x11\xc7\xed\xd8\xa6\x70\xb6\xed\xaf\x04\x24\xfa\x1c\x0d\xe3\x95\x3d\x87\x86\xc4\x75\xb7\x26\xfe\x08\xcd\x2a\x6e\xee\xd3\xa3\x45\xb0\x17\x81\xb8\x16\x3e\x39\xb2\x3f\x2a\xe5\x8f\x4a\xf9\xfd\x2a\x65\x6f\x60\x32\xbe\xb5\x7f\x72\xe1\xf4\x39\xf1\x85\x9b\xf2\xad\x31\x8d\xdb\xbe\x1c\x29\x0e\x28\x8c\xba\xa7\x74\x2e\x78\x85\x39\xbc\x1d\xdc\x33\x3c\x52\x37\xc6\x4f\xa4\x27\x6d\xc6\xdc\xcd\x12\x48\x3f\xd1\xff\xc0\x7e\x6c\x2c\x6a\x33\x1d\xe1\x7a\xea\xfe\xec\x51\x83\x0f\xc4\xef\x47\x21\xfa\xbf\x2f\x44\x6b\xb6\x97\xf6\x91\xdb\x39\x42\x2b\x03\x5a\x4c\x07\xf7\x40\x5f\xb9\xc8\x6e\x5e\x41\x07\x3c\x98\xbc\xa4\x84\x42\x91\xcf\x64\x02\xb1\xa0\x9c\xf3\x80\x95\xc6\xc1\x08\x7b\xfd\xf2\x90\x2f\x09\xfc\x7d\x1e\xf7\xff\xfa\xf3\x0d\x5c\xf6\x4c\x77\x61\x79\xc8\x4d\xb8\x0c\x88\x87\x35\xa9\x9f\x09\xbe\x14\x49\x81\xdf\xa7\x12\x0d\x3d\x78\x20\xad\x8f\xa4\xf3\xc1\xf8\x4e\x65\x61\x83\x71\x42\x5a\xbb\x14\x3e\x51\x19\x8f\x09\x23\x38\x10\xa9\xe3\x14\x71\xc4\xda\xd8\xf7\x7f\xc8\x0f\x27\xa1\x5e\xac\x82\x0c\xe8\xef\xa8\x5f\xb1\x1c\x82\x14\x7e\x45\x33\x24\xd6\x5d\x30\xb8\x57\x4c\xe3\x6e\x00\xd3\x5a\x66\xdc\xbe\xcf\xb4\x94\xd3\xba\xb0\xe2\x6b\x14\xfb\x4d\xdc\x9e\xb9\x88\xb8\xb1\xf9\x0e\xa9\x9b\x3f\x88\xde\x55\xdc\x22\xc0\x78\x22\x1b\xfe\xde\xa4\x97\x12\xe1\xe1\x23\xa9\xf8\xf3\x7c\x1f\xb2\x6b\x07\xa1\x7f\xb5\x4b\x01\xe1\x2b\x8a\x19\x1c\xb9\xd1\x05\xf7\xf6\xe2\x78\x88\x46\xed\x0f\xe5\xed\xdf\x8a\x1e\x9b\xce\xc9\xdb\x45\xe7\xe0\x26\xba\xa7\x0a\x6f\x6a\xa0\x8a\x03\x88\x41\x16\xc4\xf5\x31\x90\xdf\xa8\x8d\xc7\x80\x17\xac\xaa\x96\x2c\xbb\x3d\x8e\xfc\x98\x83\x5e\x45\x1e\x76\x5f\x45\x0f\xc6\x69\xa0\xa3\x2e\x6a\xb1\x8e\x82\x73\x23\x72\xe9\x5d\xe1\x8f\xea\x65\x78\x75\xff\x34\xc1\xf4\x66\x18\x06\xce\xbf\x58\x3f\x3a\x61\x5f\x32\xfd\xb7\x0e\x3d\xcd\x78\x63\x23\x9a\xe9\xe3\x1c\x17\xcd\x08\xd2\x6f\x56\xcd\x23\xe8\x47\x64\x33\x06\xff\xa8\x8f\x5e\x38\x1e\xfb\xa1\x70\x1e\x8a\xd6\x40\x39\x5d\xec\x4e\x52\x4e\xf4\xd2\x26\x52\x4d\xd6\x6a\x23\x6b\xfb\xee\x1a\xe2\x1e\x7d\xc1\x00\x17\xda\x20\xb3\xb7\x0d\xfe\x45\x6b\x89\x90\x63\xc1\xda\xca\xd0\x72\x7f\x54\x51\x91\xdd\x61\x3c\x4b\xd7\x08\x27\xbf\x33\x8a\x94\x15\xbf\x87\xea\xa9\xca\x1b\x1d\xa8\x2a\x46\x18\x2b\x6a\x00\xdf\xfd\x8b\xc8\x93\x94\x73\x04\xe5\xfc\x64\x74\x41\x1a\x65\xf8\x37\x82\x58\x1a\xe3\x70\x07\xb2\xe8\xc0\xc7\xb2\xb0\x57\xfc\x21\x1a\x6f\x99\x3e\x8c\x46\x56\x62\x76\xab\xed\xc1\xe1\x41\x4d\x70\xfd\x97\xb3\x6b\x38\xe3\x30\x46\x4b\x29\xdd\xbb\x8b\x3f\x4e\x81\xbd\x87\x26\x9d\xd6\xff\x1b\x00\x00\xff\xff\x82\xce\xeb\xc1\x22\x25\x00\x00")
+var _svcEndpointsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x5a\xdb\x8e\xdb\x38\xd2\xbe\x96\x9e\xa2\xc6\xc8\xff\x47\x0a\x14\x79\xf6\xb6\x83\xbe\x98\x4d\x32\x49\x80\x49\x26\x98\xce\xec\x5c\x04\x41\x40\x4b\x65\x9b\x68\x89\x54\x48\xba\xdd\xbd\x86\xdf\x7d\x51\x3c\xe8\xd0\x92\x1d\x77\x77\xb2\x8b\x01\x72\x31\x13\x37\x0f\x75\xf8\xf8\x55\x91\x2c\x71\x3e\x87\xe7\xb2\x44\x58\xa1\x40\xc5\x0c\x96\xb0\xb8\x81\x35\xdb\x5e\xe6\xf0\xe2\x77\x78\xf7\xfb\x07\x78\xf9\xe2\xcd\x87\x3c\x9e\xcf\xe1\x0f\x54\x1b\x21\xb8\x58\xd9\x7e\xd8\xf2\xaa\x02\x79\x85\x6a\xab\xb8\x41\x30\x6b\xae\x61\xc9\x2b\xb4\x63\xff\x85\x4a\x73\x29\xce\x60\xb7\xcb\xfd\xef\xfd\xbe\xd7\x01\x2f\x98\xc1\x7e\x2f\xfd\xbd\xdf\xc7\x71\xc3\x8a\x4b\xb6\x42\xd0\x57\x45\x4c\xe3\x3f\x04\xb1\x50\x48\x61\x18\x17\x1a\x6a\x34\x6b\x59\x6a\x30\x12\x6a\x76\x89\xc0\x45\xc9\xaf\x78\xb9\x61\x15\xa0\x28\x1b\xc9\x85\xd1\xb0\x54\xb2\x06\x8d\xea\x8a\x17\xa8\x33\x92\xa4\xf0\xcb\x06\xb5\x01\x26\x4a\x50\xa8\x1b\x29\x34\x82\xb9\x69\xd0\x4a\xa2\xa1\xe4\x84\xd4\xd8\x49\xc9\x80\x69\xd8\x62\x55\xd1\xbf\x28\x0a\x59\xa2\xd2\x24\x80\xe4\x95\xe8\xff\x5e\x4a\xe5\x27\x5a\x69\x99\x6d\x60\x04\xce\x12\xe4\x46\x81\xde\x34\x8d\x54\x04\xad\x51\x4c\x68\xfa\x4d\x96\x71\x56\xf1\x7f\x33\xc3\xa5\x20\x69\x4b\xa9\x6a\x66\x74\x1e\xc7\xbc\xb6\x23\x92\x38\x9a\x2d\x6b\x33\x8b\xa3\x19\x79\x8e\xd7\xf6\xa7\x40\x33\x5f\x1b\xd3\xcc\xe2\xa8\x13\x36\x5b\x71\xb3\xde\x2c\xf2\x42\xd6\xf3\x95\x7c\x7a\xc9\xcd\x9c\xfe\x6b\x07\xf8\x19\x71\x74\x60\x60\xf0\x77\x16\xef\x76\x4f\x81\x2f\x21\xbf\x30\x0a\x59\xcd\xc5\xea\xa5\x60\x8b\x0a\xcb\xfd\x7e\x38\x59\x70\xce\x57\x52\xce\x89\x08\xf3\xe6\x72\x35\xaf\x79\x59\x56\xb8\x65\x0a\xc9\xca\x95\x94\xab\x0a\xf3\x95\xac\x98\x58\xe5\x52\xad\xe6\x2b\xd5\x14\x4e\x3c\x0a\x92\x16\x47\xcd\x02\x66\xbb\x5d\xfe\xfe\x9f\x6f\xac\xbf\xef\x99\x59\xc3\xd3\xfd\x7e\x16\x47\x34\x4a\x31\xb1\x42\xc8\x5f\x49\xd7\xab\xc9\x80\x68\xb7\xcb\x7f\xa9\x38\xd3\xfb\xbd\x9b\xca\xcc\xba\x9d\xe0\xc4\xa6\x96\x33\xbf\xb1\x05\x56\x58\xbe\x6d\x6d\x72\x5c\x5d\xa1\x81\x86\x69\x4d\x0b\xb1\xee\x56\x19\x04\xab\x11\xb6\x6b\x14\x6d\xaf\x24\x29\x7f\x29\xd6\xfc\x52\x55\x5e\xd8\xcb\xeb\x02\x1b\x93\x39\x9a\x17\x4c\xc0\x02\x61\xe3\x06\x83\x0b\x00\xe6\x62\x88\x17\x44\x50\xc5\x0b\x4d\x42\x3a\x5c\x60\xbb\xe6\xc5\xda\x4e\xd5\x28\xa6\x4c\x30\xd2\x36\xfa\xd9\x50\xc8\xaa\xc2\xc2\x48\x95\xc7\xc4\xab\x09\xaf\x96\x1b\x51\x24\xda\x28\x2e\x56\x59\x2b\x2b\x7f\xe9\x7f\xa4\xe3\xa6\x38\xee\xb0\x7d\xa4\xaf\x0a\x38\x3b\x87\xfc\xc2\x07\x09\xad\xca\x7c\x0e\xbb\x1d\xf5\xe4\xaf\xe4\x7b\x85\x4b\x7e\xbd\xdf\x87\xc9\xad\x45\x3a\x90\xbb\xef\x82\x06\xb3\x66\x06\x0a\x59\x37\x14\x42\xd4\xe5\x25\xbd\x63\x35\xee\xf7\x21\x16\x73\x78\x63\x1e\x3b\x68\x90\x09\x43\xa1\x17\x90\x64\x1a\x18\xac\xb1\x6a\x50\x81\x36\x6a\x53\x10\xdc\x32\x68\x9d\x56\xca\x85\x91\xc0\x48\x9c\xe6\x62\x55\x21\x34\x4c\xb1\x1a\x0d\x2a\x4a\x43\xd4\xfe\x46\x00\xb3\xca\x51\x65\xc0\xcd\x63\x4d\xca\x96\x9b\xca\x46\x29\x21\x48\x11\xe8\xad\x17\xe8\x16\x54\x36\x36\x17\x82\xa4\xb9\x0d\xaa\xa7\x41\x21\x09\x5c\x30\xcd\x75\x0e\xbf\x4a\x05\x78\xcd\xea\xa6\xc2\x0c\x6e\xe4\x06\x6a\xbe\x5a\x3b\x82\x01\x13\xd0\xa1\x46\x06\xb6\x8a\x9c\x9e\x46\xc9\x72\x53\xa0\x85\x81\x09\xa0\xf0\xcc\x5f\x33\x51\x56\x64\xe3\x96\x9b\x35\x20\x2b\xd6\x3e\xd1\x41\x12\xb4\xa7\xb0\xe5\x0a\x4b\xd8\x34\x64\x24\x03\xdd\x60\xc1\x97\xbc\x80\x86\x99\x75\x0e\xc9\x1b\x43\x02\xb9\x86\x46\xc9\x05\x5b\x54\x37\xc0\xa0\xe6\xda\xb8\x24\x09\x25\x6a\xbe\x12\x34\x95\x8b\x2b\x79\xe9\x16\xc9\xaf\x7e\x9b\x54\xad\x89\x48\x72\x3a\x0f\xdc\x62\x00\xef\x90\xcc\xd3\x3e\xba\x45\xc5\x51\x98\x21\xba\xbd\x85\xeb\xf2\x73\x75\x03\x85\x14\x4e\x1c\x96\xc7\x96\xd1\x32\xde\x62\xc5\x09\xe1\x1a\xc9\x8e\xbe\xbd\x5c\x18\x54\x4b\x56\xe0\xa1\x95\x20\x17\x5a\x65\xd3\x7b\xc4\x86\x38\xd3\x25\x65\x9b\x26\xf3\x77\xb8\x7d\xee\xfd\x29\x64\xbd\xe0\xc2\xe2\x54\x7b\x13\x7b\x0b\x9b\xf9\x9d\xc4\x6c\x94\x00\x6e\x42\xf8\x16\xac\xaa\x50\xd1\x86\xc1\x82\xb1\x3e\x80\x8f\x44\x96\xb7\x72\x47\x69\x31\xff\x53\xb4\x3e\x63\xb9\xdb\xbd\x92\x14\x41\xd0\x8b\x25\x12\x8b\x2a\x8e\xc8\x5e\xf7\xfb\xf7\x86\xc8\xa5\x01\x00\x6a\xd6\x7c\x74\x39\xe1\xd3\xc7\x4f\xad\x6f\x79\x7f\x9c\x9b\xf9\x87\xdb\x0f\x5f\x84\x6d\xac\x3f\xb3\x9b\xe7\xba\xfd\xd8\x5f\x37\xa2\x08\x93\xdd\x06\xfa\x32\x6c\x8a\x93\x93\x5d\x6f\x18\xdb\xcd\xf6\x5c\xa7\x06\x6b\x73\x7f\x36\x45\x4a\x42\x83\xf2\x30\xef\x2f\x4a\xaf\x2a\x83\x27\xbe\xd5\x9a\x92\x52\x2e\xf3\x99\x8c\x53\x1e\xb3\xf8\xbc\x75\x2c\xa6\xdd\x62\xb7\xa3\x9d\x8c\x96\x28\x11\xd2\xc0\x23\x1e\x66\xba\xbd\x2d\xed\x35\x3b\x35\xa1\xdd\x6f\x35\x8f\xb8\x47\x3b\xac\x52\x34\x4e\xa7\xd1\x6e\x07\x58\x69\x3c\x3c\xa7\xcb\xff\x7e\x4f\x1d\xce\x15\x25\x4d\xed\xf6\xaf\x7d\x1c\x93\xff\xf0\x0e\xb7\x87\xd9\x92\xa4\xc7\x92\xf4\x2e\x8e\x3c\x27\x0f\x0f\xda\xc5\xd1\x98\x3c\x67\x11\x91\xe7\x12\x93\x13\x18\x94\x66\x5e\x82\xc7\x34\x90\xe8\x6c\x2c\xe2\x08\x95\x7a\x52\x86\x6c\x3a\x3b\x22\x65\xcc\xa9\x56\x4c\x9f\x56\x53\xde\x9c\x4a\xad\x34\x8b\x23\xbb\x16\xfd\x2c\xf8\x5d\xe9\x46\x96\x41\x72\x2c\x45\xa4\xd0\xa3\x57\x52\x98\x6b\xf0\xa7\xc2\xfc\xb9\xfb\x37\xa3\xfc\xfc\xc4\x0e\x7a\x25\xbd\xee\x0f\x37\x0d\xee\xf7\x29\x24\x5d\xbb\x53\xee\x3a\x32\x40\xa5\xa4\x4a\x81\xf8\x10\x85\x33\xb1\x6d\xa5\x88\xc2\x7c\x82\xd1\xa4\x9a\x54\xa5\x34\x85\x2f\xed\xd8\x9f\xce\x41\xf0\xca\x49\x09\xe4\x13\xbc\xb2\x82\xa8\x6d\x1f\x77\xed\x41\x4b\x7e\xc0\xa6\x34\x23\x59\xb1\x9d\x14\xa2\x8b\x2f\x61\x0a\xd3\xfb\x41\x77\x08\xa5\x8c\xce\x1c\xc8\x6a\x68\x16\xf9\x54\xd2\xfd\xdc\x35\x06\x59\x2e\xa7\xa6\xe4\xa6\x54\xce\x7d\xbe\x9c\x86\x0d\xce\x27\x30\xc2\x53\x53\x7d\x5f\x62\xc2\x45\x30\x35\xbd\x0d\xee\xf4\x8a\xb9\xc1\x81\x27\x49\x9a\xc1\x50\x44\x0f\xe9\x7b\x21\xfa\x37\xc1\xed\x81\x98\x59\x42\x8f\x40\x0b\x09\xdc\xdf\x6a\xe6\x73\x78\x4b\xa7\xad\x53\xb3\xc6\x03\xd3\x86\x5b\x2a\xd2\x38\x5a\xab\x49\xa7\xdc\x1a\x8d\x60\x9a\xb8\x29\x0c\x01\x27\x35\xd3\x39\x27\x5c\xaa\xdb\xe3\xd8\x8e\xd2\x4d\x08\xf2\x7e\xb3\xcd\x06\x83\x84\x43\x4b\xf0\x85\x50\xf1\x42\xf2\x64\x32\x30\x09\xed\x28\x8a\xae\xda\xbc\xa4\x07\xcb\x6a\xf3\x91\xc2\x2f\x7e\xd8\x54\x4a\x9a\x4c\x4a\x9e\x05\x6d\xdf\x55\x48\x3c\xbe\xc3\xaf\x4e\x17\x16\xdf\x0c\xec\x83\x67\x02\x6f\xee\x7d\x40\x0f\xd4\x04\xba\x68\xfb\xad\x3a\x50\xa5\x17\x66\xe4\x92\xbd\xe1\xdf\x26\xda\x7e\x08\xc6\x10\xe2\xff\xdf\xed\x3e\xc8\xdf\xe4\x16\x55\x3f\xc4\x7a\x23\x9c\xbe\x5d\x5f\xed\x99\x37\x28\x83\xc2\x5c\x9f\xd1\xff\xf6\x7e\x85\xc8\x02\x42\xf5\xa8\xca\xaf\x50\x22\x83\xef\x60\x93\x0d\xe1\xde\xfa\x53\x97\xbd\x10\x9f\xa2\x08\xda\x84\xe4\x17\xfe\x0e\x99\x90\xee\x99\x46\x36\xfe\x76\xeb\xd5\x86\xac\x1a\x0a\x16\x4a\x6e\x56\x6b\x7b\xc3\xe8\xf8\xa3\xa9\x1e\x81\x81\x20\xc0\xdd\x15\x49\x0a\x0c\x17\xe5\xde\xd0\x9c\xe4\xfa\x7b\xc8\x49\x0e\x75\x77\x92\x28\x8a\x46\xb4\xb2\xad\x13\xec\xec\xa0\xb3\xe1\x92\x68\x78\x72\x9a\xbe\x14\xbc\x84\x24\xbd\x2d\xd3\xdb\xd0\x12\xa5\x30\xd7\x6d\x16\x77\x09\x74\x94\x20\xfb\x21\x7b\x17\x1b\x2e\x50\x94\x49\x0d\x07\xce\x26\x83\x50\x6a\xcd\xe9\xc3\x92\x93\x80\xb7\x7a\x95\xf4\x36\x9a\x89\x2c\x72\x57\x93\x7e\x11\xe5\xf3\x4a\x6a\xfc\x1e\xa6\xb9\x2d\x6c\x08\x65\x2f\x33\xdc\xd7\xec\x3f\xb0\xb8\x4a\x52\x98\x0e\xe0\xe1\xc9\x33\xaa\x69\x17\x10\xb8\x4d\xa6\xc6\x5a\x6b\xc3\x51\xf3\xec\xfc\xb6\x57\xa4\xc7\x01\xfe\x6c\x22\xf3\x4f\x25\xfe\x7d\x1f\xa3\xba\x4d\xfb\x23\x40\xa6\xf7\x77\x5f\x16\x74\xf5\x40\xd8\x2a\xd6\x68\x57\xad\x69\xb3\xf8\x92\x63\x55\x52\x04\xfa\x08\x0a\x1d\xda\x95\x76\x6c\x99\x63\xa2\xfa\x9a\x77\x95\x3d\xaa\x5d\xc1\x9f\x3a\x14\xd5\xa9\x9c\xdc\x34\xd5\x0d\x55\x2b\xa8\x02\x63\x48\x78\x2f\xb4\xa9\xf4\x80\x57\xa8\x6e\xda\x7d\x9c\xee\x02\x14\xff\xa1\xe8\x46\xf2\x5c\x8d\x80\x8a\x30\x5d\xb1\xb0\x2d\x65\xfa\x34\xc3\x05\x95\xff\x43\x35\x72\x81\x80\xd7\x45\xb5\x29\xb1\x74\xf5\xf4\x05\x92\x09\xe4\x73\x83\x65\x3e\x42\x23\xe9\x6c\xca\x60\x76\x61\x98\xd9\xe8\x59\x06\xb3\xf7\x5c\xac\x66\x69\x1c\xce\x95\x4f\x46\xfb\x67\x8b\x50\x7a\x50\x20\x4c\xc0\x94\x75\xe6\xe5\x79\xee\x6e\x8a\xf6\x6c\xc1\x85\x6f\x3e\x3b\xef\x97\x16\xdc\x7a\xec\xf6\xc4\x8d\x5e\x21\x74\xfa\x64\xf6\xd0\xa3\x59\x34\xeb\x05\xc5\xec\x0c\x76\xfb\x6c\x4c\xb0\x76\xd7\xa1\x94\x49\x05\xc9\xcf\xe4\x13\xd9\xe3\x6c\x6b\xfd\x23\x93\xf9\x12\x3e\x67\x20\x2f\xa9\x3b\x78\xf8\x11\xaf\x3f\x3d\x83\x9f\xe4\x25\xb9\x1d\x45\x0d\x13\xbc\x48\x96\xb5\xc9\x2f\x1a\xc5\x85\x59\x26\xb3\x97\x41\x44\x00\x10\x1e\xff\x9f\x7e\x0c\xa5\x44\x6d\x6f\x55\x78\xcd\xb5\x79\x06\x1a\x07\xbb\x45\xcb\x4a\x9d\xaf\xe4\x8c\x8c\x4a\xfd\xa9\x37\x2a\xb1\x42\x83\x49\xb0\xc0\xf6\x75\x0e\x70\x51\x74\xe6\x87\x31\xf0\xdf\x41\x9c\x2f\xad\xfa\xf3\x73\x18\x60\x1f\x72\xc1\xe4\xed\x08\xce\x7b\x6e\x27\x93\x43\xd2\x2e\x6b\x1c\x5c\xbd\x7d\x7c\xe8\x93\xc1\xbd\x53\xc4\xa8\xde\x9f\xf9\x4f\x08\xf6\x63\x86\xc2\x02\xf9\x15\x65\x08\x74\x5f\x0f\x6e\x95\xc6\x73\xb8\x40\x9c\x14\x63\x7b\x42\x6d\x79\x10\x6e\xb6\x24\x5e\xa2\x61\xbc\xd2\x74\x26\x09\xec\x23\x31\xa1\x80\xcd\x2a\x6e\x6e\xf2\x3b\xc5\xb2\xb7\x60\x1c\xd2\x77\xfe\x7e\xf1\x23\xe0\x7f\x04\xfc\xb7\x0d\xf8\xc1\xbc\x0c\x1e\x12\xff\xe1\x66\x45\x69\xad\xfd\x7e\xfa\xa7\xc6\x5b\x07\x07\xd7\xa5\xc7\xc9\xc1\x1f\xf8\xdb\x55\x3b\x9e\x1d\x46\x97\xc8\xfb\xe6\x09\x12\x76\xd7\x84\xf0\x90\x6c\x30\xf0\x3f\xa9\x8f\x3b\xf2\xfd\xc3\xfd\x11\xf7\x6a\xe9\x64\xf3\x23\x90\x1f\x14\xc8\x53\x58\xde\x37\x44\xbf\x61\x64\x76\x7f\xf6\xc2\xf0\x2f\x6e\xd6\xaf\x8d\x69\xdc\xa9\x78\x1c\x8d\xad\x2d\x28\x8c\xba\xa1\xad\x9a\x1e\xa1\x94\xf0\x7a\xf4\xcd\xed\x78\x9c\x4e\x7f\x3f\x39\xe5\x80\xaf\x7d\x6d\xc0\xab\xf9\x9f\x9f\xf1\xa7\x10\x4b\x74\xcf\xa9\x87\x9e\xf9\xbf\xaa\xe0\x00\x98\x3f\x4e\x05\x7f\xa7\x53\xc1\x15\xeb\x38\x7d\xf8\x03\x35\x79\x29\x83\x97\x98\x8f\x3e\x56\x7e\xe4\xa2\xf8\xf4\x0c\x82\xc3\x41\xe0\x39\x5d\x94\xa9\x84\x23\x33\xd0\xfd\xef\x95\x94\x01\x5d\x15\xe6\xd6\x78\xfb\x8d\xf0\x90\x1d\x19\xfc\x23\xed\x0d\xff\xf8\xf3\x27\x38\x1f\xc8\xf5\x58\x1c\x32\x10\xce\x83\xab\xc3\x3b\xc2\x90\xe9\x3e\xed\x50\xd9\x2e\x04\xc5\x77\xcc\x3a\x63\xfd\x07\x82\xf8\x0e\xc1\x7b\x4b\x5e\x4b\xb1\x70\xb6\x3f\x21\x88\x6d\xc0\x9e\xc6\x8d\xaf\x51\x23\xa8\xef\xf8\x71\x02\x3d\x7a\xec\xe8\x46\x1f\xb2\x81\x64\x46\x7d\x01\x74\x40\xb0\xe1\x1b\x1d\x35\xa8\xc7\x07\x47\x86\x57\x68\xc6\x4b\xeb\x2a\x53\xda\x3e\x78\x9a\xd6\x0f\x4c\x6b\x59\x70\xfb\x5c\xd3\x6e\x35\x74\x0b\x5c\xf1\x2b\x14\x6d\x78\x77\x87\xb2\x63\x8b\x37\xa5\xbf\x7d\x6e\x04\x21\x95\x1e\x82\x81\xd0\xa2\xc5\x70\xf3\xee\xb6\x24\xbe\xfc\x16\xe0\x20\xe8\x42\x45\xee\x78\x44\xfe\x9c\xfa\x40\xba\x70\xb6\xfb\x6c\xec\x1e\x17\xd0\xbb\x1b\x7a\xe4\x44\x8f\x6f\x8e\x3c\x3c\xa0\xe2\x16\xbb\x2b\x56\x93\xfa\x6e\x83\x95\x85\x67\xa1\xc7\xd4\x5b\xae\x3b\x98\x86\xd2\x86\x54\xf1\x92\x86\x54\x19\x4e\x68\xb9\x42\x04\x38\xe6\xf0\x37\x26\xcc\xd7\x40\x58\xb2\xaa\x5a\xb0\xe2\xf2\x38\x0a\xc7\x0c\x76\xd4\xf2\x10\x0c\xa9\x35\x54\x7e\x84\x5c\x01\xc0\x1e\xb9\x82\x61\xb7\x39\x34\x78\x7f\x32\x49\xa2\xf1\xbb\x93\x87\xb1\x68\xa0\x71\x8c\xa0\x7f\x6d\x7c\xd4\x80\x01\x8f\x06\xf2\x86\x44\x42\x31\x49\xa4\xc1\x8c\x03\x4c\x9a\xf0\xfa\x9b\x53\xe9\x2b\x48\x4c\x70\x69\x0a\x8a\xa3\x36\x3b\x36\xa1\x98\x64\xd3\x21\xe4\x6e\xd3\x09\xc5\x89\x74\xea\xbd\x43\xea\x51\xa9\xd8\x68\x23\x6b\x20\x06\x43\x7f\xc4\x90\x45\xc0\x85\x36\xc8\xec\xf7\x03\xff\x3e\x71\x8d\x50\xe2\x92\x6d\x2a\x03\x52\xe0\x9d\x68\xd6\xd3\x33\x06\x76\xed\x3a\xe1\xe4\x67\x51\x1d\xdd\x7a\x72\x87\x54\xf3\x32\x87\x54\xeb\x8d\x1e\xd0\x6c\x04\x05\x9d\x1c\x1f\x48\xa7\x23\x1e\xa7\x27\x7b\xea\xb3\x8f\x77\x66\xc8\x97\x69\xd7\x6f\x73\x25\xe0\xd0\xe3\x0a\x7d\x66\x72\xb0\xbc\x66\xfa\x36\x2c\xc5\x1a\x8b\x4b\x6d\x6f\x1a\x07\x89\xc2\xf5\x37\x4b\xe3\x63\x0b\xc6\x60\x2d\xa4\xb4\x9f\xd1\x3e\x9f\xe2\x7f\xeb\xa5\xbc\x1c\xdc\xb8\xff\x33\x00\xae\x35\x02\xfd\x5f\x32\x00\x00")
 
 func svcEndpointsGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -255,12 +297,12 @@ func svcEndpointsGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "svc/endpoints.go.tpl", size: 9506, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x43, 0xd0, 0x76, 0x9e, 0xc5, 0xf5, 0x72, 0x30, 0x5e, 0xa4, 0x1f, 0xfb, 0x81, 0xfa, 0xe9, 0x2b, 0xba, 0x1, 0x46, 0x33, 0x66, 0x61, 0x53, 0xde, 0x9c, 0xd8, 0x53, 0xb7, 0xd0, 0xc5, 0xed, 0x9f}}
+	info := bindataFileInfo{name: "svc/endpoints.go.tpl", size: 12895, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x83, 0x75, 0xb0, 0x2, 0xd, 0x58, 0x18, 0xd4, 0x54, 0x6d, 0xdf, 0x3e, 0x6c, 0x81, 0xcf, 0x5, 0xd6, 0x1f, 0xbe, 0x4b, 0x79, 0x19, 0x3, 0x23, 0x38, 0x51, 0x98, 0x8, 0x79, 0x73, 0x7b, 0x8c}}
 	return a, nil
 }
 
-var _svcServerRunGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x57\x4f\x6f\xdb\x3a\x12\x3f\x4b\x9f\x62\x2a\xbc\x5d\x48\x0b\x97\x7a\xe8\xe2\xed\xc1\xfb\x7c\x68\x9d\x34\x0d\xd0\xb4\x81\x9d\xd7\x1e\x17\xb4\x34\x92\x88\x48\xa4\x96\xa4\xec\x04\x82\xbf\xfb\x62\x48\xc9\x96\x93\x38\x6d\x2f\x7b\xb1\x25\xce\x70\xfe\xfe\xe6\x8f\xd2\x14\x96\x2a\x47\x28\x51\xa2\xe6\x16\x73\xd8\x3c\x42\xc5\x77\xf7\x0c\x2e\xbe\xc2\x97\xaf\x77\x70\x79\x71\x7d\xc7\xc2\x34\x85\x15\xea\x4e\x4a\x21\x4b\x47\x87\x9d\xa8\x6b\x50\x5b\xd4\x3b\x2d\x2c\x82\xad\x84\x81\x42\xd4\xe8\x78\xbf\xa1\x36\x42\xc9\x39\xf4\x3d\x1b\x9e\xf7\xfb\x09\x01\x2e\xb8\xc5\x29\x95\xde\xf7\xfb\x30\x6c\x79\x76\xcf\x4b\x04\x83\x7a\x8b\x3a\x0c\x45\xd3\x2a\x6d\x21\x0e\x83\x28\x53\xd2\xe2\x83\x8d\xc2\x20\x2a\x6a\x5e\xba\xff\xc6\xbd\x96\xc2\x56\xdd\x86\x65\xaa\x49\x8d\xd0\x5d\x6b\x50\xa6\xb5\x2a\x75\x67\x9e\x52\x55\x85\xa2\xae\x1e\xd3\xac\xe9\x1e\x1c\x4d\xa9\xb2\x46\x56\xaa\x9a\xcb\x92\x29\x5d\xa6\xa5\x6e\xb3\x54\x63\x51\x63\x66\x85\x92\xc4\x24\xd1\x0e\x7f\x69\x65\x6d\x3b\x7d\x4e\xdb\x56\xab\x82\x4e\x94\x89\xc2\x30\x48\x53\xf8\x67\x0e\xb7\x5c\xdb\xc7\xb3\xd2\x07\xbe\x3b\x0a\xd8\x1a\xf5\x56\x64\x18\x06\xed\x06\xa2\xbe\x67\xb7\x1f\xae\x9d\xc3\xb7\xdc\x56\xf0\x76\xbf\x27\xc9\x7d\xcf\x4e\x0f\x21\xad\xb8\xcc\x6b\xd4\xe6\x0c\xd9\x6c\xb3\x28\x4c\xc2\x70\xcb\x35\x5c\x60\xc1\xbb\xda\x2e\x95\x2c\x44\x09\x66\x9b\x31\xff\x18\x86\x45\x27\x33\x10\x52\xd8\x38\x81\x3e\x0c\x28\xa6\x6c\x6d\xb5\x90\xe5\x37\xae\xe3\xbf\x9f\x5c\x64\x17\xb8\xe9\xca\xf7\x79\xae\x67\x10\xe5\xf4\xcc\x78\x9e\xeb\x68\x06\xd1\xfc\x8f\xdf\xff\xf5\x3b\x3d\x38\x16\xe0\x32\x87\x06\xad\x16\x99\x81\x5a\x18\x8b\x12\x88\x13\x8d\x89\x92\x1f\x29\x19\xa2\x31\xa8\x31\xfe\x6d\xaa\xe8\x0f\xa7\xe8\xd3\xdd\xdd\xad\xd3\x53\xae\x6e\x97\xcf\x95\xb8\xe8\xfe\x65\x10\x50\x6e\x85\x56\xb2\x41\x69\x61\xcb\xb5\xe0\x9b\x1a\xcd\x0c\x44\x01\x06\x2d\x83\x8f\x35\x2f\x0d\x54\x7c\x8b\xd0\x6a\xa1\xb4\xb0\x8f\x0e\xcf\x70\x29\xb7\xc4\x6f\x58\x18\x88\xc2\x09\x86\xf9\x02\x94\x61\x57\x68\x51\x6e\xe3\xe8\xe2\xf2\xc3\x5f\x57\xff\x79\x7f\x71\xb1\x8a\x92\x7f\x7b\x86\x37\x0b\x88\x22\x0a\x63\x70\x26\x6e\xb0\x70\x8c\x61\xb0\x77\x52\x1d\xaa\x4f\xa5\xde\x7e\x5d\xdd\x91\x3c\x47\x3a\x27\x6f\x12\x22\x58\x40\xd1\x58\xb6\x6e\xb5\x90\xb6\x88\xa3\xf9\xdf\x4c\x34\x73\xb7\x93\x51\xcb\x0b\xb6\xaf\x2f\x57\xdf\xae\x97\x97\x3f\x67\xfd\xa9\xb6\xd1\xfe\xfd\x80\x9d\x2f\xb8\xbb\x94\x79\xab\x84\xb4\x26\x1e\xb2\x05\xed\x86\xf5\xfd\x78\x91\x7d\xe1\x0d\xee\xf7\x6b\x57\xcc\x89\x43\xdf\xe1\x06\xa9\x4b\x53\xf8\xd0\x19\x21\xd1\x18\xc8\x55\xc3\x85\x64\x3e\x7d\xdf\x35\x6f\xc7\xe2\x80\x9d\xb0\x15\x34\x22\xcf\x6b\xdc\x71\x8d\x86\xc1\x1a\x11\xc6\x1a\x48\xa7\x94\x52\x85\xc1\x68\xc9\xe2\xc0\xc2\x48\xdc\x20\x6d\x34\x74\xc0\xc9\x68\xce\x41\x7d\x40\x45\x13\x87\x41\xdf\x6b\x2e\x4b\x84\xdf\x04\x05\xf0\xe0\xd0\x0d\xda\x4a\xe5\x86\xea\x2c\x0c\x82\xbe\x27\x34\x11\x14\x63\xa9\x2c\xfc\x26\xd8\x0a\xff\xdb\xa1\xb1\x6b\xab\x91\x37\xc9\xe4\xd8\xb4\x4a\x1a\x1c\xcf\xdd\xed\xa0\xef\xef\xd4\x67\xb5\x43\x4d\x2c\x3e\x54\x07\x7b\x16\x2e\x5a\x37\xfc\x1e\xfb\xfe\x19\xf5\xe8\x84\xb3\x01\x65\xee\x24\xf6\x3d\xca\x9c\x1e\xc8\x39\x3c\x04\x7a\xee\x65\x9d\xa4\x2b\xf9\xff\x78\x78\x30\x82\xbd\xe0\x05\x2c\xe0\x95\x08\xbc\xec\xda\x11\x1d\x06\xa9\x45\x63\x0e\x47\x44\xfd\x22\x50\x8e\x11\x7a\x02\x95\x67\xa8\x9e\xc1\x81\x97\x42\xab\xd1\x76\x5a\x1e\xcf\xa8\x22\x68\x40\x76\x12\x8c\xe5\xda\x1a\xe0\x20\x71\x07\x34\x21\x86\x49\x36\xf3\xcd\x6a\x7c\xa1\x80\x72\x70\x8d\x74\x38\xf3\xc6\xdb\x0a\x49\x52\xcb\x8d\xc1\x1c\x32\xdf\xb4\x89\xb9\x56\x65\x89\xda\xd7\xdd\xaa\x93\x71\x56\x4c\x9b\xb9\x6b\xe0\x23\xee\xe7\x13\x6f\xbe\xe0\x6e\xc4\x7d\xf2\x04\x12\x2f\x55\x2f\x39\x27\x0a\xc8\x8a\x92\x5d\xd1\x42\x20\x32\xea\xb5\x63\x6a\x2f\x65\xa6\x72\xd4\xb0\x58\x80\x14\xb5\x6b\x17\x3f\xe2\x1c\x4a\x9e\xde\x88\x3e\xb0\x8e\x6c\xd4\x4c\x7c\x42\x71\xb3\x56\xd9\x3d\xda\xc1\xe5\x30\xd8\x99\x65\x51\x8e\xc8\x3d\x90\xbd\xb7\xa4\xf8\xaa\xe3\x3a\x9f\x03\xc5\x23\xce\xec\x03\x0c\xcb\x01\xc5\x83\xfe\x67\xa0\xe1\x1f\x14\xfe\x11\xae\x09\xc4\xcf\x58\x50\x6b\xa5\x5d\xe8\x82\x31\xa5\x47\x18\x8c\x2a\x9d\x22\x52\x31\x83\x03\x18\x34\xd5\xdd\x7e\x16\x06\xc1\x57\x2d\x4a\x21\x97\x15\x66\xf7\xa8\x07\x73\x9e\x69\xde\x28\x55\xff\x40\xcb\x89\x9c\xf8\xb9\xa2\x21\x4e\x37\x98\x55\x5c\x8a\x8c\xd7\xc7\x7e\x85\x5a\x67\x14\xa8\x86\xdf\x63\x4c\xe4\xc1\x2f\x7f\xe3\x5a\x5a\xd4\xba\x6b\xed\xa8\x94\x85\x41\xa9\x8e\x16\x1c\xe8\x9f\xfc\x49\x4c\xe2\x86\xbb\x7e\xa0\xfb\xf9\x3a\x5e\x74\x1e\xfa\x98\x1d\x64\x7c\x76\xd8\x64\xdf\x85\xad\x3e\x0a\xac\x73\x13\xfb\xe5\x8b\xf9\x37\xe7\x79\x54\xf3\x47\xd4\xd1\x7c\xd8\x1e\xa2\x99\x3b\x74\xa3\x7d\x1e\x38\xc0\x1d\x37\x0c\xf2\x39\x61\xd7\xb2\x50\x71\xe4\xb5\x0b\x59\xba\xc1\x1e\x34\x0e\xdd\x14\xdd\x01\xd9\x78\xd3\x3d\x10\xb4\x83\x86\x79\x0f\xe2\x28\x75\x1a\xfc\x66\x96\x46\x33\xcf\x3e\xb8\xf7\x91\xcc\x77\x14\x76\x2d\x73\x7c\x48\x5e\xb9\x9a\x35\x79\x2d\x24\x9e\x97\xb0\xf4\x0c\xaf\xc9\xa0\x1f\x51\xbf\x22\xe3\xd6\x33\xbc\x26\xc3\x3c\x36\x1b\x55\x9f\x17\xb1\x76\xf4\xd7\x24\x58\xcd\xb3\x57\x6c\xb8\x23\x72\xe2\xe2\xeb\xc0\xf4\xe7\x5b\xcf\xf9\xd9\xc5\xfe\xbd\xcc\x5d\xa0\xe3\xd3\x24\x41\x43\x5b\x46\x3c\x40\x85\x8a\x7b\x68\x71\xd4\xad\x8e\xf5\x3c\x41\x4f\xed\x2a\x8e\x12\x28\xd1\x0e\xc2\xe3\xc8\x66\x6d\x34\x73\x00\x98\x2c\x1b\x89\x6b\x43\xc4\xfd\xe6\xd8\x6b\x5e\xc2\xdb\x25\x41\x9d\x30\x9b\x30\xff\x38\x6e\x8d\x07\xc5\xbe\x1a\x68\xf7\x1c\xaa\xcf\xed\x46\x39\x16\xa8\xa1\x66\xcb\x5a\x19\x74\x4e\xd8\xac\xbd\xe9\x1e\xc8\x3a\xfa\x36\x20\x78\xc5\x75\x12\x06\xb4\xb0\x7f\x1e\x45\xcd\x17\xe0\xd9\xd8\x0d\xb7\x59\x45\x06\x7c\xa7\x8f\x1f\x6d\x62\x77\x89\xa2\xf0\xce\x91\x3e\x21\xcf\x51\x3b\xfc\xaf\x91\x02\x68\xad\x90\xa5\x89\xfd\x47\x8c\xb4\x6f\xed\x63\x4b\x19\x89\x78\xdb\xd6\x22\xe3\xf4\xa9\xe1\x3f\x0e\x92\x27\x4a\xdf\x3d\xd5\x3a\x51\x35\xd1\xf2\x93\x92\x29\xaf\xe7\xdc\xf1\x82\xdf\xcb\xc7\x38\x19\xb2\xea\xf2\x69\x35\x97\x86\xb6\x4a\x16\x06\x6e\x70\x90\xb0\x43\xf9\x69\x2a\x3e\xa3\xb7\x63\xab\xa6\x85\xe5\x6a\x75\xbb\x1c\x88\x93\xb1\x19\xb4\x1b\xb6\xc2\x92\x94\xeb\x33\x1b\x62\x6c\x66\x60\xf4\x36\xa1\x09\x3b\x7e\x80\x1d\xee\xc4\x6e\xf4\x9e\xb6\xa0\x11\xae\x86\x79\x88\x4e\x03\x37\xc0\xf3\x17\x6e\xbc\x7b\x8a\xe8\x13\xdf\xab\xa9\x8b\x44\x1d\x3b\xe6\x13\x5c\x4e\x76\x85\xd9\x0f\x26\xe9\x0c\xdc\x98\x3b\x6b\xa4\xab\x42\x6f\xe7\x34\x71\x33\xa8\xa6\x96\xae\x69\xe5\xa0\xcd\x01\x28\x7f\xe7\x64\x0d\x99\xf6\xd2\x0e\xd7\x7f\xa5\x87\x1f\x5b\xf8\x50\x63\xae\x89\x0f\x3d\x1c\x9e\x96\x30\x0d\xad\x97\x9b\xb8\xdf\x94\xde\xbc\xac\xdc\x57\xf1\x9f\x6f\xdd\x18\x1a\xae\xe3\x83\xb0\x51\x12\xee\xc3\xff\x05\x00\x00\xff\xff\xf9\x39\x35\x0e\xad\x10\x00\x00")
+var _svcServerRunGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x58\xcf\x72\xdb\x38\xd2\x3f\x93\x4f\xd1\x61\xcd\xf7\x15\x39\x45\x53\xb3\xd9\x9d\x1c\xbc\xa3\x43\x62\x3b\x8e\xab\xe2\xc4\x2b\x79\xe2\xe3\x16\x44\x36\x49\x94\x49\x80\x03\x80\x92\x3d\x2a\xbe\xfb\x56\x03\xa0\x24\xdb\x92\xec\x9c\x44\xb2\xbb\x7f\xdd\xe8\xff\xd0\x64\x02\x67\xb2\x40\xa8\x50\xa0\x62\x06\x0b\x58\x3c\x42\xcd\x56\xf7\x19\x9c\x7f\x87\x6f\xdf\x6f\xe1\xe2\xfc\xea\x36\x0b\x27\x13\x98\xa1\xea\x85\xe0\xa2\xb2\x74\x58\xf1\xa6\x01\xb9\x44\xb5\x52\xdc\x20\x98\x9a\x6b\x28\x79\x83\x96\xf7\x07\x2a\xcd\xa5\x38\x85\xf5\x3a\xf3\xcf\xc3\xb0\x43\x80\x73\x66\x70\x97\x4a\xef\xc3\x10\x86\x1d\xcb\xef\x59\x85\xa0\x51\x2d\x51\x85\x21\x6f\x3b\xa9\x0c\xc4\x61\x10\xe5\x52\x18\x7c\x30\x51\x18\x44\x65\xc3\x2a\xfb\xdb\xda\xd7\x8a\x9b\xba\x5f\x64\xb9\x6c\x27\x95\x54\xbc\x69\xd8\xa4\xed\x1f\x9e\x51\x34\x57\x7d\xa7\x51\x4c\x1a\x59\xa9\x5e\x3f\xa7\xca\x1a\x79\x53\x3f\x4e\xf2\x51\x52\xca\xaa\xc1\xac\x92\x0d\x13\x55\x26\x55\x35\xa9\x54\x97\x4f\x14\x96\x0d\xe6\x86\x4b\x41\x00\x02\x8d\xff\x99\xd4\xc6\x74\xbb\xcf\x93\xae\x53\xb2\xa4\x2f\x52\x47\x61\x18\x4c\x26\xf0\xcf\x02\x6e\x98\x32\x8f\x07\xd1\x3d\xdf\x2d\xb9\x72\x8e\x6a\xc9\x73\x0c\x83\x6e\x01\xd1\x7a\x9d\xdd\x7c\xba\xb2\xae\xb8\x61\xa6\x86\x93\x61\x88\xc2\x60\xbd\x3e\x01\xc5\x44\x85\x90\x5d\x4a\x47\xd5\xc3\x10\x06\xc1\x7a\x9d\x7d\x6c\x38\xd3\xc3\xe0\x44\x99\xa9\x37\x02\x28\x0a\xe2\xa1\xef\x4f\x01\x61\x52\x33\x51\x34\xa8\x74\xb4\x9f\xac\x97\x79\x14\x26\x61\xb8\x64\x0a\xce\xb1\x64\x7d\x63\xce\xa4\x28\x79\x05\x7a\x99\x67\xee\x31\x0c\xcb\x5e\xe4\xc0\x05\x37\x71\x02\xeb\x30\xa0\x48\x65\x73\xa3\xb8\xa8\x7e\x30\x15\xff\xff\x13\xc1\xec\x1c\x17\x7d\xf5\xb1\x28\x54\x0a\x51\x41\xcf\x19\x2b\x0a\x15\xa5\x10\x9d\xfe\xfe\xdb\x87\xdf\xe8\xc1\xb2\x00\x13\x05\xb4\x68\x14\xcf\x35\x34\x5c\x1b\x14\x40\x9c\xa8\x75\x94\xbc\xa6\xc4\x7b\xd2\xab\xa1\xc4\xe2\x39\xee\x2a\xfa\xdd\x2a\xfa\x72\x7b\x7b\x63\xf5\x54\xb3\x9b\xb3\x43\x4a\xae\x84\xd9\xa3\xe1\x0e\x17\x73\x99\xdf\xa3\xc9\xee\xa4\xba\x47\xa5\x53\x88\x56\x3a\x5b\xb9\x97\x28\x85\x7f\x7c\x48\x21\xba\x66\x0f\xbc\xed\x5b\x10\x7d\xbb\x40\x05\xb2\x84\x5c\x8a\xbc\x57\x0a\x85\x01\x85\x7f\xf5\xa8\x8d\x86\x0e\x15\x6c\xf0\x88\x43\xf8\x74\x7b\xab\x09\x9f\x58\x7e\xdf\xc8\xca\x99\xb0\x70\x2f\x51\x0a\x1f\xfe\x95\x42\xf4\x6d\xa3\xfa\x55\x7d\xb0\x62\xdc\x50\xb1\x97\x52\x01\x03\x77\x96\x37\xfb\xe1\x3f\x3d\xf6\x38\xe7\x7f\xa3\x33\xe3\x2f\x7a\x3d\xd1\xfc\x6f\x7c\x69\x49\x8b\x5a\xb3\x0a\x35\x58\xa6\xe2\x75\x07\x6c\x03\x1d\xff\xaa\xed\x73\x72\xd8\x90\x79\x23\x57\x67\x52\xe8\xbe\x45\x95\x38\x63\x74\x23\x57\x27\xb9\xff\x16\xa5\xe0\x30\x62\x4a\xe2\x5d\xee\x4f\x8d\xcc\xef\x49\xe4\x46\x36\x3c\x7f\x04\x5e\x82\xa9\xd1\x59\x49\x76\xb3\xfd\x6e\xa3\x2e\xd8\x37\xcd\x29\x2c\x48\x3e\x85\x42\xc9\x0e\xa4\x82\xbc\x91\x1a\xa3\xc4\x55\xf8\x9f\x1a\x01\xc5\x92\x2b\x29\x5a\x8a\xfe\x92\x29\xce\x16\x0d\xea\x94\xd4\x68\x34\x19\x7c\x6e\x58\xa5\xa1\x66\x4b\x84\x4e\x71\xa9\xb8\x79\xb4\xdd\x16\x2e\xc4\x12\x96\x4c\xe9\x2c\x0c\x78\x69\xab\x00\x4e\xa7\x20\x75\x76\x89\x06\xc5\x32\x8e\xce\x2f\x3e\xfd\x79\xf9\xdf\x8f\xe7\xe7\xb3\x28\xf9\xb7\x63\x78\x37\x85\x28\xa2\x72\x0c\x0e\xd4\x1f\x4c\x2d\x63\x18\x0c\x16\x95\x0a\xff\x19\xea\xcd\xf7\xd9\x2d\xe1\x59\xd2\x21\xbc\x9d\x52\x83\x29\x94\xad\xc9\xe6\x9d\xe2\xc2\x94\x71\x74\xfa\x7f\x3a\x4a\xad\x74\x32\x6a\xd9\x63\xfb\xfc\x62\xf6\xe3\xea\xec\xe2\x6d\xd6\x3f\xd5\x36\xda\x3f\x84\xe1\xb6\x31\xfe\xa2\x97\x39\xa9\x18\x79\xa9\x3f\xda\x0e\xf5\x0d\x57\xeb\x35\x51\xb3\x4b\x79\xa3\xb0\xe4\x0f\xc3\x70\x21\x8a\x4e\x72\x61\x74\xec\x9b\x04\x74\x8b\xcc\x73\x7d\x63\x2d\x0e\x03\xa1\xa0\x4a\x6c\xc3\x3b\x2c\x4e\x8e\x9e\x4c\xe0\x53\xaf\xb9\x40\xad\xa1\x90\x2d\xe3\x22\x73\xa1\xbf\x53\xac\x1b\x9b\x3b\xac\xb8\xa9\xa1\xe5\x45\xd1\xe0\x8a\x29\xd4\x19\xcc\x11\x61\xec\xc3\x93\x5d\x4a\x25\xc3\x60\x34\x6b\xba\x61\xc9\x08\xee\x85\x25\x1e\x7e\x3c\x86\x4f\xba\xd1\xbe\x8d\x3d\xc1\x92\x29\x9a\xac\xeb\xb5\x77\x16\x27\x57\x59\xac\x6b\x34\xb5\x2c\x34\x35\x7e\x3b\x4f\x6e\xe5\x57\xb9\x42\x05\xbf\x70\xef\x89\x0d\xd8\xd4\x3a\xe3\x9a\xdd\xe3\x0b\x33\xd6\xeb\x17\xec\x5b\x93\x82\xf5\xda\x8f\x22\x32\x0f\x3d\x5d\x93\x05\x04\x78\x3c\x3e\xc9\x1b\x8c\xde\x40\x66\x7b\xec\x80\x29\x1c\x39\xd4\xd6\xb8\x6d\xc8\x34\xd2\xdc\xc7\x02\x46\x26\xfd\xb3\xd1\xdb\x9e\xf1\xb5\xf8\x6d\x54\x8c\xee\x4a\x61\x23\x4c\xde\x52\x68\x7a\x25\xb6\xdf\xc2\x21\x1c\x0d\xa6\x3d\xad\x17\xa0\x0d\x53\x46\x03\x03\x81\x2b\xa0\x75\xc4\x2f\x54\xa9\x9b\x6e\xe3\x0b\x8d\x3b\x06\x76\xf2\x7a\x06\x77\x28\x53\x23\x2d\x6b\x1d\xd3\x1a\x0b\xea\x6e\x34\xe5\x89\xb9\x91\x55\x85\xca\x0d\xf9\x59\x2f\xe2\xbc\xdc\x9d\xfe\x76\xe2\xf3\x12\xf2\xb2\xca\x2e\x69\xa1\xe4\x39\x4d\xd5\x19\xea\x4e\x0a\x8d\x17\x22\x97\x05\x2a\x98\x4e\x41\xf0\x86\x78\x83\xd7\x38\x2d\xb8\x93\x23\x24\xcf\x3a\xb2\x51\xb9\xdb\x08\x5d\x63\x5e\x33\xc1\x73\xd6\x6c\x93\x1b\x95\xb2\xa5\xdf\xb2\x7b\x8c\x89\x0c\xa8\x94\x54\xbe\x18\xae\x84\x41\xa5\xfa\xce\x8c\xa1\xc8\xc2\xa0\x92\xdb\xb8\x6c\xe8\x5f\xdc\x97\x98\xe0\xbc\xac\xed\x9a\x7e\x43\x18\x05\xc9\x23\x6e\xe3\x09\x36\x18\x5f\xad\xb3\xb2\x3b\x6e\xea\xcf\x1c\x9b\x42\xc7\x6e\xf5\xcc\xdc\x1b\x9d\x3f\x88\x1a\xf6\x88\x2a\x3a\xf5\xfb\x4f\x94\xda\x8f\xd4\xcb\xa2\xd3\xc0\x3a\x72\xbb\x23\x85\x41\x30\x24\xd9\x95\x28\x65\x1c\x39\xed\x5c\x54\x76\xa4\x04\x2d\x9d\x94\xe2\x9c\x7d\xc3\x15\x95\x3f\x5e\xf7\x0f\x71\x12\x06\x41\x9b\xb9\x13\xc4\xd1\xc4\x6a\x70\x7b\xe9\x24\x4a\x6d\x5a\x78\xa2\xfa\x4c\xe6\x5b\x4a\x76\x25\x0a\x7c\x48\x8e\x88\xe6\x6d\xd1\x70\x81\x87\x11\xce\x1c\xc3\x31\x0c\xe2\xe3\xcd\x11\x8c\x1b\xc7\x70\x0c\x43\x3f\xb6\x0b\xd9\x1c\x86\x98\x5b\xfa\x31\x04\xa3\x58\x7e\xc4\x86\x5b\x22\x27\xd6\xbf\x14\x7d\xf8\xe3\xc4\xa9\xfa\x6a\x7d\xff\x51\x14\xd6\xd1\xf1\xd3\x20\x41\x4b\xf3\x2d\xf6\xa9\x42\x49\xeb\x6b\x8e\xca\x67\xbb\x31\xec\x64\x4f\x93\x52\x66\x52\x00\x05\x1a\x0f\x1e\x47\x26\xef\xa2\xd4\x26\x80\xef\xe6\x84\x9e\xd8\xe9\x4c\xdc\xef\xb6\x35\xb4\x2f\xdf\x2e\x28\xd5\x29\x67\x93\xcc\x3d\x46\xbe\x93\x6c\x14\x93\x4e\x69\x17\x3a\xdf\x4e\xa8\x98\x82\x02\x4b\x54\xd0\x64\x67\xb4\xad\xd8\x43\x98\xbc\xbb\xee\x1f\xc8\x3a\xba\x19\x51\x7a\xc5\x4d\x12\x06\x74\x19\xfa\x3a\x42\x9d\x4e\xc1\xb1\x65\xd7\xcc\xe4\x35\x19\x70\x47\x97\x42\xa5\x63\x2b\x44\x5e\x78\x6f\x49\x5f\x90\x15\xa8\x6c\xfe\xcf\x91\x1c\x68\x68\xc7\xd4\xb1\xbb\xdc\x09\x73\x62\x1e\x3b\x8a\x48\xc4\xba\xae\xe1\x39\xa3\xcd\xd7\x5d\x8d\x92\x67\x4a\xdf\x3f\xd7\xba\xa3\x6a\x47\xcb\x1b\x91\x29\xae\x87\x8e\xe3\x80\x3f\x8a\xc7\x38\xf1\x51\xdd\xc4\x93\xb4\x81\x51\x4c\x68\x5a\x6c\x52\xbb\x20\x7a\x47\x6b\xd0\x35\x53\x08\x0b\x69\x6a\xdf\x5b\x69\x65\xb3\x03\x8e\xd4\x6e\x0a\x55\x51\x99\xda\xfa\xf5\xfe\x9d\xc9\xde\xa0\x7a\x32\xe3\xf6\x6c\x31\x4f\xa6\xf2\xd3\x0d\x85\xc2\x7c\xba\x33\x63\xf6\xcd\x52\xcf\x17\x27\x87\x80\xb6\x63\xee\x74\x0a\xfb\x10\xb6\x43\xea\x98\x21\xe4\xb1\xa0\x5b\x64\x33\xac\xc8\xbd\x6a\xbd\x7e\xc6\x83\x2a\xd6\xe9\xe1\x1d\xe2\x72\x76\x73\xe6\xd9\x8e\x9b\x99\x1c\x3c\xc9\xb6\xe8\x28\x89\xcb\x9d\xdb\xc1\xab\x12\xd9\x65\xcf\x54\x41\xab\x2c\xb5\x95\xdc\x3c\x80\xff\x13\x82\xae\xbb\xf4\x9b\x82\x82\x5f\x29\x79\xb2\x99\xbb\x50\x25\x10\xbf\x60\xb1\x95\xe6\x66\xc3\x38\xba\x37\xb1\x79\x71\xe0\x8d\x6e\xab\x3a\xce\xcd\x43\x0a\x7b\x8d\xf4\x0e\x4e\x41\xd1\xc9\x87\xd7\xcf\xf2\x5d\xf1\x8a\x8b\xb3\x1a\xf3\x7b\x3b\x5a\xed\xc8\x7a\x61\xfe\x42\xca\xe6\x67\x4d\x7d\x82\x1c\xbf\xd1\x5a\xa2\x3d\xcb\x8a\x2d\x34\x15\x96\x6f\xc8\x71\x9b\x6e\x6d\x70\x23\x35\x85\xe3\xb9\x90\xbe\xb2\x81\xa4\x70\xdc\x55\xbb\xdb\xe9\xf6\x0f\x9f\x8d\xb5\xb1\x4e\xc2\xe7\x43\x7f\x1c\x10\xda\xde\x34\x30\xde\x6d\x55\x7e\x20\xfc\x84\xc4\x7b\x2f\x72\x48\x8b\xcd\x38\xa7\x68\xb7\x73\x3d\x1b\x3e\x73\x5a\x02\x6d\x4f\xa2\xce\x72\x08\xcb\xb7\x3a\x87\xb6\x11\xff\x99\x25\x66\xbb\xc3\xf8\xde\x67\xb7\x18\xbf\xc4\xc0\xf3\x19\x96\x86\x87\xb6\x18\xb7\xbb\xbe\xdb\xaf\xdc\x8d\xb1\x3f\x4e\xc8\xec\x51\x1c\x1f\xb8\x89\x92\x70\x08\xff\x37\x00\x61\x4d\x01\xe4\xc6\x14\x00\x00")
 
 func svcServerRunGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -275,12 +317,12 @@ func svcServerRunGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "svc/server/run.go.tpl", size: 4269, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x15, 0xcd, 0xbb, 0x7, 0xcf, 0x51, 0xfa, 0xc5, 0x57, 0xae, 0xb4, 0x5a, 0xa4, 0x93, 0x68, 0x66, 0x26, 0x3, 0x61, 0x7e, 0x9b, 0xa1, 0x1b, 0x3d, 0x3f, 0x11, 0x55, 0xfc, 0x14, 0x8, 0x9f, 0xca}}
+	info := bindataFileInfo{name: "svc/server/run.go.tpl", size: 5318, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x32, 0xcf, 0x9b, 0x5b, 0x92, 0x4a, 0xc6, 0xfa, 0xff, 0x14, 0x3e, 0x1c, 0xbd, 0x89, 0xf6, 0xe2, 0x29, 0x4, 0x21, 0x88, 0x81, 0x56, 0x81, 0x49, 0x1a, 0x9, 0xe0, 0xfb, 0x5c, 0x9a, 0xc1, 0x25}}
 	return a, nil
 }
 
-var _svcTransport_grpcGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\x4d\x6f\xe3\x38\x0c\x3d\x5b\xbf\x82\x6b\x0c\x16\xf1\x20\x55\xf6\x5c\xa0\x97\x69\xbb\xd3\x62\xb7\x1f\xe8\x76\x67\x0f\x83\xc1\x40\xb1\x19\x5b\x88\x2d\xb9\x92\x92\x34\x2b\xf8\xbf\x0f\x28\x7f\xc4\x69\xd3\xb4\x87\x00\xb1\x44\x3e\x92\xef\x91\xb4\x67\x33\x38\xd7\x19\x42\x8e\x0a\x8d\x70\x98\xc1\x7c\x0b\x85\xd8\x2c\x39\x5c\xdc\xc1\xed\xdd\x23\x5c\x5e\x5c\x3f\x72\x36\x9b\xc1\x03\x9a\x95\x52\x52\xe5\xe1\x1e\x36\xb2\x2c\x41\xaf\xd1\x6c\x8c\x74\x08\xae\x90\x16\x16\xb2\xc4\x60\xfb\x0d\x8d\x95\x5a\x9d\x82\xf7\xbc\xfb\xdf\x34\xa3\x0b\xb8\x10\x0e\xc7\xb7\xf4\xdc\x34\x8c\xd5\x22\x5d\x8a\x1c\xc1\xae\x53\x46\xf6\x8f\x3d\x2c\xd4\x46\xaf\x65\x86\x16\x2c\x9a\x35\x9a\x13\x2b\x33\x84\xb9\x54\x99\x54\xb9\x85\x85\x36\xe0\x0a\x84\xfc\xe1\xfe\x1c\x9c\x11\xca\xd6\xda\xb8\x90\xcb\xb5\x83\x95\x93\xa5\xfc\x1f\x6d\x30\x19\x6e\x67\xb9\xa9\x53\xfe\x4f\x80\xe3\x8c\xc9\x8a\x0e\x61\xc2\xa2\x58\xa1\x9b\x15\xce\xd5\x31\x8b\xe2\x54\x2b\x87\xcf\x2e\x66\x2c\x8a\x73\xad\xf3\x12\x79\xae\x4b\xa1\x72\xae\x4d\x1e\x20\x66\x15\x3a\x91\x09\x27\xc8\x86\x0e\x86\x08\x10\xe7\xd2\x15\xab\x39\x4f\x75\x35\xcb\xf5\xc9\x52\xba\x19\xfd\xf6\x53\x20\xb7\xbe\x54\xca\x46\xa6\xc8\xa2\x7a\x0e\xb1\xf7\xfc\xfe\xcb\x75\x48\xeb\x5e\xb8\x02\x4e\x9a\x26\x66\x49\xe0\xe5\x46\x2c\xf1\xeb\xc3\xfd\x79\x9b\x3d\x54\x62\x89\x16\x04\x58\x74\xa0\x17\x80\x2a\xab\xb5\x54\xce\x82\x58\x0b\x59\x8a\x79\x89\x20\xe8\x3e\xd0\xe3\x3d\xef\xc2\xf0\x5b\x51\x61\xd3\xf4\x14\x2c\x56\x2a\x7d\x81\x3c\xd9\x41\x5d\xf6\xff\xa6\xa0\x6b\x27\xb5\xb2\xc0\x39\xdf\xab\xb7\x23\xf3\x2e\x5c\x27\x50\xcf\xf9\x1b\xb1\xc0\xb3\xc8\x8e\x6c\x2d\x9c\x9e\xc1\xf7\x1f\x6f\x83\x79\x16\x45\x87\x6e\xbf\xe0\x42\x1b\x9c\xf4\x0a\x3c\xea\xf3\x56\xae\x64\xca\xa2\xe6\x65\x8c\x33\x10\x75\x8d\x2a\x9b\xec\x1d\x0f\xe5\x70\xce\x13\x16\x19\x74\x2b\xa3\xe0\x77\x8a\xd6\xc6\xf0\x41\x1e\xef\xe1\x51\xff\xad\x37\x68\x60\xaf\x24\x68\x1a\x16\x79\x6f\x84\xca\x11\x3e\x49\x2a\x64\xb8\xbf\x41\x57\xe8\xcc\x92\x45\xe4\x7d\xef\xfe\x49\x76\x5c\x9c\xc2\x7e\x49\xb7\xb8\xe9\x58\x67\x51\x14\x0d\xcc\x73\xef\x07\x97\x5e\x84\x29\x59\x5c\x60\xaa\xb3\x20\xd6\xc8\xe2\x01\x9f\x56\x68\x5b\x83\x4b\x75\xd0\xc0\xd6\x5a\x59\x0c\x16\x7b\x4c\x70\xce\xe9\x90\xb8\xf3\xfe\x84\xba\x88\x32\x6f\x58\x13\x5a\x6e\x47\x08\xc8\xaa\x2e\xb1\x42\xea\x0a\x9a\x28\xef\xbf\xea\x40\xc5\x61\xad\xa5\x72\x68\x16\x22\x45\xe6\xb6\x35\x8e\x71\xac\x33\xab\xd4\x81\x67\x00\x40\xdd\xf2\xaf\x1a\x90\x31\x3b\x8e\xca\xd8\xfb\x9c\x1f\xa0\x1c\xe0\x05\xe7\x57\x42\x65\x25\x1a\xb6\x2b\xb8\xad\xb6\x83\x09\x8b\x65\x94\xb1\xd3\xbb\xe2\x3f\x5e\xf7\xbb\xa9\x86\xc9\x9b\x58\xf8\xbc\x0b\x95\xec\xe0\x87\xec\x27\xa9\x7b\x86\x6e\x21\xf1\xae\xd3\xa7\x60\xf0\x09\x3e\x87\x59\xdb\xd9\x77\x5d\xd0\x34\x09\x4c\x5e\xdf\xb5\x0d\xd0\x34\x53\x40\x63\x34\x85\x62\xd1\x4f\x02\xaa\xc3\x09\x25\x49\x5d\xf7\x8a\xbd\x76\xe8\xa8\x9f\x28\x93\x10\x39\x61\x91\x5c\x04\xa7\xdf\xce\x40\xc9\x92\xa0\xfa\x19\x52\xb2\x0c\x78\x61\x14\xbb\x33\x83\x35\x3f\x92\x51\x32\x25\x2f\xd6\x30\xef\x5b\x35\x48\x8b\x8e\xcf\xb6\xdd\xdf\x27\x73\x36\x83\x63\x93\x01\x92\x36\xe1\x8b\xb7\x40\xeb\xd0\x59\xfc\x49\x6a\xb8\x42\x38\xe2\x7a\x8d\x86\xf6\x68\x98\x80\x76\x7b\xbe\x6e\x2a\xd3\x21\x3b\x0d\x02\x56\x16\xcd\x49\xa6\x2b\x21\xd5\x31\x63\x0e\xf7\x46\x56\xc2\xc8\x72\x4b\x2e\x8b\x55\x09\x52\x85\x15\x3e\x5a\xc6\xc7\xea\x98\xfc\x7c\xdd\x0a\x54\xcb\x03\x3e\xed\x5a\xcf\x53\x03\x8c\x9e\xc6\x8a\x53\xdf\x9c\x9e\xf5\x3e\x87\x54\xe9\x7b\x68\xa4\xde\xd3\x11\x81\xda\x75\xf3\x21\x81\x8e\x6e\xa6\x83\x0a\xb5\x1e\xbd\xc9\x5b\x12\xbd\x4f\x7e\x17\x22\x48\x75\x44\xd0\xba\xdc\x7e\x48\xa1\xa3\x85\x1c\x92\x68\xc8\xe0\x83\x1a\xd9\x9a\x58\xec\xbd\x8e\xce\xce\x48\x26\x5b\x1f\xd2\xe9\x0a\xcb\x1a\x8d\x65\x6d\xea\xaf\x5e\x9a\x87\xd7\x4b\x95\x0d\x96\xfc\xe6\x22\x79\x69\x40\x59\xd2\x92\x5c\x4e\x61\x1d\x32\x0d\xda\x57\x59\x58\x04\x72\x01\xeb\xf1\x62\x68\xbf\x73\x10\x96\xb8\x0d\x22\x67\x19\x7d\x71\x6a\x57\x10\xb3\x7d\x14\xda\xb9\x95\x70\x30\x59\x26\xb0\x29\x64\x5a\x04\xd3\xb2\x84\x92\x54\xea\x50\x84\xca\xc2\xbb\x87\x3e\xd3\xf8\xb9\x50\x5a\xc9\x54\x94\x57\x28\x32\x34\x7f\xe1\x96\xbe\x82\x5c\x17\xc8\xea\xb6\x53\xa4\x83\x54\x28\x98\x63\x0f\x91\xa6\x68\x2d\x66\x14\x1b\xa5\x2b\xd0\x74\x91\xe9\x9e\xa8\x38\x1b\x6a\xfd\x4f\xba\xe2\x9b\x28\x57\xd8\xee\x3d\xaa\xf5\xfb\x1f\x3f\x92\x77\x0d\xdf\xc8\x6e\xb2\x4c\x76\x08\xe1\x15\x7b\x1c\x26\x1e\x66\x21\x9e\x42\x4c\xcd\x16\x27\x6c\x50\x3b\x75\xcf\xac\x61\xbf\x02\x00\x00\xff\xff\x7d\xcc\xbd\x93\xca\x0b\x00\x00")
+var _svcTransport_grpcGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x4d\x6f\xdb\xb8\x16\x5d\x8b\xbf\xe2\x3e\xa3\x78\xb0\x0a\x87\x7e\xeb\x00\x59\xbc\x26\x69\x1a\xcc\xb4\x0d\xd2\x4c\x67\x51\x14\x01\x23\x5d\x4b\x84\x25\x52\x21\x69\x3b\x1e\x41\xff\x7d\x70\x29\x4a\x96\xe3\x8f\x24\xd3\xc5\x2c\x02\xc4\xe4\xfd\x3c\xe7\xf0\x92\x9a\x4e\xe1\x5c\xa7\x08\x19\x2a\x34\xc2\x61\x0a\x0f\x6b\xc8\xc5\x6a\xce\xe1\xe2\x2b\x7c\xf9\x7a\x07\x97\x17\xd7\x77\x9c\x4d\xa7\x70\x8b\x66\xa1\x94\x54\x99\xdf\x87\x95\x2c\x0a\xd0\x4b\x34\x2b\x23\x1d\x82\xcb\xa5\x85\x99\x2c\xd0\xdb\x7e\x47\x63\xa5\x56\xa7\x50\xd7\x3c\xfc\xdf\x34\x83\x0d\xb8\x10\x0e\x87\xbb\xf4\xbb\x69\x18\xab\x44\x32\x17\x19\x82\x5d\x26\x8c\xec\xef\xba\xb0\x50\x19\xbd\x94\x29\x5a\xb0\x68\x96\x68\x4e\xac\x4c\x11\x1e\xa4\x4a\xa5\xca\x2c\xcc\xb4\x01\x97\x23\x64\xb7\x37\xe7\xe0\x8c\x50\xb6\xd2\xc6\xf9\x5a\xae\x1d\x2c\x9c\x2c\xe4\x5f\x68\xbd\x49\xbf\x3b\xcd\x4c\x95\xf0\x6f\x3e\x1c\x67\x4c\x96\xe4\x02\x63\x16\x8d\x14\xba\x69\xee\x5c\x35\x62\xd1\x28\xd1\xca\xe1\x93\x1b\x31\x16\x8d\x32\xad\xb3\x02\x79\xa6\x0b\xa1\x32\xae\x4d\xe6\x43\x4c\x4b\x74\x22\x15\x4e\x90\x0d\x2d\xf4\x19\x60\x94\x49\x97\x2f\x1e\x78\xa2\xcb\x69\xa6\x4f\xe6\xd2\x4d\xe9\x6f\xbb\x84\x11\xab\xeb\x13\x90\x33\xe0\xdf\x9c\x41\x51\x4a\x95\x5d\x2a\xf1\x50\x60\xda\x34\x2c\x1a\x86\x50\x52\xca\x4c\xeb\x29\x11\x30\xad\xe6\xd9\xb4\x94\x69\x5a\xe0\x4a\x18\x6c\x83\xa0\x22\x1f\x16\x75\xc8\x51\x73\x32\x41\x16\x55\x0f\x30\xaa\x6b\x7e\xf3\xe1\xda\x77\x79\x23\x5c\x0e\x27\x4d\x33\x62\x11\xb9\x19\xa1\x32\x04\x7e\xa5\xdb\x5d\x4b\x79\xa3\xba\xe6\xff\x2f\xa4\xb0\x4d\xd3\xba\x0a\x97\xf7\x0e\x6d\x9e\x98\xb1\x8d\xf7\x3b\xbb\x4c\xe0\xf4\x0c\x78\xc8\x49\x41\x08\xfe\xcf\x62\x8e\x75\x4d\xbb\xfc\x4a\xdf\x18\x9c\xc9\xa7\xa6\xb9\xba\xbd\x39\x27\x3b\x34\x50\x8a\x39\x5a\x10\x60\xd1\x81\x9e\x01\xaa\xb4\xd2\x52\x39\x0b\x62\x29\x64\x41\x30\x80\xa0\x7d\xcf\x6c\x08\xf4\x45\x94\xd8\x34\x1d\x73\xb3\x85\x4a\x5e\x4a\x33\xde\xc4\xdd\xb1\xba\xec\xb6\x26\xa0\x2b\x27\xb5\xb2\xc0\x39\xdf\x22\x32\xa8\xe4\xab\xdf\x8e\xa1\x7a\xe0\x7b\x2a\x81\x9a\x45\x76\x60\x67\x09\x8e\x1f\x3f\x0f\x07\xaa\x59\x14\xed\xdb\xfd\x80\x33\x6d\x70\xdc\xc9\xea\x4e\x9f\xb7\x1a\x8c\x27\x2c\x6a\x9e\xe7\x38\x03\x51\x55\xa8\xd2\xf1\xd6\x72\xdf\x0a\xe7\x3c\x66\x91\x41\xb7\x30\x0a\xfe\x4b\xd9\x76\xfa\x6f\x4b\xaa\xbd\x6a\xea\x1a\xee\xf4\xef\x7a\x85\x06\xfa\xfe\x80\xe4\x50\xd7\x81\x66\x49\x5d\xf9\xbd\xcf\xe8\x72\x9d\x76\x62\xf1\x0a\x7e\x27\x37\x1a\x0e\xeb\x7d\x38\x19\xc0\x3a\xdd\x70\x4c\x28\x76\xcb\x1d\x0b\x93\x10\x0d\x0b\x8b\x87\x43\x6c\xc3\xf6\x05\x57\x81\x66\x16\x45\xd1\x8b\xe1\xa3\x0b\x4c\x74\x8a\xa4\x8e\x1d\x30\x06\x2e\xb7\xf8\xb8\x40\xdb\x7a\x5c\xaa\xd7\x79\xd8\x4a\x2b\x8b\xde\x65\x8b\x0f\xce\x39\x2d\xc6\x7d\x77\xfe\x00\x0d\xff\x6d\x58\x7b\x5e\x8e\x50\x04\xb2\xac\x0a\x2c\x51\xb9\x76\x92\xd5\xf5\x95\xa6\xc4\x1b\xaa\x36\x96\xca\xa1\x99\x89\x04\x99\x5b\x57\x78\x34\xa8\x75\x66\x91\x38\xa8\x19\x00\x90\xb2\xff\x50\x7d\x1a\x4c\x0f\xa7\x60\xec\xb8\x24\x0e\x28\x62\x0f\x9b\x00\xb0\x99\x63\xc1\xba\xa3\x8b\x6d\x69\x61\xbf\xf3\xb6\x16\x3e\x09\x95\x16\x68\x86\x73\x6a\xf3\x5f\x18\x49\x6d\x95\xfe\xd2\x38\x86\x8c\xd3\x1b\xc4\x5f\x07\xf6\x51\x48\x02\x22\x4a\xbb\xe7\xa8\xf8\x09\x36\xb6\xf0\xfe\x48\x35\xf1\xa0\x82\xae\xf9\x71\xe2\x9e\x20\x5c\x50\x3c\x0c\x89\x09\x18\x7c\x84\xf7\x5e\xc7\x57\x3a\x68\xf8\x6e\x5d\x61\xd3\xc4\x30\xde\xac\xb7\x4a\x6d\x37\x26\x80\xc6\x68\x13\xd3\x04\xbb\xa7\x00\x95\x5f\xa1\x1e\x2c\xdf\x03\x7a\x3b\xa7\xe8\x34\x50\x05\xe4\xf0\x18\xb3\x48\xce\xbc\xd3\x7f\xce\x40\xc9\x82\x42\x75\x63\x47\xc9\xc2\xc7\x23\x91\x77\x6b\x06\x2b\x7e\xa0\x9a\x78\x42\x01\x18\xf1\x46\xc4\x0f\x30\x0b\xdd\xb4\xd0\xfd\x0a\x6e\x07\x21\x9a\x80\xf5\xc1\xe9\x20\xec\x63\xfc\x7e\x37\x58\x97\xc6\x43\x08\x75\xdf\xe1\x5e\xe4\xc6\x6d\xf8\xc0\x55\xf8\xd5\x51\x37\x8e\x63\x8f\x65\x57\x44\xdc\x63\xf0\x2b\xbd\xfe\xdb\x0d\x79\xf6\xb7\x1a\x7a\x76\x28\xe9\x48\x86\x93\xd4\x0e\xe6\x7f\x7a\x8c\xa6\x53\x78\xd3\x64\x07\x49\x0f\x8b\x7e\x74\xf8\xc7\x1c\x6f\x23\x04\x8b\x8f\x04\xba\xcb\x85\xa3\x53\xb6\x44\x43\xcf\x12\x2a\x37\x3c\x46\x76\xd0\x20\xf6\xe8\xce\x00\xa7\x41\xc0\xc2\xa2\x39\x49\x75\x29\xa4\x3a\x66\xcc\xe1\xc6\xc8\x52\x18\x59\xac\xc9\x65\xb6\x28\x40\x2a\xff\x22\x1a\xbc\x6f\xde\xd4\xd8\xf8\x7e\x77\x2a\x50\x73\xb7\xf8\xb8\x19\x56\x35\xcd\x83\xc1\xaf\xe1\x10\xa0\xf3\x71\x7a\xd6\xf9\xf0\xf1\xde\xb3\xb2\x79\x57\x78\xcd\xf6\x67\xf6\x30\xbd\x97\xea\x17\xe9\x7d\xdb\x35\xbc\x97\xdf\x36\x44\x67\x72\x88\xe0\x97\xa9\x0b\x29\x3c\xd1\x47\xe4\x50\x15\xeb\x57\xf1\xfb\xb6\xce\xf6\x11\xdc\x97\xf4\x4a\x86\x6d\x45\xf3\xbd\xf3\x3a\x38\x8c\x07\x24\xdb\xea\x30\xcb\xdb\x7c\x7f\xc2\xa2\x42\x63\x59\x3b\xb5\x76\x1e\xb2\xfb\xef\xad\x32\xed\x2d\xf9\xe7\x8b\xf8\xb9\x01\xe9\x92\x6e\xec\xf9\x04\x96\xbe\x72\xaf\xa1\x32\xa5\x75\xba\x7b\x96\xc3\x9b\x87\xde\xb2\x77\x39\xc2\x1c\xd7\x5e\x05\x69\x4a\x9f\xb6\xda\xe5\x04\x7d\x97\x85\x1e\x00\xa5\x70\x30\x9e\xc7\xb0\xca\x65\x92\x7b\xd3\xa2\x80\x82\x68\x0c\x51\x84\x4a\xfd\x67\x23\x7d\x0f\xf2\x73\xa1\xb4\x92\x89\x28\x3e\xa1\x48\xd1\xfc\x86\x6b\xfa\x66\x71\x21\x91\xd5\xad\x94\xa4\x83\x44\x28\x78\xc0\x2e\x44\x92\xa0\xb5\x98\x52\x6e\x94\x2e\x47\x13\x32\xd3\x3e\x41\x71\xd6\xf7\xfa\xa7\x74\xf9\x77\x51\x2c\x90\x20\x9a\xf8\x5e\x7f\xfc\xef\x67\xfc\xa2\xe1\x81\xea\xc6\xf3\x78\x13\xa1\xf1\xf7\xef\xd1\x30\xa3\xfe\xb0\x8c\x26\x30\x22\x35\x8e\x62\xd6\x2b\x20\x71\x4f\xe1\x05\xb5\x35\xf4\x41\xa4\x69\xfb\x20\xed\x81\x0d\xa0\x84\xab\x87\xde\x51\xce\x76\x39\x27\x50\xc8\x39\xee\x8a\x02\x52\x8d\xed\x87\xfc\x42\x09\xb3\x86\x44\x14\x85\x6d\x05\xb4\x7d\xc7\xec\x11\xcf\x5e\xb1\x94\xe9\x04\xee\x49\x29\x5d\x2a\xfe\xd1\xe8\xf2\x5a\x25\x9a\x3e\x4f\x82\x21\xe1\xbc\x19\x64\x3b\x45\xd1\xf6\x04\xca\x34\x66\x0d\xfb\x7b\x00\xe0\xd8\x3f\x7e\x2c\x11\x00\x00")
 
 func svcTransport_grpcGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -295,12 +337,12 @@ func svcTransport_grpcGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "svc/transport_grpc.go.tpl", size: 3018, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x70, 0x81, 0xa4, 0x8d, 0xee, 0xa9, 0x66, 0xb3, 0xef, 0xfd, 0x8d, 0xe5, 0x5c, 0xf0, 0x4e, 0x4a, 0xed, 0x3e, 0xbe, 0x58, 0xd9, 0xb4, 0xcd, 0xc3, 0x51, 0x93, 0x4, 0xcd, 0x94, 0x32, 0xae, 0x18}}
+	info := bindataFileInfo{name: "svc/transport_grpc.go.tpl", size: 4396, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8d, 0x90, 0x7, 0xb3, 0xe4, 0xb9, 0xc8, 0xd0, 0x29, 0xc8, 0x6, 0x49, 0xbb, 0x54, 0xd0, 0xb9, 0x70, 0x5b, 0x7d, 0xac, 0xca, 0x93, 0xde, 0xba, 0xc9, 0x6b, 0x47, 0xb0, 0x15, 0x65, 0xdb, 0x2b}}
 	return a, nil
 }
 
-var _svcTransport_httpGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xcb\x31\x0a\xc2\x50\x0c\x06\xe0\xbd\xa7\xc8\xdc\xe1\xe5\x1a\x1d\x85\xbe\x0b\x84\xfa\xdb\x82\xd1\x3c\xd2\xa0\x43\xc8\xdd\x05\xe9\xfe\x7d\x99\x3c\xd3\x0a\xd0\x21\xdf\x27\xef\x78\xc3\x25\xcc\xf9\x88\x18\x1c\x78\x0d\x95\xc0\xc9\x27\xfc\x03\x6f\xbb\xd1\xc3\x9c\x36\xbb\x83\x66\xae\x9a\x32\x37\x51\xa5\xb6\xf4\x7e\x5b\xa0\x03\xde\xd6\x3f\xed\x57\xa5\x56\x35\xfd\x02\x00\x00\xff\xff\xed\x08\x4c\xd3\x65\x00\x00\x00")
+var _svcTransport_httpGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x65\x00\x9a\xff\x7b\x7b\x2f\x2a\x20\x53\x65\x65\x20\x68\x61\x77\x6b\x2f\x67\x65\x6e\x65\x72\x61\x74\x6f\x72\x2f\x68\x74\x74\x70\x2f\x74\x65\x6d\x70\x6c\x61\x74\x65\x73\x2f\x73\x65\x72\x76\x65\x72\x2e\x67\x6f\x20\x66\x6f\x72\x20\x63\x6f\x64\x65\x20\x2a\x2f\x7d\x7d\x0a\x7b\x7b\x63\x61\x6c\x6c\x20\x2e\x48\x54\x54\x50\x48\x65\x6c\x70\x65\x72\x2e\x53\x65\x72\x76\x65\x72\x54\x65\x6d\x70\x6c\x61\x74\x65\x20\x2e\x7d\x7d\x0a\x03\x00\xed\x08\x4c\xd3\x65\x00\x00\x00")
 
 func svcTransport_httpGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -320,7 +362,7 @@ func svcTransport_httpGoTpl() (*asset, error) {
 	return a, nil
 }
 
-var _svcTransport_wsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x5d\x73\xdb\xb8\xce\xbe\x96\x7e\x05\xd6\xd3\xe9\x48\x19\x47\xee\xee\xbc\x37\xaf\x5b\x5f\x34\x4e\x4e\x9b\xb3\x9b\x8f\x89\xd3\xcd\x45\xa7\xd3\x65\x24\x58\xe1\x89\x4c\xea\x50\x54\x9c\x1c\x8f\xfe\xfb\x19\x80\xd4\x87\x1d\xa7\xed\xee\x9e\xcc\xb4\x89\x44\x00\x04\x1e\x3c\x00\x41\x4d\x26\x30\xd7\x19\x42\x8e\x0a\x8d\xb0\x98\xc1\xed\x13\xdc\x89\xf5\x7d\x02\xc7\x17\x70\x7e\x71\x0d\x27\xc7\xa7\xd7\x49\x38\x99\xc0\x15\x9a\x5a\x29\xa9\x72\x5e\x87\xb5\x2c\x0a\xd0\x0f\x68\xd6\x46\x5a\x04\x7b\x27\x2b\x58\xca\x02\x59\xf6\x77\x34\x95\xd4\x6a\x0a\x9b\x4d\xe2\xff\x6e\x9a\xc1\x02\x1c\x0b\x8b\xc3\x55\x7a\x6e\x9a\x30\x2c\x45\x7a\x2f\x72\x84\xea\x21\x0d\x43\xb9\x2a\xb5\xb1\x10\x85\xc1\x28\xd5\xca\xe2\xa3\x1d\x85\xc1\x08\x55\xaa\x33\xa9\xf2\xc9\xbf\x2a\xad\xe8\x45\x2e\xed\x5d\x7d\x9b\xa4\x7a\x35\xc9\xf5\xe1\xbd\xb4\x13\xfa\x87\x2a\x2b\xb5\x54\xa4\x62\x8d\x50\x15\x9b\x7a\x41\xb6\x13\x98\xdc\x59\x5b\x3e\xb3\xa9\xf3\x02\x27\x75\x2d\xb3\x67\x2b\x46\x16\x85\x98\xac\xf1\xb6\xd2\xe9\x3d\xda\x9d\xf5\x4a\x9a\xba\xac\x50\x4d\x0a\x9d\x9b\xba\xa2\x55\x85\xfd\x1e\xd5\x93\x4a\xe9\xb7\x95\x2b\x1c\x85\xc1\x64\x02\xd7\x04\x62\x85\xe6\x41\xa6\x18\x06\xe5\x2d\x8c\x36\x9b\xe4\xf2\xe8\x94\x71\xb8\x14\xf6\x0e\x0e\x9b\x66\x14\xc6\x61\x98\x6a\x55\x31\x32\xa5\x56\xf9\x8d\x90\x36\x00\x80\x19\xfc\xf2\x06\x0e\x80\xec\x25\x0b\x4c\xb5\xca\xc2\xa0\x94\x2a\xbf\x44\x23\x75\x16\xc0\x0c\xa2\x56\x1c\x0e\xe0\xff\x63\x98\xc0\xcf\x6f\xc2\x60\xb3\x39\x04\xb9\x84\xdc\x42\xf2\xf1\xfa\xfa\xf2\x23\x16\x25\x9a\x64\xe1\xdc\x48\x6e\x16\x67\xe2\x71\x21\xff\x83\xf0\x06\x9a\x26\x0c\x82\x95\x78\x3c\xc3\xaa\x12\x39\xf2\xdb\x19\x65\xf1\x5b\x7a\xa4\x44\x5b\xa0\xca\xc8\x40\x1c\x86\xf6\xa9\x44\xb8\xc1\xdb\x05\x83\x36\xd7\x6a\x29\x73\xa8\xac\xa9\x53\x0b\x9b\x30\xf8\x50\x0b\x93\x41\xfb\xb3\xac\x55\x1a\xa5\xf6\x11\x3c\x05\x92\xb9\xfb\x3d\x06\x03\x07\x04\x65\x72\x85\xff\xae\xb1\xb2\x31\x44\xcf\x44\xd0\x18\x6d\xe2\x30\xb8\x30\x32\x97\x6a\x7e\x87\xe9\x3d\x1a\x67\xf2\x99\xf6\xad\xd6\x45\xd8\x78\xef\x7c\x84\x03\xaf\xce\xd0\xde\x69\x76\xab\xb2\x86\xaa\xa0\xfb\xf9\x83\x88\x38\x1d\xad\x58\x60\xf4\x47\x18\x1c\x0b\x2b\x02\x00\x7a\x9d\x5c\x89\x75\x6b\xcb\xcb\x65\xc2\x8a\xb1\x5e\x49\x8b\xab\xd2\x3e\x91\xfc\x5c\xaf\x56\x42\x65\x2f\x9b\x4e\x9d\xc0\xb6\x96\x77\xfc\xf4\xf8\x25\x2d\xe3\x04\xbe\xca\x1d\xc5\x85\x15\xb6\xae\x48\x50\x2a\x0b\xc3\x1f\xaf\x58\xb1\xc0\x96\x52\x8b\xcb\xa5\xd6\xc5\x00\x94\x42\xe7\x44\xbc\x03\x47\xf0\xe4\x44\x59\xf3\x14\x06\x75\x99\x1b\x91\x21\x00\x74\x95\x91\x7c\x72\xef\x4c\x18\xe4\x5d\x7a\xff\x27\xa9\x6d\xeb\xbc\x82\x95\x28\x3f\x3b\x28\xbe\xb4\x2f\x93\x13\xff\x47\x18\x64\x98\xea\x0c\x4d\x05\x43\x39\xf6\x60\x27\x4f\x31\x44\x52\x59\x34\x4b\x91\xe2\xa6\xe9\x37\x4a\x0b\x89\xb4\x8d\x33\x70\x30\xe7\xc7\x2f\xcc\x9a\x80\x4a\x39\xb9\xba\x39\xab\x2d\x3e\x76\x58\x39\x89\x01\x5a\xb2\x67\xb5\xdb\x9f\x01\x6c\x5f\xed\x80\x98\x6a\xa5\x30\xb5\xd4\x2c\x0f\x7a\x18\xe7\x5a\x29\x2a\x7a\x5d\xb4\x5a\x97\xec\x00\xa1\xe8\x7f\x76\x90\x0a\xc3\x40\xd7\x16\xd2\x3b\xa1\x5a\x56\x93\x87\x14\x39\x9c\xe3\x9a\xd4\x23\xf2\x62\x6b\xfb\x31\xf4\xb0\xb6\x10\x56\x63\x58\x57\xf3\x65\xbe\x5b\xb9\xb1\xf3\x81\x02\x2c\x61\x3a\x83\xd7\xf4\xb4\x09\x03\x8a\x6d\x1a\x40\xa1\xf3\x71\x18\xb4\xe8\x4d\x61\x25\xee\x31\xda\x45\x30\x26\x11\x4f\x9b\xe9\x1e\xd6\x90\xb9\x80\xcb\xd7\x55\xf2\x94\x23\x65\x77\x92\xad\xda\x26\x3b\xc1\x15\x8a\xec\xa8\x5e\x2e\xd1\x50\xff\x99\x02\xfc\xfc\xe6\x97\xff\xe3\x95\x1b\x3a\xab\x86\x4b\xed\x4a\x43\xff\x31\x33\x87\xa6\xb9\x13\xd1\x4a\x87\xc6\xc0\xff\x97\xa8\xc6\xb1\xb4\x6c\x9b\xc2\x33\x85\x1f\xe7\x1c\x59\x6a\x42\xea\x9e\x46\xa8\x1c\xe1\x95\x24\x7c\xbb\x0e\xeb\x3a\x52\xc5\x4d\x79\xb3\xa1\x16\x4e\x4d\x24\x52\xda\xc2\x2b\xd9\x96\xce\xc2\x1a\x14\xab\x78\xf0\xba\x2a\xb5\xaa\xb0\x7d\xcf\xda\x41\x99\x74\x11\x7e\x1e\x6d\x36\xaf\x64\x72\x2e\x56\xd8\x34\xa3\x2f\x30\xeb\xa9\x90\x0c\x56\xfa\xc2\x22\xed\x36\xdc\xe7\xca\xdd\xda\x60\xc1\xf9\xeb\xcf\x83\xf6\x6c\xa0\xd3\x3f\x30\x68\x6b\xa3\xa0\xec\x18\x1a\x95\x8e\x5d\x31\xbc\xcf\x32\x47\x98\xfd\x1d\xe3\xe5\x72\x89\xc1\x33\xcd\x97\xe0\x74\x06\x74\x96\x27\xe7\xb8\x5e\x70\x46\x22\x2a\x6e\x66\xae\x93\x23\xb2\xc9\x6c\x1a\x04\x20\x39\xf9\xbd\xe9\xe9\x60\x1b\x5a\xa1\x3a\x9c\x52\x03\x2c\xc7\x9e\xef\x50\x26\x85\xce\x93\x1b\x69\xef\xfe\x21\xb1\xc8\xaa\xc8\x97\x95\x7b\x62\x1e\x8f\xba\x71\x63\x34\x85\xd1\xcd\xc9\xd1\xe2\x62\xfe\xeb\xc9\xf5\x88\x19\x3a\x72\x85\x32\x9a\x06\x6e\xf3\x86\xe9\x94\xda\xc7\x69\x17\x33\x19\xff\x5d\x14\x35\x12\x12\x63\x18\x98\x1b\x0f\xcd\xb1\xa2\xae\xad\xa7\xec\xb0\x01\x38\x62\x05\x29\xfb\x7a\xaa\x96\x3a\x1a\x7d\xbe\x59\x7c\x01\xb7\x77\x1b\x24\x66\xa3\x38\x0c\xca\xc4\x97\xee\xe7\x94\xf2\x69\x4d\x8d\x7d\xa2\xd2\x3d\x89\x32\xb8\xd2\x0f\xd8\xe6\xca\x59\xf4\x19\x88\xb9\x49\x24\xbf\xe9\xf4\x9e\x40\xcf\x70\x89\x06\xca\xe4\x93\x2a\xfc\x1b\xb9\x84\xaf\x63\xd0\xf7\x94\x8d\xc1\xc6\xae\x51\xbc\xa5\x85\x0d\xf7\x12\x5d\xa1\x37\x9d\xe8\xda\xc6\x61\x10\x7c\x85\x99\x77\x3f\xe9\x73\x94\xcc\x59\x32\xe6\x82\x2c\xd0\x62\xd4\x19\x1d\x7b\xe9\x98\x90\xe8\x82\x48\x7b\x4f\x0d\x8a\xcc\xc3\x55\x45\xec\xb8\x73\x97\xcb\x37\x76\x7e\x24\x44\x80\x64\x3b\x60\x32\x18\xc5\x5c\xb5\x7f\x66\xaa\x4a\x87\x6e\x2f\xd0\x52\x0f\xfb\x4d\xae\xa4\x8d\xb6\xe7\xad\x38\x1c\xd6\x8e\x5c\x52\xab\x20\xb4\xf6\xe9\x1f\xa3\xc8\x0a\xa9\x30\xe2\x89\xf0\x5c\xaf\xa3\x38\x79\x9f\x65\xdd\x10\x18\xc7\x6f\x59\xfd\xa7\x19\x28\xc9\x0d\xdc\xe7\xd5\xb5\x9d\x5d\x93\x97\x5a\xe5\x1f\x85\xca\x0a\x34\x11\xa3\xe0\x1a\x5a\xec\xba\xd5\x40\xfd\x2f\x3a\x13\x06\x0d\xe1\xb6\xf4\xb6\xbe\x8e\xa1\x14\x4f\x85\x16\xd9\x78\x6f\x90\x57\x7d\x82\x18\xef\x16\x8c\x41\x34\xf4\xaa\x6f\x06\xa7\xd5\x27\x85\x8f\x25\x33\x9b\x89\x71\x42\x7e\x47\x68\xcc\x78\x20\xc5\x2b\x1f\xb4\x54\xf9\xfb\xb5\x78\x7a\xb6\xf2\xfe\x56\x69\xb3\x12\x05\x3d\xd4\x06\x1d\x11\x02\x5f\x4a\x54\x99\x9d\xd1\x78\x58\x59\x75\xb7\x33\x30\x7b\xa9\xb2\x82\xa0\x01\x2c\x2a\xfc\x41\x13\x43\x3d\xfa\xef\xd6\xa0\xb8\xa7\x0e\x41\xb1\x3f\x08\x03\xab\x2a\xef\x4e\xf8\x0e\x8d\x99\x1b\x43\x3f\xa9\x95\x30\xd5\x9d\x28\xa2\x0e\xd3\xd7\xab\x2a\x7f\x4e\x80\xef\xba\x21\xd5\x83\x28\x64\x06\x2b\x3f\xd5\x1a\x4c\x51\x3e\xb8\x5e\xc1\xbd\xd2\x4a\x55\x63\xeb\x17\x4d\x16\x9c\xb8\xad\xae\x18\xb5\xc3\xf2\x98\x9c\xf6\x07\x59\xec\x9d\x6e\xeb\xdf\x57\x57\x7f\x30\xf5\xa2\x7d\x2b\x08\x78\x9a\xee\xf9\xc1\x2a\xdd\x69\x34\xd0\x88\xe8\x6f\x1a\xcc\xd9\x4d\x82\x6a\xd6\x82\xe5\xe0\x77\x72\x7c\xfa\xf7\x6a\xdc\x92\xfb\x49\x7b\xca\x4b\xdd\xa3\x5b\x75\xe3\x34\x2b\xf2\xcc\xea\x9e\x2f\x7e\x1d\x77\x99\xda\x43\xcc\xe0\xdb\x20\xbb\x8a\xe2\x38\x68\xb2\xf7\x58\x3b\x88\xc9\xf9\x84\x2f\xc1\x3d\x81\xfb\x05\x3f\xdc\xcf\x86\xbe\x1c\x89\xcc\xfb\xec\x89\x46\x03\xe1\xbb\x43\x0a\xc6\xbd\xe8\x93\xe6\x1c\x36\x7e\x48\xe8\x80\xc5\x28\x4d\xf8\xb0\xc9\x5a\x04\xff\x6a\x4c\xf8\x88\x69\x6d\x29\xa8\xee\x82\xfe\xed\xa8\xb6\x6a\xa4\x4d\xe2\x78\xc8\xed\x33\xcf\xec\xd6\x6b\x67\x6f\x9f\x83\xfb\x3c\x74\x7f\x0e\x5d\xf4\x95\x52\x90\x93\xad\x4d\xef\xe4\xcb\xd8\x37\x1d\x78\x3b\xf0\x36\x2f\x1d\x30\xfc\xdd\x64\xfb\x84\xb1\x92\xef\xa7\xd3\x99\xbb\xc3\x9f\xe3\xfa\x9a\xdf\x44\xfd\x2d\x3e\xde\x73\x0e\x39\xb5\x64\x61\x75\xc9\x67\xdd\xb7\xcf\xa5\xb6\xbf\x56\x58\xa0\xbb\x97\x04\xa9\xa8\xb0\x25\x59\x5b\x7e\xef\x0e\x39\x90\xa9\x4f\xf6\x4f\x6d\xc1\xbd\x74\xe6\xdc\x0c\xc2\x89\x76\x9a\xe6\x59\x6b\x5a\xc9\x62\x4f\xcb\xf9\x7e\xd3\x71\x89\xa1\x0e\x48\x49\xe9\x77\xf5\x69\xd9\xe2\xc8\x9e\xb1\xa6\x9f\x0d\xb9\x89\x66\xa3\x41\xce\xba\x23\xcf\x3f\x6f\x35\x94\x2d\x7e\x79\x80\x5e\xa4\xff\xde\x20\xbe\x4d\xaf\xed\xc2\xde\xae\xc3\xc1\x36\x3f\x06\xf5\x35\x3e\xda\x0e\x69\x2e\xd4\x3d\x50\xff\x18\xd2\xc4\xcd\x67\xfe\x35\x2d\x53\xde\x1d\x7a\xc6\xcd\xa7\xe1\x9f\x26\xc4\xa5\x54\x79\xe7\xe5\xe7\x2f\xb7\x4f\x16\x37\xcd\xdf\xf7\x94\x2a\xc4\xc3\xb8\x9d\xd0\xed\xfa\xeb\xa6\x54\x1a\xc4\x90\x06\xb3\x68\x0d\xfe\x53\x83\xab\x74\x76\xdb\xec\xf9\x04\xb1\x09\xf9\x76\x6d\x1f\x39\x52\x3f\x8b\x1f\x89\xf4\x3e\x37\xba\x56\x99\x1f\x5f\xcb\xc4\x7d\xdf\x18\x84\x42\x67\x33\x45\xc7\x0e\xbb\x61\xbe\xed\x5f\x5e\xda\x0d\xf2\x26\xde\x3f\xc4\xb0\xde\x09\x37\x1d\x13\x29\x59\xb0\xf2\x18\xd6\x71\x38\x0c\xb6\xf1\x43\x9b\x56\xaa\xa3\x6f\x99\xf8\x3b\x75\x7b\x91\x8e\xd6\x63\x30\xae\x0c\xc3\x3d\x5b\x6d\x4d\x7f\x6e\x72\x67\x2b\x5b\x77\x2f\x77\xd7\x8a\xc3\x20\xd7\xed\xc4\xbd\x3d\x2b\x0f\x57\x76\x9a\x5c\x9f\x88\x15\x1c\x74\x77\xdf\x9d\x86\xea\x6f\xbf\x3c\x72\x73\x31\x7e\x7d\x56\x8a\x2c\x71\x63\x44\x59\xa2\xd9\xb0\xe2\x94\xb4\x7c\xb5\xc5\x8d\x8b\xce\x57\x1a\x65\xd3\xf5\x34\x12\x89\x86\x86\xd0\xc4\xdd\x28\x21\x97\xbc\xc7\x91\xce\x9e\xc6\xad\xea\x89\xc3\xb1\x33\xd4\xea\xfd\x73\x71\x71\x1e\xc5\x6f\x87\x62\xb3\x41\xc6\xc8\x6d\x7f\x3a\x91\xb9\x36\x3b\x01\x85\x49\xf6\x06\x67\xf3\x29\x5d\xfb\x95\x28\x98\x8f\xe6\xc4\x51\x44\x2e\xa1\x4a\xb7\x9c\xee\xee\x78\x5e\x6d\x4e\x64\xe8\x7d\x67\xc3\x33\xa8\xd2\xc1\x72\xc4\x57\x9b\x60\xc5\x07\x26\xcc\xb8\x23\xd0\x63\x37\x21\x90\x12\x25\xe4\xfb\x9f\x17\xfe\xde\xd7\x85\x9d\xca\x7b\xfe\x3d\x20\x62\xbc\x7e\xe8\x93\x88\xc3\x97\x93\xf2\xba\xbc\x4d\x36\x9b\x0f\x9a\x6c\x0c\x5c\x6a\x9a\x4d\xd3\x17\x06\xb1\x7d\x67\x00\x76\x9c\x7a\xcd\xd5\xd6\x0c\x6f\x53\x9b\x8d\xfb\x0c\xf1\xdf\x00\x00\x00\xff\xff\x22\x86\x06\xe6\x14\x19\x00\x00")
+var _svcTransport_wsGoTpl = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x7d\xfb\x93\xdb\x36\x92\xf0\xcf\xe4\x5f\xd1\x51\xdd\xa6\x48\x1f\xcd\x49\xf6\xdb\x4a\xd5\x69\x33\x57\x15\x3f\x92\xf5\xed\xfa\xf1\x65\x9c\xf3\x0f\x53\x2e\x9b\x22\x21\x0d\x6e\x28\x92\x21\x28\xcb\x73\x2a\xfd\xef\x5f\x75\xa3\xf1\x22\x29\xcd\xd8\xb9\xef\x6a\xb3\xb5\x1e\x89\x00\x1a\x8d\x46\xbf\xd1\xa0\x2e\x2e\xe0\x69\x5b\x09\xd8\x88\x46\xf4\xc5\x20\x2a\x58\xdd\xc1\x4d\xb1\xbf\xcd\xe1\xd9\x6b\x78\xf5\xfa\x2d\x3c\x7f\xf6\xe2\x6d\x1e\x5f\x5c\xc0\xaf\xa2\xdf\x35\x8d\x6c\x36\xd4\x0e\x7b\x59\xd7\xd0\x7e\x12\xfd\xbe\x97\x83\x80\xe1\x46\x2a\x58\xcb\x5a\x50\xdf\xff\x14\xbd\x92\x6d\xb3\x84\xc3\x21\xe7\xcf\xc7\xa3\xd7\x00\xcf\x8a\x41\xf8\xad\xf8\xfd\x78\x8c\xe3\xae\x28\x6f\x8b\x8d\x00\xf5\xa9\x8c\x63\xb9\xed\xda\x7e\x80\x24\x8e\x16\x65\xdb\x0c\xe2\xf3\xb0\x88\xa3\x85\x68\xca\xb6\x92\xcd\xe6\xe2\xbf\x54\xdb\xe0\x83\x8d\x1c\x6e\x76\xab\xbc\x6c\xb7\x17\x9b\xf6\xf1\xad\x1c\x2e\xf0\xff\xa2\xa9\xba\x56\x36\xc3\xa8\x47\x23\xa5\xdc\xb4\xed\x05\xae\xe1\xa2\xbb\xdd\x5c\xec\xd5\x22\x3e\x1c\x1e\x83\x5c\x43\x7e\x35\xf4\xa2\xd8\xca\x66\xf3\xbc\x29\x56\xb5\xa8\x8e\xc7\x7b\x06\x6f\x65\x55\xd5\x62\x5f\xf4\x82\xa6\x69\xdb\x4d\x2d\xf2\x4d\x5b\x17\xcd\x26\x6f\xfb\xcd\xc5\xa6\xef\xca\x8b\xad\x18\x8a\xaa\x18\x0a\xec\x22\x5b\x3d\x9b\x68\x08\xf8\xd0\x17\x8d\xa2\x45\x9e\x58\x85\xed\x70\x71\x33\x0c\xdd\x64\xb5\x38\xdf\xc5\x6e\x27\xab\x49\x4b\x2f\xeb\xba\xb8\xd8\x8b\x95\x6a\xcb\x5b\x31\xa6\x82\x92\xfd\xae\x53\xa2\xb9\xa8\xdb\x4d\xbf\x53\xf3\xc8\x77\x7d\x3b\xb4\xab\xdd\x5a\x7f\x18\x41\x40\xd2\x89\xbe\x6f\x7b\x1a\xdc\x08\x87\xa0\xba\x6b\x4a\xf3\xf7\xa2\x18\xda\xad\xa4\xaf\x83\xdc\x22\x91\x2e\x2e\xe0\x2d\xb2\x8a\x12\xfd\x27\x59\x8a\x38\xea\x56\xb0\x38\x1c\xf2\x37\x4f\x5e\xd0\x6e\xbf\x29\x86\x1b\x78\x7c\x3c\x2e\xe2\x08\xe9\xd4\x17\xcd\x46\x40\xfe\x4b\xab\x5b\x15\x12\x2d\x3a\x1c\xf2\x9f\x6a\x59\xa8\xe3\x51\x0f\x2d\x86\x1b\x3b\x40\x13\x36\x8d\xe3\xb2\x6d\x14\xf1\x4e\xd7\x36\x9b\x77\x85\x1c\x22\x00\xb8\x84\x3f\x7f\x07\x8f\x00\x71\xc9\xaf\x44\xd9\x36\x55\x1c\x75\xb2\xd9\xbc\x11\xbd\x6c\xab\x08\x2e\x21\x31\xdd\xe1\x11\xfc\x5b\x0a\x17\xf0\xfd\x77\x71\x1c\x55\x62\x5d\xec\xea\xe1\x5d\xdb\xdf\x8a\x5e\x11\xa0\xef\x7f\xb0\x8f\xff\xef\x4e\xec\xc4\x95\xfc\x6f\x01\x97\xf0\xc3\x5f\xec\xe3\x27\x45\x79\x5b\xb7\x1b\xea\x8d\x8f\x2f\x2e\x40\x11\x83\x3d\xd9\xad\xd7\xa2\x07\xa9\x60\xb8\x11\xd0\xec\xb6\x2b\xd1\x43\xbb\x86\x5e\xfc\xbe\x13\x6a\x50\xf8\xb9\x80\xb2\x96\xa2\x19\x78\x08\xf4\xa2\x14\xf2\x13\xca\xe7\x6e\x80\xa6\x1d\xa0\x17\x05\x09\x2b\x82\xd8\x8a\xe1\xa6\xad\xe0\x4e\x0c\x71\x14\x4c\x71\x09\xdf\xff\x10\xa7\x31\x4a\xde\xd3\x76\xbb\x2d\x9a\x8a\x81\xbf\x14\x4a\x15\x1b\xe1\xa8\xe4\x7a\x3c\x6f\x2a\x10\xd8\xb1\xe0\xb9\x97\xa0\x10\x11\x9e\x4b\xa3\x95\x41\xd3\xc2\x7a\xd7\x0f\x37\xa2\x87\xad\x06\x86\x0b\xa2\x9e\x43\x4b\x3d\xf5\xe8\x2c\x18\x8d\xdb\x2e\xfa\x0c\x3f\xd3\x9c\x8c\x79\x2f\x86\x5d\xdf\x88\x0a\xf6\x72\xb8\xe1\xc1\xc5\xb0\x23\x64\xf1\x1b\xcf\x10\x47\x1e\x8e\x97\xb0\x10\x4d\xb5\xf0\x51\x7f\x5a\x34\xa5\xa8\x2d\x1e\x01\xc6\x30\xb4\x50\xea\x76\x84\xc8\x0a\x05\x27\x28\x0c\xe1\xa1\xed\xed\xa2\xe3\x28\x84\x79\x09\x0b\x3d\x3a\x98\xf0\x6a\xb7\x52\x65\x2f\x57\xe2\xf4\x9c\xbc\x71\xf4\x54\x7c\x12\xcd\x60\x17\x35\xb4\x9d\x2c\xe3\x68\x02\xea\x12\x16\xca\x7c\x09\x66\xfb\xad\x51\xf7\xcf\xa7\x86\xb6\x63\x6e\x41\x5d\x7d\xdf\xb4\x3e\xcc\x4b\x58\xec\x9a\xf9\xa9\x9f\x23\xe6\xe3\x49\xf5\x66\x42\xb7\x53\x37\x38\x55\xd1\xe8\x99\x32\x9f\x27\x0d\x93\x17\x5b\x61\x10\xa0\x4e\x16\x01\x0d\x19\x37\x13\x3f\x2c\x98\x5b\xaf\xea\x76\xff\xb4\x6d\xd4\x6e\x2b\xfa\x37\x6d\x2d\xcb\x3b\xa8\xc4\x5a\x36\x42\xc1\x4d\xbb\xc7\xbd\xbc\x29\x9a\xaa\x16\x4e\x4e\x8c\x4c\x98\x45\x33\xcb\x28\x28\x14\xac\x0b\x35\xe0\xdf\xe1\x46\xdc\x41\xd1\x23\xe6\xcd\x10\x0f\x77\x9d\x98\x9b\x48\x0d\xbd\x6c\x36\x9e\x06\x19\xe1\xf3\xa4\x6e\xcb\x5b\xd8\x17\x72\x50\xb0\x6b\x06\xa9\x19\xea\x77\x54\x02\x66\x89\x8c\xd3\x4d\xa1\x40\x75\x45\x29\xa0\xd8\x14\xb2\x89\xa3\x29\x98\x99\xf9\x2f\x61\xb1\xc2\x29\x16\x93\x99\x9f\xf5\x6d\x07\x55\xdf\x76\x2a\x5c\x22\xae\x7d\x2d\x87\x01\xd7\x2e\x1b\x16\x3f\x42\x28\x9c\x92\xc6\xcf\xcf\x88\x50\xa7\x13\x3e\xad\x5b\x85\xab\x69\x95\x50\x46\x6c\x1a\x51\x0e\x68\xc2\xe5\xda\x5b\x37\x1a\xff\x5d\x5d\x87\xd3\xe9\xd1\xf3\xf3\x11\x4c\xda\xed\x4f\x45\x8f\x0a\x48\xf4\x3d\x2b\x25\xc4\xb2\x13\x15\x5c\x82\x36\x30\xf9\x2b\xb1\x4f\x16\xbc\x58\x5a\x7e\x27\xaa\xec\x24\xd1\x19\x95\x45\x4a\x30\x9f\xd2\x43\xc2\xa4\x02\x18\xc1\xf4\x56\x43\xf8\x54\x8b\x14\x31\x22\xc6\x78\x27\x56\x57\x64\x3a\x9f\xb6\xcd\x5a\x6e\x50\x2d\xec\xca\x01\x0e\x71\xf4\xcb\xae\xe8\x11\x96\xfe\xdf\x7a\xd7\x94\x49\x39\x7c\x36\x1a\x25\x7f\xaa\xff\x66\xd0\xc3\x23\xb4\x89\xf9\xaf\x5a\xbb\xa4\x90\x4c\xba\xd0\x02\xd3\x38\x7a\xdd\xcb\x8d\x6c\x9e\xde\x88\xf2\x56\xf4\x1a\xe4\x64\xf4\xaa\x6d\x6b\xda\x20\x63\x85\x6a\xb9\x45\x16\x44\x3a\x58\xcb\x21\x3e\x8b\x72\x87\x6e\x5c\xd9\x36\xe5\xae\xef\x45\x33\xd4\x77\xd0\x89\xde\xdf\xb9\x84\x0d\x14\x7c\xff\x43\x9a\x83\x76\x7a\x14\xc9\x05\x32\x52\xd9\xee\x9a\x41\x54\x79\x1c\x99\x89\x64\x33\xd0\xc4\xc6\xa0\x9d\x36\x5c\xa3\x89\x50\x46\x90\x27\xd7\xa4\x58\xf7\x04\xce\xcd\xfe\xc3\x5f\xd2\xcc\xda\x10\x0b\xa2\xe8\x05\x4d\xd6\x8b\xff\x12\xe5\x30\x63\x12\xfe\xf2\xe7\x7f\xcb\xe1\x57\x4f\xd2\xbd\x09\x1b\xf1\x49\xf4\x2c\x9a\xfe\xa4\x79\x1c\x59\xe4\x79\x31\xce\x68\x4f\x96\x63\xe5\x8a\xd8\xab\x1a\xaf\x6a\x68\x61\xa5\x55\x48\xb0\x96\x38\xf2\x40\xf2\x24\x3e\xef\x9b\x79\x3a\xad\xce\x8a\xae\xab\xa5\xa8\x66\xc5\xc8\xc1\xf5\x01\x90\xc2\x48\x43\x11\x9b\x91\xae\xf8\x48\x1a\x94\x85\xc9\xea\x6c\xd9\xc0\xaa\x1d\x6e\xa0\x92\xbd\x26\x96\xca\xd0\x0b\x2f\xb0\x03\xb9\xd4\xc2\xba\x13\xf8\xa5\xb4\x52\x65\x17\xae\x15\xa6\x81\xeb\xe4\xe1\xa5\xb6\xe1\x00\x28\x23\xb8\xdd\xf6\xbf\x8f\xe8\xa2\x2f\x17\xda\x14\x2c\x3e\xc6\x11\x4e\x18\x01\xe0\xe3\xfc\xd7\x62\x6f\x60\x71\x3f\x74\x92\xb3\x76\x2b\x07\xb1\xed\x86\xbb\xc5\x47\x6b\x23\x4e\x83\x2e\xb5\x15\x0b\x47\xb1\xc8\xbc\x78\x76\x6a\x14\x33\xdb\x07\x39\x1a\x78\xa5\x39\x0c\x00\x64\x33\x98\x31\xfe\x40\x45\x1d\xc2\x41\x6f\xd1\x8c\xc3\x99\xe5\x93\xc1\x0d\xc6\x1c\x59\xc7\xbc\x69\xdb\xda\x23\x64\xdd\x6e\xd0\x49\x7d\xa4\x9d\xf2\xfc\x79\x33\xf4\x77\xc4\x46\xdb\xe2\x33\x93\x8a\xbc\x4c\x4f\xf0\x15\x7e\x6f\xd7\x20\x9b\xb2\xc5\xc0\xc5\xda\x84\x0c\xbe\x83\x4a\x2a\x0c\x63\x34\xdb\xd1\xa0\x38\x1a\x81\x92\xcd\x80\xae\xe9\xae\xdb\xf4\x45\x25\x00\xc0\x46\x0c\xf9\x6f\xfa\x59\x1f\x47\x1b\xab\xf0\xfe\x47\x94\x9d\x89\xcc\x14\x6c\x8b\xee\x5a\x6f\xd1\x7b\xf3\x30\x7f\xce\x1f\xce\x06\x65\x8a\x75\x16\xf8\x20\xf6\x4a\xab\xb2\x00\x02\x07\x5a\x95\x40\xa6\xee\x55\x30\x80\x56\xb3\x57\x18\x00\x97\x19\x5c\xbf\x5f\xdd\x0d\x22\x85\x44\x36\x83\xe8\xd7\x45\x29\x0e\x47\x87\xb4\xb6\xe8\x66\xc6\x47\xda\xac\xbc\xb7\x3a\x79\x75\xf7\xe2\x59\x06\xab\xbb\xdf\x94\xe8\x01\x39\x76\x75\xa7\xf9\x42\x36\x95\xf8\xec\x99\x27\x15\x47\xd8\x17\x42\xd4\x19\x1e\xb6\x11\x04\xbf\x6d\x3a\x9f\x81\x7d\xb6\x13\x3b\x7d\xf0\x88\x3e\xbc\xd9\xad\x6a\xa9\x6e\x44\x1f\x47\x7b\xd6\xea\xc0\x7c\x1e\x47\x2b\x56\x8d\xee\xc9\xef\x56\x93\x99\x27\xea\x1e\xa5\x13\x61\xb8\x97\xff\xfa\xee\xe5\x6e\x10\x9f\x2d\x83\x3f\xb5\x31\x0c\xb3\xb8\x74\x86\x93\xdd\xac\xc8\xce\x3c\xe1\x7c\x4f\xe7\x3e\x72\x7c\xf9\xb4\x6d\x9a\x38\xc2\xed\x2c\x69\x10\xf0\x0e\xc6\x51\x87\xe2\xc4\x90\x50\xb4\xe2\x08\xed\x32\xff\x37\x62\xc7\x38\xe2\x60\x20\x68\xa3\x47\x3f\xef\x9a\x92\x36\x75\xa7\x9c\xd6\xa6\xcf\x13\x95\x08\x4a\x90\x0b\xfe\x4e\x0e\x37\xd6\x61\xc0\x1d\xcc\x80\xa4\x5e\x5b\x55\xdc\x7d\xeb\x56\x57\xdc\x12\x47\x04\xd2\xd1\x81\x07\x78\x9b\x4a\xbb\x1d\x47\xed\x6e\x00\x28\x6f\x8a\xc6\xe8\xde\x38\xaa\xda\x46\xe8\x47\x5a\x7b\x1c\x8e\x84\xf0\xfe\x4b\x3d\x83\x0c\x3a\xd1\x90\x21\x35\x78\xda\xfe\x73\xb6\xdb\x31\xcf\x68\x6e\x03\x85\x1e\x3f\xda\xab\xa7\x05\xfa\x82\x17\x17\x0e\x9c\x85\xcf\x39\x24\xdb\xb0\xba\x33\x9d\x40\x56\x71\x64\x9f\x87\xf2\x61\x40\x9a\xe6\x97\x3b\x20\x86\xd3\xec\x76\x4e\x55\xd8\xa8\xdb\xe1\xd0\x76\xa2\xb1\x0f\x47\xf3\x9b\xc7\xe3\xe9\xaf\x38\x2a\xe4\xf6\xe9\xfc\x5a\xd1\x1c\xe3\xd8\xa5\x2d\xfe\x45\x7d\x2a\x61\x79\x09\xf9\x95\x4e\x76\x50\xf6\x82\x71\xc5\xb6\xfc\xdd\x15\xa6\x3b\xf0\x29\xee\xde\x2b\xb1\x3f\x1c\xe8\xf9\x2f\xed\x9b\x5e\xac\xe5\xe7\xe3\x11\xd9\x18\xca\x5e\x14\x03\xab\x71\xcb\x65\x40\xdc\xae\x10\x32\xfb\x41\x4e\xaf\x32\x9f\x32\xb4\x57\xc5\x56\x1c\x8f\x26\xe3\x92\xc7\x51\x84\x8a\xef\xe4\x74\x09\x0a\x64\x20\x89\x99\x07\x7a\x32\xc4\x68\x5b\x95\xa1\x20\xae\x37\x0e\x43\xed\x38\xa7\xf0\x88\x16\x71\x88\xa3\x28\xea\x90\x1c\x8d\xd8\x9b\x79\x78\x4c\x66\xc0\xbe\xbb\x7a\x59\x7c\x46\xc3\x74\x3c\xa6\xd4\x3f\x67\x35\x76\x09\xdf\x4e\x67\xa6\xa6\x7c\xac\xdf\xa2\xe8\x54\x4f\xa4\x58\x7e\x35\xb4\xbd\x48\xba\x34\x46\xf8\x87\x03\xef\x94\x44\xc4\x68\x94\xf6\x66\x68\xab\xa8\x83\xde\x2d\xe9\x78\x8b\x5b\xa2\x2e\x67\x5e\xb8\x5e\x1c\x0e\xff\x22\x99\xcc\x8b\xf7\x70\x09\x63\x4b\x74\xb0\xf4\xcb\xbd\xae\xa6\x95\x56\x2f\x4d\x98\xa0\x47\x1e\xbd\xf9\x45\xad\x84\x9b\xd4\x82\x9a\x4e\x7b\x76\x16\x07\x8e\x18\x15\xbf\x75\xb9\x31\x8b\x53\x58\xdc\x32\x21\xa5\xd7\x2f\x0e\x00\xe2\x37\x9d\xc6\x81\x2e\x8e\x22\xe6\x74\xdd\xe6\xf5\x22\xd6\xf3\x38\x60\xcc\x69\xb3\x3c\x94\xc1\x9c\xdb\xe2\x71\x96\x5c\x6b\x46\xca\x4d\xc8\xf2\xe3\x25\x7c\x87\xbe\x69\x14\x3e\xbe\x84\x30\x93\x17\x47\x47\x6f\xb0\x09\x11\x46\x83\xcd\x63\x3b\x98\x1f\x84\x83\x9d\xf3\x3f\x1a\xee\x1a\x2c\x00\xfb\x28\x04\xe1\x9b\x55\xb8\xbc\x84\xc5\xc2\x03\x13\x36\x06\x26\x98\x02\x03\x02\xc5\x1b\xf0\x2d\xca\x17\x0e\xad\xdb\xcd\x32\x82\xba\xdd\x64\x71\x34\x72\xfd\x96\x23\x9a\x62\x0f\x76\x51\xb0\xe9\x56\x24\x63\x9f\x22\xc5\x2e\xe8\xbc\x2c\xd1\x66\xda\x2e\x46\x47\x6a\x5b\xcf\x9d\xd0\x06\x2e\xa7\x9d\x4e\x80\x24\x77\x66\xf9\xb0\xde\xec\xaa\x2e\x67\x3c\x55\x5c\x71\x44\x41\xb4\x8e\xa7\x97\xc6\x3d\x58\x6f\xf2\x20\xc2\x46\x1c\x23\x0c\x21\x75\x9a\x14\x59\x6a\x09\xf0\xfd\x77\x7f\xfe\x0b\xb5\xbc\xc3\x13\x0d\xbf\xc9\xb6\x5c\xed\x56\x94\x0c\x2f\xdb\x5a\x11\xf4\xeb\xf7\x7a\xf9\x87\xbd\xca\xbd\xc6\x37\xf8\x37\x83\xf0\xe1\x7f\x5c\xbd\x7e\x75\x44\x30\xf4\x0f\x39\xd5\x4b\xe3\x9d\x68\x24\x29\xb3\x80\x8d\x6c\x66\xb9\x39\xe0\x61\x6c\x66\x87\x2d\x68\x66\xa6\xc4\x66\xeb\xbd\x2d\x5d\xb3\x65\x39\xec\xe0\x3b\x73\x4b\x98\xf2\x17\xf6\xb1\xca\x64\x76\xb7\x27\xee\x7a\x9a\x9d\xb5\xc2\xc6\x6e\x2e\x67\x60\x8d\xb5\x65\x9a\xf9\x9e\x7b\x64\x74\xd4\x0c\x3b\x3d\xdc\x81\x47\xd6\x21\xeb\x8c\x43\x20\xe9\xb4\xea\x48\xe1\xa7\xaa\xd2\x7c\x3b\x1f\xd5\x9c\xf6\x40\x53\x60\xce\x64\xaf\x76\x79\x09\x78\x0e\x83\xb9\xaa\x2b\x5a\x56\x92\x6a\xf7\xf3\xd2\x42\x45\x27\xf1\x3f\x8b\x7a\x27\x70\x2e\x64\x0e\x0d\xe0\xef\xe2\x0e\xf1\x94\x95\x1e\x90\x99\x2c\xf5\x32\x1c\xa9\x73\xdb\xc9\x09\x60\x0b\x7b\x50\xb4\xc8\x60\xf1\xee\xf9\x93\xab\xd7\x4f\xff\xfe\xfc\xed\x22\x4d\xb5\x9f\x99\xc1\x07\xb4\x70\xe5\xf0\x39\xd7\x28\xec\x15\x8a\x28\xcd\x9d\xe6\x89\xa6\x27\x22\x80\xbd\xbe\xd5\x88\xa1\x38\xc9\x6a\x19\x45\x20\x2b\xe4\x08\x47\x8c\xa5\x47\x18\xdd\x52\x89\x92\xb9\x91\x3e\xbf\x5e\x27\xae\x87\x2f\x03\x09\xed\x04\x79\xea\x4b\x8c\x75\xbb\x8c\xb5\x14\x74\x79\xdd\x6e\x68\x59\x3f\x4b\x51\x57\x2a\x61\xbb\xa0\xbf\x21\x2e\x91\xb7\xc8\xa5\xbf\x48\x84\x11\x2d\xb4\xf2\x5a\x2c\x23\x8d\xed\x91\x26\x2a\x87\xcf\x84\x17\x52\x1c\xbf\x12\x11\x97\x4c\x62\xec\x80\xc4\xc1\x1e\xf8\x17\xbf\x6b\x2f\x7c\xaa\x8b\xac\xfe\x69\x77\x03\xaf\x94\xba\xf8\x7e\x79\x06\x5d\x6e\x65\x8f\x3a\xa3\x9f\xbe\x1c\x75\x36\x5e\x73\x1a\x0a\xfa\xb4\x03\x82\xe3\x76\xea\xcb\x4e\x76\xd0\x97\x1d\x63\xec\xca\x4a\x81\xba\x1a\x37\x79\xba\x0e\x1e\xf0\x60\x71\x9d\x19\xaf\xa5\x35\x94\xd2\x63\x1c\x95\xe1\x06\x26\x0b\xe5\xb6\x7d\x91\xc1\x49\x7e\xc8\x5f\x34\xeb\x36\x59\x5c\xbf\xbb\x7a\x6f\x52\xb8\xdc\x97\xf2\xb1\x51\x97\xff\xa3\x2d\x6f\x51\x9c\xba\x9c\x0d\xd4\x75\x89\x2e\xca\xd0\xef\xf0\xfc\x30\x47\x93\x74\x2d\x2b\x7c\x54\x92\x35\xc7\xbd\x84\x6f\xac\xfd\x2c\xaa\xea\x05\x46\xe1\x49\x97\xeb\xf0\x3a\xa3\x1e\x19\x94\x29\x21\xde\xe5\xbf\x35\x68\x41\x93\x34\xb6\x16\xb4\x9c\xd1\x15\xbd\xd8\xb6\x9f\x84\x51\x17\xf4\xc7\x28\x81\x14\x0e\x3e\xa2\x95\xc0\xf3\x3d\x0f\x2e\x62\xf5\x21\x83\xf6\x16\xc5\xcb\x5b\x06\x01\x79\xff\x57\x6c\x38\xe8\x20\x60\x74\x36\x35\x09\x57\xa4\x62\xe6\x15\x15\x9e\x68\xec\x45\x5d\x5b\xc3\x9d\xeb\x16\x9c\x2f\xa2\x7c\x36\x63\x99\x23\x1b\xe2\xc3\x0f\x48\x22\xee\xea\xb6\xe3\x29\x75\xc5\xf6\x4a\xd4\x62\x10\x89\x45\x30\xe3\xde\x41\x1b\x92\xdb\x34\xe4\xa4\xb5\x70\x75\xfc\x7d\x44\xfa\x48\xd3\x6c\x4c\x7e\xaf\xb3\xf9\x82\x60\x90\xf1\x30\xdc\x24\x19\x44\x4a\x69\xe7\x9c\x7b\x73\x7c\x3c\x0b\x96\x1c\x08\x8e\xb9\x47\x10\x9d\xd6\xb7\x7c\xa0\x73\x32\x67\x1c\x8d\x0c\x6e\x85\x39\x07\xca\x60\x66\xab\x25\x66\xdc\x2a\xf1\xf9\xfa\x56\xdc\xbd\x47\x57\xad\x91\xe4\x87\x46\xfe\x53\x27\x7b\x3e\x6c\xcd\x73\xae\x9f\x61\x02\xc3\xd0\x06\x5b\x7f\x89\x7f\x1c\x61\xde\x3d\x37\xad\xe9\x95\x92\xc4\xd4\xa2\xf1\xda\x52\x5c\x91\x76\x9f\xfd\x71\x44\x95\x94\x29\x4a\xe9\x1d\x6b\x44\xac\xce\x32\xcf\xad\x69\x73\x2d\x98\x94\x9e\xe4\x49\x40\x56\xa2\x19\xe4\x5a\x8a\x30\xcd\x52\xb8\x10\xc0\xd3\x1c\x19\x48\x3a\xdf\xd9\x29\x97\xac\xb6\xfd\xc8\x79\x82\xa1\x05\x41\xc1\x3f\x4e\x67\x4e\x26\x39\x88\x1c\x5a\x28\xea\xda\x83\x67\x23\x65\x62\xdb\x44\x09\x01\x6f\x5b\x5c\x52\x9a\xeb\x4d\x98\xe0\x3b\xef\x26\xd0\x70\xb6\xa1\xe3\x56\x38\x38\xa5\x32\x6f\xbb\x3d\x53\xac\x41\xa5\x9c\xc2\xb7\x33\x6b\x6a\xbe\x78\x06\x1a\x90\x26\x95\xac\x0c\xf6\x73\xa4\xe2\x7c\x8f\xc9\x0d\x98\x14\x07\xaf\x91\x39\x83\x17\x39\x9e\x66\x6e\x91\x29\xb0\x93\x90\x01\x71\x31\x2e\x4b\x56\x46\xa5\xf9\x7e\x85\xe7\xd5\xf8\x9e\x05\xd3\x40\x8f\xe1\x05\xbe\x2d\xfa\x8d\x18\xa0\xa8\xaa\x5e\x28\x73\xc4\xe8\xad\x86\x73\xae\xe6\x60\x19\xb7\x1e\xb7\x94\x72\x68\x9a\xd3\x18\x82\x4b\x30\x32\xef\x9b\xa4\xda\x5c\x8e\xcd\x7e\x65\x24\x5a\x8d\xb1\x87\x46\x01\x4a\x36\x9b\xda\xcf\xf4\x61\x19\x83\x98\x92\x4a\x53\xd0\x10\x34\x91\x15\xc3\x4e\x0d\x66\x6e\xf7\xf5\x83\x83\x46\x70\x09\xb2\x3a\x1a\x2a\x10\xcb\xf9\xd3\x9f\x66\x52\x46\x63\xcc\x96\x06\x0d\xfc\x9c\x04\xdc\x78\x0a\x0d\xec\xb4\x24\x6e\x73\x68\x90\x16\x3d\x83\x47\x90\xc3\xf4\x6a\x09\x78\x72\x1a\x9e\x90\x0e\xbe\x77\x7a\xea\xb5\xd4\xe3\x0d\x02\x61\x22\x87\xca\x0a\x84\xf2\x64\x77\x9e\x39\x48\x59\x70\x6e\x2b\xf3\xcb\x1d\x30\xdb\x57\x89\xb2\x2e\x7a\xad\x2c\xf4\x29\x94\xb2\x25\x26\x38\x65\xdb\xe1\xde\xc2\xc7\x04\x2b\xba\xf2\x4f\xdf\xe7\xba\x53\x9a\xef\xc5\xea\x83\x8e\x2e\x3f\x10\xb8\x8f\x39\xbc\x20\xd5\x83\x25\x06\x77\xd0\x36\xa5\x4e\x25\xfe\xed\xed\xdb\x37\x5c\x8a\x60\xd3\xc3\x8c\x8c\xee\xbd\x91\x6a\x10\x3d\x1e\xa7\x12\xbf\x8e\xd6\xe8\xf8\x16\x3d\x62\xd0\xd5\x52\xf9\x1b\x8c\x82\x44\x7f\x8d\x21\xca\x7b\xca\x60\xda\xe2\x10\xad\xe4\x8a\xdd\x70\xd3\xf6\xf2\xbf\x85\xf2\x73\xcb\x7a\x2d\x44\x0f\x36\x84\xa8\xed\xd8\x6c\x22\x35\x8a\xba\x6e\xf7\xfa\xc8\xb1\x91\x75\x0e\x6f\x3d\x5f\x83\xd3\xdc\x98\x57\x6e\xd7\xb1\xe7\x89\xf0\xee\xe7\x71\x34\x42\xe2\xf4\x59\x50\xc8\x02\x14\x80\xf1\x16\x77\x7a\xe5\x78\x78\x5a\x29\xb7\x59\x66\x77\xbd\x3d\xc5\xaf\x03\xab\x87\xc6\x14\x08\x8d\x0f\x6a\x4d\x7f\xa9\x4b\x2d\x56\x02\x1d\x25\x0d\x8f\x9d\x37\x31\x3e\xff\x48\x0d\x0e\xa7\x50\x67\x0e\xcd\x18\x33\xa3\xf4\xba\xe2\xae\x6e\x8b\x0a\xc8\x71\xcd\xd9\xe1\xd7\xb1\xa6\x89\x31\x69\x1b\x51\x1b\x8a\x1c\x77\x33\xff\x47\x5b\x54\xec\xfb\x75\xbe\x8f\xc0\x92\xf0\x5d\x06\x8d\xac\xd1\x9c\xb2\xd2\x52\x38\x96\x7c\x86\x6b\x93\x4d\xc9\xe0\x3b\xf2\x7b\x7f\x35\x9e\xa5\xda\xcb\xa1\xbc\xc1\x99\xca\x42\x61\x2d\x0f\xbb\x6a\xda\xe7\x5a\xb2\x2f\xe6\x3c\x4d\x74\xd6\xae\x6d\x2f\xe7\x69\xda\x29\x2f\xa1\xe8\x30\xb0\x60\x57\x51\x69\xaf\x38\x3a\xba\x09\x9c\x4b\xb7\x64\xff\xcc\xf3\xcd\x8c\x4f\x77\xcd\x1d\xdf\x7f\x29\x74\xcd\x2b\xe7\xc0\x93\x5a\xb9\x36\x5d\x1f\x3c\x01\x39\xf6\xbf\x7a\x9e\x3d\xb3\xb4\xd9\x48\xef\xd8\x9b\xa4\x59\x1f\xf2\xd3\x39\x92\x79\x6e\xb6\x03\x1d\x2f\xce\x32\xbc\xe7\x24\x43\x1c\xd1\xa1\xfa\xf2\x12\xbe\x8b\x09\xe9\x0f\x99\x8f\xb7\x41\x0f\x71\xa5\x53\x6d\xde\x10\x86\x7c\x5d\xe6\xf8\xb7\x7c\xaf\xf7\xeb\x1b\xb3\x29\x58\x0a\x23\xfa\x9e\x45\x26\xa2\x46\x3d\x1c\x9f\x5e\x02\x0f\xcb\xb7\x45\xaf\x6e\x8a\x3a\xe1\xb5\xa4\x7f\xc5\x11\xf0\x8d\x63\x31\xcb\x64\x4a\x58\xfe\x54\xf9\xbb\xbe\xe8\xd6\x89\xe8\xfb\x8c\x8a\xd9\x9a\x76\x00\x86\xc4\xcc\xfe\x27\xb5\x60\xbe\x47\x22\xe2\x36\x45\xd1\x18\x65\xb8\x24\x94\xd8\x5d\x47\xcf\x3f\x47\x69\x46\x71\xca\x4c\x20\x7c\xd0\x49\xf4\xa5\xa9\x07\xe3\x63\xfc\x65\x50\x4d\x96\xc1\x5b\x63\x04\x72\xd6\x57\x58\x1e\xb0\x24\xf0\xc7\xd4\x97\x18\x22\xf6\xbf\xfe\xab\xdd\xd8\x60\x71\x28\x44\x5a\xbb\x58\xfb\xc4\x1a\xd9\x94\x2c\x71\xe1\xa3\x6b\xc6\x03\x5a\xaf\xd2\xcd\xe8\x1b\xbd\x6b\xac\x39\x4a\xe7\x3f\xdb\x8e\xc9\x56\x6d\xcc\x1a\x29\x0e\xe8\x45\x57\xdf\xe1\xb6\x9b\x85\xc7\x91\xa9\x59\xc0\xb0\x7f\xab\x36\x39\x7f\xc5\xa8\x9c\x33\xfc\x98\x40\xc5\x16\xfb\x15\xdb\x98\x16\xc0\xa3\xe8\x2b\x3e\xd7\x25\x09\x08\x0c\xa8\x98\x48\x7f\x7f\xfd\xf7\xcc\xa4\x8e\x6d\x6f\x2f\x63\x4c\x68\xe5\x7a\xe7\x9e\xa3\xfa\x4d\x70\x2c\x7d\x3a\xf8\xf5\x4f\x44\x76\xd8\x4a\x85\xee\xce\x22\xcd\xfc\x29\x9e\x14\x15\x23\x48\x7a\x8a\xce\x63\xca\x9c\x20\x27\xf4\x6f\x6a\x55\x99\x8f\x0a\x2f\x17\x91\xe1\x8f\xd6\x6c\xc0\xb7\xdf\x42\xa9\x55\x23\x9b\x68\xe6\xd8\xf1\xf3\x7c\x64\x69\x3c\xc6\x96\x6b\x62\xf5\xe5\xe5\xd9\x11\x49\x99\x13\x3b\x5a\xda\xcc\x48\x88\x4b\x57\x10\x5d\x50\x2c\x52\x3f\x7b\x41\xb4\x59\xf8\x30\xfc\x44\x05\xb3\x84\x36\xb9\x95\x68\xa4\xce\x55\xcc\x91\x1e\x01\xb3\x2c\xdb\x14\x80\xe8\xfb\x3c\xb1\xd9\x2c\xa6\x38\x2a\x98\x3e\xfd\xab\x53\x07\x0c\x8c\xab\x52\x2e\xfd\xdd\xf9\xb9\xed\x57\xb2\xaa\x44\x63\xe5\x74\xb2\x37\x76\x73\x70\x77\xf0\x7c\x86\x29\xf6\x8f\x99\x94\x04\x37\xb1\xda\xba\x2e\x9d\xa1\x78\xc0\xb6\x32\x39\x89\x60\xea\xda\xd2\xcb\xa5\x68\xbc\x14\x0c\x4f\x64\x23\x76\xdb\xdb\x28\x6e\x3a\xed\xd2\xcb\xe7\xd8\xd3\x40\xf6\xb7\x62\x9c\x03\xb8\x17\x2c\x67\xa8\xa8\x97\xcb\xca\x8c\x68\xc6\x5a\x44\x34\x15\x50\x1a\x2f\xa8\xb2\x34\xb1\x27\xd7\x1f\x9a\xba\x2d\xe7\x21\x61\x23\x65\x5d\xaa\x1c\x7e\xd2\x65\x5c\xb6\xa8\x4b\xeb\xa3\x0a\x8a\xb2\x6c\x7b\x3a\x6f\xd7\x1e\xcf\x89\x52\x57\xd6\x47\x88\x6d\x06\x54\x0b\x8a\x43\x5c\xad\x29\xbb\x2d\x38\x23\xa6\x76\xa0\x18\x60\xdb\xaa\x81\x83\xd6\x40\x75\xb1\x6a\x9e\x7a\x3a\x81\x2a\x23\xa5\x80\x54\x57\xa2\x16\x1c\x48\xa1\x7f\x51\xe6\x58\x7c\xf0\xe3\x63\x24\xfd\xd2\xb9\x2e\xa8\x72\xb5\x05\xff\xf1\x71\x49\xd9\x25\xaf\x71\x54\x74\x69\xcb\xe1\x97\xb8\x07\xd6\x83\xe1\xbd\x08\xca\x4a\xcc\xac\x3e\x45\xb0\x18\x74\x19\xfb\xe2\xca\xf2\xc9\x45\x65\xb4\x90\xfc\x25\xbb\xed\xef\x8a\xbe\x61\x09\xd5\xb4\xc7\x6d\xc8\x60\x54\x3b\xba\x70\xaa\x0b\x26\x65\xa7\x33\x38\xd0\x42\x3c\x24\xe6\x27\xc1\xbd\xc7\x6d\xf2\x38\xa2\x5d\x03\x2e\x10\x89\x4f\x80\x68\x62\x5e\x79\x98\x4d\x4c\xcf\xd0\xef\xf8\xff\x67\x5b\x4c\xc7\xe1\x73\xfe\xac\x6d\x44\x92\x7a\x9d\x31\x9e\x7f\xde\xf7\x89\xc9\xf6\x50\x25\x07\x9a\x3a\x96\x8b\xc2\x92\xd4\xd4\x16\xf6\x42\x75\x6d\x43\x3e\x1d\x27\x1c\x8a\x0a\xc3\x55\x39\x70\x51\x26\xb1\xb1\xa9\x49\xc1\x36\x1d\x7f\x90\xe8\x4d\xe5\x0a\x05\xc3\x4a\x0f\xd2\x17\x7a\xb1\x29\x7a\x34\xea\x36\x3c\xf0\xb7\x48\x0b\xce\x8c\x00\x10\xfc\x89\xdd\x3e\x4f\xcf\x31\x09\x1d\x03\xff\x31\x36\x24\x5c\x7c\x26\xf4\x4e\xa4\x02\x94\x8b\x8a\x91\x55\x09\x67\xef\xd6\xa6\x2c\x98\xbe\x9f\xe4\xa1\x23\x79\xba\xe4\x94\x11\x93\x85\x47\xbc\xf0\xef\x9c\xd5\x2b\xfd\xf4\xef\x95\x18\xf0\x28\xf4\x1f\x58\x1f\x98\xcc\x8e\xe3\x94\xa5\x67\x7a\xa7\xc3\x9f\x89\xa2\xaa\x65\x23\x12\xba\x5b\xf3\xaa\xdd\x27\x69\xfe\x53\x55\xd9\xeb\x34\xe9\xd4\x04\x3b\xe7\x21\x8e\xc6\x20\xdf\xb4\xcd\xe6\x6f\xa4\x34\xfb\x84\xd6\x1d\x44\x93\xde\xf0\xaf\x44\x26\x8e\x8e\x48\xa9\x35\xc3\xfa\x90\xc1\xba\x2f\xb6\x22\x9b\x5d\x22\xae\x8f\xc9\x41\xf4\x35\xa4\xf0\xdd\x09\xb9\xf6\x0e\xa4\x5f\xa8\xdf\x1a\xf1\xb9\xa3\x23\x0c\x12\x37\xeb\x09\x64\x5e\x2f\x6a\xf9\xa5\x95\xcd\xe6\xa7\x7d\x71\x37\x69\xf9\x69\xd5\xb4\xfd\xb6\xa8\xf1\xcb\xae\x17\x7a\xe3\x7d\x0e\x74\xee\x85\xef\x99\xec\xec\xcc\xba\x6e\x1f\xf9\x2c\xb4\xab\xf7\x82\xf0\xc7\xe1\x80\x55\x2f\x8a\x5b\x34\xce\xb8\xf6\xad\xda\x04\x54\xc2\x78\x04\x05\x3a\x21\x02\xa6\xf3\xd4\xb9\x6f\x46\xd9\x7c\x2a\x6a\x59\x59\xad\x62\x6e\x3b\x69\xe4\xd1\x76\xc9\x86\x3c\x09\xe4\x15\xaa\x18\xa4\xd9\x1f\x20\x8b\xe7\x8f\xb9\x48\x52\xb4\x71\xd6\xa7\x5a\x66\x9b\xf1\x24\x1d\x45\x9a\xa9\x1e\x60\xe0\x1f\x4e\x8f\xdc\x23\xef\xe4\x83\xed\x9c\xdf\x4a\xcf\x49\xb9\xf0\x13\x7d\xa6\xbb\xe4\x4d\xd1\xa7\x37\xec\x74\x27\x41\x84\x90\x8e\x47\x5a\xef\x2b\x83\xe9\x45\x1e\x03\xd0\x3e\x40\x60\x6e\x3b\xc7\xe4\x14\x23\x2f\xd0\x9e\xfc\x5f\x3b\x3a\x8e\x23\x55\xa4\xbc\xb7\x7f\xbb\xe6\xb6\x69\xf7\x0d\xe7\xd8\x3c\x4f\xd8\x8f\x8d\x4c\x50\xe8\xa0\x66\x70\x2a\x24\x3a\xc6\xd1\x83\xc2\x18\x62\xa7\x75\xb2\x08\x11\x80\x3f\xfd\x1e\x72\x41\x10\xd9\xbc\x6a\x87\x9f\xdb\x5d\x53\xb9\xb8\x66\xec\x08\xce\x10\xe9\x01\x27\xf3\x18\x73\xe0\xd8\x12\xd3\x6e\x78\x8c\xae\x8f\x5a\x0f\x74\x06\xed\x8d\xb7\xc7\xcf\x58\x98\xb3\xc4\x7f\x5c\x81\xdd\x12\x04\x21\x4e\x54\x42\x22\x94\xf9\xd0\x17\xe5\x6d\x82\x40\x11\xb8\xb3\x5c\xc6\x74\x99\xda\xcb\x1f\x1f\x03\x76\xc2\xcd\xf7\x0c\x16\x2d\xad\x16\x85\x12\x16\x44\x34\x72\x62\xf8\xe0\xd8\x1a\x29\xda\x11\x7b\xff\xe2\x7f\x71\x33\x39\x26\x6d\x61\x5b\x34\xb6\x2a\x53\x8d\xe2\xd2\xb7\x6d\xfb\xb2\x68\xee\x18\xb8\x3a\xb7\x89\xe6\xf4\x0f\x33\x3f\x48\x44\x9b\x8e\x0d\x4a\x63\xf9\x3e\x87\x3d\x55\x5d\xdd\x01\x5e\xab\x31\xad\xb2\x9a\x5a\x68\xb7\x25\x60\xce\xd3\xf9\x54\x10\x9f\xe5\xc1\xe2\xbd\xd3\xd0\x32\x77\xe5\xab\xf6\xac\xd8\x7b\x7a\x3d\x1d\x8d\x41\x14\x3e\x1d\x8f\x76\x31\x8c\xf3\xcf\x68\x97\x99\xb7\xd4\xdc\x41\x32\x8f\xe7\x84\x2a\xba\x0e\x94\x3a\x5d\xf7\xed\x96\xdb\x4d\xb6\xda\xac\x5e\x4d\xd7\xee\x73\x53\xb0\x7a\x7c\xe0\x9d\x40\x7f\x1d\x31\x70\xd4\x3d\xf4\xd0\x04\x19\x85\x88\x66\x48\x36\x33\x29\xb3\xc2\x7d\x04\xac\xa4\xea\x0a\x4c\xae\x72\xa5\x34\xdf\xd6\x61\xe9\x32\x43\xf1\xd0\x5d\xb5\x6d\x83\x7f\x4d\x59\x34\xba\xaf\xc5\xa7\x42\xd6\x68\x58\xa6\x14\x33\x80\xb5\x2f\x67\x5c\x8e\x89\x20\xb3\xd6\xf8\xf1\xb1\x15\x69\x14\x65\xbf\x9f\x91\x78\x2e\x0a\x41\x87\xd5\x9c\xae\x1e\x8e\x4b\xdb\xc1\x77\xfe\x9d\x97\xc5\xca\x7f\xd3\x42\x99\xf3\x0a\xad\x46\x98\x0e\xb3\xa3\x3c\x19\xd2\x7b\x4d\x8b\xb5\x64\x69\xfb\x71\x45\xb7\x3d\x01\xc6\xf5\x78\xe7\x72\xa6\x12\x7f\x14\x88\x7a\x75\xf8\x73\x55\xf8\xae\xd6\x41\x1f\x8d\x3c\xc1\x92\x78\x77\x65\x20\x28\x1e\x75\x37\x4b\x60\x52\x9e\x16\x47\x18\x02\xe0\x10\xa3\xc1\x78\x51\x4c\x0a\x5c\x05\x1f\x48\xf0\x08\x92\x12\x77\x4e\x61\xe3\x9b\x5d\x43\x31\x88\x2f\x4f\xf6\xec\xc1\xd7\x21\x2e\xa1\xa8\x4f\xa5\x2c\xab\x78\xc2\x5a\x41\xb1\x1e\x44\xbf\x2f\xfa\x6a\x46\xd2\xfc\x5d\x0a\x25\x0d\x6d\x89\xf1\x50\xd0\xc2\x62\x8f\x9c\x2d\x4c\x5d\xa3\xaf\xe5\xe4\x60\x36\x7a\x18\x9b\x84\x1f\x1f\x5b\xae\x8a\xa3\xa3\x2f\xbe\x66\x49\x7c\x9a\x61\xca\x54\xec\x4a\xf7\x37\xb2\x16\x27\x2e\x0b\x58\x2e\x42\x0b\x3a\xb1\x20\x71\xc4\x37\xc7\x96\x26\xe9\xc9\x76\xe4\x9e\x4c\xe9\xf9\x8c\xa8\x4b\x97\x3b\x87\xc6\x54\x10\xfa\xfe\x4c\xc2\xbe\x2b\x59\xdb\x1c\x13\xcf\x69\x3c\xe3\xb9\x9e\x77\x5b\xc9\x6e\x01\x81\xf7\xee\x5e\x71\x7a\xe1\x44\x1e\xf0\x74\x4e\xcf\x65\x5c\x4d\xa9\x8e\x97\x59\xb7\x46\xcd\xd1\x34\x32\x3c\xe9\xd6\x8b\x7c\x60\x78\x5f\x67\xe4\x2b\xbb\xb2\x93\xdb\xe9\x41\xfc\x3a\x02\xb8\x52\x03\x33\xf7\x59\x12\x78\xb1\x08\xad\x2a\x7f\x76\xe6\x88\xc3\xac\xf1\x44\x60\x31\x83\x9e\xfe\xe8\xe3\xc7\xb0\x6a\xc4\xd0\xc0\xf3\xdc\x9a\xb9\x5d\x22\xb5\x77\x6a\x13\xb4\xe6\x08\xdc\xf5\x53\x66\xb7\x18\xab\x47\x7a\x71\x82\x41\xc2\x5c\xce\x9c\xca\x7e\x18\x0b\x78\x65\x05\x87\x38\xb4\x63\xc6\x72\xe2\xee\x3a\x3f\xde\x74\xc0\xb2\xb8\xf8\x94\xe1\x93\x6b\x93\xe4\x0d\x59\x43\xdf\x90\xc0\xd4\x6d\x1a\xb6\x59\x6b\x39\x41\x77\x8f\x15\xd3\x2c\xd8\x4a\xb3\xd5\x20\xe9\x2e\xf3\xf2\x52\xbf\x6d\xe3\x95\xd8\xbf\xa5\x27\x89\x7b\xdf\x46\x3a\xa3\x9b\xf4\xb0\xfc\x6a\x68\xbb\x24\xbd\x37\xd3\x71\xca\x98\x06\xb6\x4c\xae\xfd\x78\xd5\x46\xf5\xef\x3c\x9c\x93\x51\xec\xcd\x8f\xc9\xb9\x9c\x39\x3b\xb8\x37\x82\xd6\x7c\x37\xcd\x08\x9e\x0c\xc6\xbd\xb1\xae\x37\xa7\x93\xbd\x48\xdc\x88\xaa\x5e\xa5\x89\x96\xd9\x6b\xc0\xca\x54\xec\x49\x81\xf8\xdb\xbb\x4e\xcc\x25\x35\x30\x5c\xa7\xdd\x4a\x78\xb4\x39\x9b\x78\xd8\x1a\xcf\x0b\x17\x83\xd4\x08\xfb\x21\x94\x0e\x34\xcd\x34\xe7\x36\x62\x8c\xfc\xd7\x53\x1f\x57\x39\x41\xea\xe8\x38\x84\x39\xed\xe9\x57\xf0\xc8\x1b\xd9\x6c\xf8\xa9\x29\x3b\x3f\x1c\xff\x38\xa6\x28\x19\x4c\x3b\xbb\xd1\xd1\xd1\x0b\x64\x46\x05\xa9\x78\xad\x4c\x60\x05\x4a\xb2\x07\xbe\x7c\xab\xf5\x0a\xa1\xdd\xcf\x5c\xca\x3d\xc4\x5c\x0c\xed\xc7\xb0\x4f\x8a\xf2\x76\xd3\x63\x44\xcc\x5a\xa1\xcb\x37\xe3\x73\xb7\xf1\xc9\x34\xa9\x43\x7c\x80\x45\x06\xd4\x9b\x35\xe4\x09\x3d\x4d\xe3\x9e\x93\x9e\xed\x93\x46\xd6\x64\xb2\x32\xd8\xa7\xb1\xbf\x58\x73\x50\xd5\x36\x8d\x65\xda\x2e\xe7\x1b\x1f\xe6\x9a\x47\xb2\xcf\xa0\xd7\x92\x39\x67\xb0\x0c\xb0\x63\x6c\x8b\xc1\x08\x4a\x50\xe9\x9f\xa1\x8e\x6e\xd2\x98\xdc\x61\x7a\x9a\x87\x59\x57\xbf\x65\xa4\xdc\xfc\x26\xe7\xda\xbb\xed\xd9\xc2\x23\xee\x9c\xc2\xc8\xb2\x78\x85\x22\x68\x97\xb9\x28\x1f\x6f\x89\xe7\x2f\xd9\xde\x51\x0f\x3c\xa8\xef\x44\x7f\x20\xa6\x59\xe2\x28\x16\xbc\x14\x8f\x61\x31\xe7\xa4\x7b\x8b\x3e\x38\x50\xf4\x01\xd1\x49\x22\xab\x77\xb9\xa6\x1b\xf7\x4f\xda\xea\x2e\x33\x43\x9f\x6b\x95\x60\x01\x99\x71\xff\x71\xf5\xfa\x55\x92\xfe\xd5\xef\xe6\x1f\xc4\x23\xda\x70\x69\xc1\x99\x3d\xa3\xeb\xb8\xb0\x0c\xdc\x99\x17\x58\xc3\xd4\x14\x35\x71\x69\x4f\xf8\x13\xee\xaa\x7c\xd0\x29\xa8\x31\x4d\x08\xf8\x12\x54\xe9\x35\xe3\xf6\x1c\xe3\x68\x4b\x8e\x83\xad\x47\xd8\x72\x0f\x40\xce\xae\x8c\x7f\xcf\x75\x1b\xbc\x13\xa3\xf7\xa6\x50\xad\x94\x13\xf7\xc9\xf1\x1c\x78\x25\xec\xd0\x88\x4d\x3b\x48\xf3\x8e\x34\x6c\xbd\xa1\x73\x0c\x9c\xe5\xe3\x95\x28\x1f\xdb\xda\xb4\xc7\x6f\x78\xcc\x47\x1b\x0c\xa1\x4f\x03\xf6\x5e\x0a\xae\x8c\xa2\x80\xa2\xe2\x8b\x76\x0a\x0a\xa3\xac\x47\x45\x2a\xb7\xa2\x1b\x18\xfb\x0a\x0f\x5a\x70\xcd\x71\xe4\x32\xae\xee\xda\x0b\x73\x9d\x29\x47\xa2\x29\x88\x77\xed\xe2\xdd\xb1\x0d\x96\xc2\xea\x09\x5d\x72\x80\x5f\xc1\x84\x28\xc7\x11\xdb\x08\xff\xdc\x44\xd7\x3a\xe9\xe9\x82\x49\x98\x57\xbc\x69\xac\x83\xd3\xf6\xe6\x75\x3f\xdc\x29\xd9\x3a\x32\x1c\x8e\x29\x24\x33\xf0\x76\x8d\x81\xe8\x88\xc3\x1e\x4c\x06\xf4\xb2\x06\xda\x72\xf4\x9e\x74\x07\xac\x07\xa5\x2a\x51\x6a\xe3\xae\x71\x64\xe1\x24\xd4\xdd\xcc\x34\xc2\xc0\xd6\xa7\x91\xf8\x9a\xeb\x2b\xfe\xce\x1b\xdf\xcb\xec\xe3\xc1\x9e\x62\xfa\xbd\xcc\x61\xd2\xf4\xd2\xd7\xd2\xaa\x25\xd8\x2b\x7c\x40\x60\x0e\xc7\xd9\x01\xc4\x3b\xc1\x08\x7a\x62\x87\xb8\xf2\x97\xbd\x7a\xa6\xf3\x7c\xa6\xcd\x70\xbc\xff\xd8\x96\x4b\x63\xd9\x23\x9e\x1a\x7a\x28\x67\xa1\x34\x60\xc0\xcb\x8c\xa7\xef\x4a\x1b\xae\x5b\xdd\x41\xf0\xd2\x3d\xc3\xd5\xc1\x3c\xae\xce\x9b\xe8\x98\x84\xcd\x29\xcc\x71\xac\x77\x2c\x97\x39\xf3\xc2\x95\x35\xb4\x7a\x3a\x6d\xc0\x39\xf3\xdf\xec\x66\xb2\x9c\x7c\x4b\x49\xed\x93\xf3\x3d\x90\x81\x9d\x2e\x66\x7b\x13\x68\x63\x9a\xc2\xd2\xdb\xda\xff\xb7\xe2\xf3\x60\xf1\xb6\xa3\x4f\xe3\xf2\x30\xde\xf7\x96\x1d\x22\x71\x66\x95\x5f\xc0\xe2\x9c\xa1\xc4\x52\x7f\xec\xeb\x15\xf9\xf3\xa4\xa6\x1a\xd1\xc7\xc1\x51\x1d\xc7\x64\xb0\x35\xd1\x8f\xc7\xc8\x20\xb7\x5d\x2d\xb6\x58\x87\x33\x51\x9a\x1f\xa9\xb0\x16\x97\xf3\x31\xd0\x6c\x13\x06\xa3\x11\xd8\x0f\x6a\x79\xeb\xd5\xd6\x5a\xf3\x60\x98\xce\x4d\xeb\x92\x4b\x21\x55\x7c\x6a\xd9\xde\x5f\xb2\x09\xc4\x74\xda\x44\x6d\xf3\x24\xac\xf8\x8c\xfd\x63\x10\x47\xb8\x0c\x46\x07\x12\xa3\xfa\xba\x3f\xbd\xc5\x03\x89\xd4\xa7\xef\xc4\xf4\x26\x63\x86\xf6\x90\xff\xc2\x7d\xfe\xd2\x15\xcc\x23\x6f\x27\x0d\xd0\xff\x12\x1e\xb2\x10\x44\x3f\x65\x25\x5e\x2d\x5a\x29\x4f\xc7\xdd\xcf\x4d\xb4\x9c\x8f\x63\xe5\xd5\x0b\x58\xc9\xa6\xe8\xef\xb4\x59\x53\xe4\xde\x16\x92\x62\xef\x02\x75\xec\xf3\xe6\x93\xa8\xdb\x8e\xaa\xff\xe2\x8b\x8b\xd3\xdc\x58\x28\x30\xaf\xbe\xcc\x81\xfc\x96\x49\x3b\xee\x0c\x57\x74\x07\xb8\x4f\x95\xa0\x6b\x9c\x57\x81\xbc\x2b\x3e\xfb\x91\xaf\xed\x63\x6c\xdd\x5c\x74\x64\x11\xde\x8c\x32\x9c\x09\x94\x78\x0f\x78\x06\xbe\x0b\xeb\xef\xce\x7c\x26\x4e\x98\xf3\x9c\x38\xa2\xf7\x35\xf1\x6d\x47\x41\xde\x56\x16\x16\x38\x8a\x13\xe5\x8d\xc2\xa6\xec\xaa\x51\xca\x4e\x36\x43\x22\xd8\x53\x4b\x47\x85\x8f\x82\x6b\xb6\xe2\xe8\x68\xcb\x3a\xe7\xe8\xf8\x05\xaa\x5d\xf0\x41\x9c\xa5\xe5\xd9\xb4\xa3\xb7\xb2\x13\xa5\x9b\x7c\xe2\x75\x2a\x21\x29\x9b\xe1\xff\xfc\x19\xe5\xf8\xc4\x0a\x5d\x5d\x9a\x11\x25\xd7\x17\xfe\x3d\x70\x9f\x5f\xee\xea\x41\x76\xb5\x78\x7a\xd3\xca\x52\x28\xda\x51\xa1\x25\x14\x2e\x6d\xd2\x32\xc8\xa6\x09\xe3\x10\x7b\xad\x71\xc4\x1c\x12\xf0\x8f\xd1\x38\x62\xce\xba\x3d\x21\x31\x62\xd2\xfa\x39\x84\x53\xfb\xf1\xcf\xa6\x59\xc3\x35\x8e\xb5\xaa\x8f\xf9\x3f\x91\x5a\x0d\x70\x3f\xa5\x2b\xbf\xe6\xdd\x32\xa8\xeb\x26\xaf\xf1\xa0\x0a\x6c\xbe\x4d\xe5\x5d\xc4\xb0\xb5\x55\x3c\x20\x7c\x75\xcc\xc9\x4b\x38\x31\xaa\xac\x53\x73\x9c\x7c\x75\x8b\xbd\x93\x71\x38\x87\xa4\xed\x85\xa4\xf9\x24\x4d\x18\x83\x12\x4c\x2f\xe6\x23\xbc\xcf\xa2\xad\xf5\xf4\x7d\xd0\x9d\x37\x11\x5e\x18\x89\xf5\x1b\x87\x2d\xdd\x85\x7d\x53\x0c\xa1\xa7\x1c\x85\x85\x99\x77\x4c\xd5\xa0\x91\x69\xc8\x94\xfb\x23\x57\x5d\xb8\x58\x35\xbc\xed\x72\xcf\x32\x53\x1f\x97\x07\x5e\x81\x79\x44\x43\x7e\x69\x59\xe9\x61\x66\xf1\x78\x34\x2a\xd7\xc9\xb6\xe1\xf9\xdc\xbb\x60\x83\xd0\x32\x58\x78\x73\xba\x0b\x06\x86\xae\x5c\x4a\xe3\x3e\xba\x4f\xf1\xe1\x70\x96\xdb\xcf\xbe\xbc\x87\xe4\x9d\x8f\x7e\xce\xbd\xc6\x26\xc1\x1e\xa5\x09\xee\x32\xf0\x34\xc1\xfc\xbb\x24\xf8\x66\x33\x4e\x8a\x9c\x2d\xc7\xa4\x39\x78\xa9\x5a\xcc\x54\x11\xfc\x3c\x54\x35\x9c\x29\xa3\x55\x98\x55\xbb\xd5\x9f\xa8\x57\xc2\x0d\x1f\xbf\x2c\x03\xa4\x7b\x51\x33\xba\x3a\x2c\x19\xc8\xfa\x2e\x51\x81\x65\x3d\xc6\x5b\x19\x0d\xf7\xd8\xde\x3c\x72\x2f\x35\xcf\xc3\xce\x14\x9c\x6b\xc6\xd5\x0d\x38\x39\x9e\x13\xd8\x62\x68\xbe\x7c\x19\xbc\x3e\xda\x7f\x41\x68\x1c\x05\xc3\xf1\x26\x29\xbb\x80\x33\x45\x58\x74\xf1\xdd\x87\xd3\x43\x57\xd8\x6b\xa2\xec\xf9\xa1\x4e\x2a\x1a\xff\x8e\x3c\xd5\x9c\xf6\x02\x53\x4a\x0a\xf6\x37\x82\x5e\x2f\xea\x8f\xf0\xe5\x87\x0b\xb3\x73\xe3\x49\x28\xc4\x36\xb8\x70\x8f\x9e\x9f\xbd\xa4\x6c\x29\xca\x0b\x02\x59\xcd\xd4\x9c\x9e\x28\x28\x1b\xbf\xea\x28\x70\x60\x56\xfc\x26\xa3\x32\xe7\x89\xbd\xb3\x1d\x95\xe9\xf5\x61\xce\xd6\x34\x5f\x07\x6e\xc8\xfb\x70\x60\x78\xc6\x83\x43\x0f\xf1\xc9\x8a\xb4\x13\x05\x69\xea\xd4\x81\x90\x6d\x49\x6c\xe9\xc0\x62\x61\xcb\xd0\x9e\x37\x15\x0d\x67\x07\x1b\x5d\x5e\xd1\x4f\xde\xcb\xea\x5e\x10\x9d\x41\x61\x37\x66\x7f\x23\xcb\x1b\x60\x2b\xb9\x72\x55\x80\x60\x4f\xe1\xcd\xbb\xb9\xfd\x84\xbd\xca\xb9\x1f\xfa\x5d\x73\x49\xf8\xf3\x29\x78\x66\xae\x62\xd5\xf6\xb6\xda\x29\x52\x39\x7d\x37\xa7\x81\xe8\xab\x05\x45\x55\x2c\xdc\xeb\xa2\x56\x82\xab\x4b\xf8\x11\x52\x09\xed\x79\x3c\x29\xab\xf3\x37\xce\x16\xd5\x4d\x7d\x06\x86\x79\xfc\x1f\x3c\x31\xe7\xad\x41\x87\xd7\xed\x52\xe0\x81\xda\xee\x5f\x71\x89\x88\xd7\x45\x07\x9c\xb2\x17\x2e\x57\xf7\x41\x56\x5f\x7f\xa7\x88\xaf\x8e\x1c\x75\x38\x64\xc5\xcd\x69\x63\xc2\xfd\x1b\x91\x07\x0a\x65\xee\xa8\x42\x5a\xf5\x93\xd9\xd3\xa7\x2f\x29\x0b\x98\x72\xd4\x57\x17\x05\x9c\x3e\x6f\xe6\x16\x9b\xc1\x9e\x25\xda\x6c\x91\x5b\x48\x2f\x73\x84\x82\x6e\x57\x99\xa3\xd6\xd0\x6a\x08\x65\x23\x83\x90\x5a\x7c\x7c\x12\x52\xf0\xdb\x6f\x29\xba\xb7\xab\xb7\x35\xe3\x2c\xd0\x68\xbc\x8c\xd3\xb2\x96\xbd\x1a\xac\xf4\xf2\x0d\x62\xfd\xd0\x6c\x58\xbb\x0e\xe5\xf6\x03\xf8\x02\x6b\x18\x1b\x67\x5a\x5a\x8a\x23\x5b\x1c\xe9\x94\xc5\x3b\x17\x66\x61\x17\xae\xbc\x41\xe5\x7c\x26\xcf\x7b\xab\xd2\x78\xac\x9b\x66\x74\xa9\x2b\x19\xe3\xb6\x0c\x14\xbf\xc5\x64\x5e\x83\x22\x8d\xd4\x6c\xdd\x04\x53\xde\xca\xff\xa9\x0d\x7c\xfd\xf7\x07\xd7\x2e\x9c\x29\xad\xd0\xb8\xdd\x5f\xb6\x10\x14\x2d\x18\x12\x69\x5e\xa1\xc2\x1e\x9f\x5d\x8e\xf1\xd4\x78\x85\x3c\x63\x74\x4f\x06\x01\x97\xa0\xb5\x4a\xc1\xbe\x0b\xc8\x16\x25\x3d\xa8\x40\x56\x71\x4c\xae\xc7\x1e\xbc\xb7\x34\x01\xcc\xbc\xab\x89\x1f\xda\x37\x36\x99\x17\x28\xf0\x73\x54\x70\x5b\xa7\x15\x27\x8a\x51\xda\xe7\x46\x69\xb2\x34\x69\xdd\xe8\x2f\x6b\x19\x2c\xd2\x2a\x48\xff\x21\xae\x34\x52\xb9\x6c\xcc\xeb\x5d\xe8\x2d\x4c\xa3\xf7\x59\x67\xcc\xef\xfa\x15\x72\x29\xdf\x3d\x9b\xf2\xa2\x7d\x76\x8d\x2c\x88\xa5\x9e\x2a\x3e\xc1\x85\xbc\x6b\xca\x26\xcc\x18\x1d\x2f\x59\xb6\xe9\xbb\x92\xfc\x63\xd1\x73\x23\xbd\xab\xbc\x33\x32\xe8\x45\x6d\xf6\xc4\x6b\x26\x89\xb6\xe5\xbc\xc3\x67\xeb\x3e\xa2\x9f\x64\xe4\x59\x9a\x17\x19\x58\x0c\x9c\x03\x69\x5f\xb6\xfb\x85\x25\x7e\x44\x5e\x7c\xef\xb5\x66\xc1\x98\xb7\xd3\x7b\x6d\x87\x7d\x6b\xb0\x79\x30\x5b\x16\xf8\xc7\xdd\xd3\x0c\x0f\xd8\xa4\x22\x11\x6d\xd1\x6f\xdc\x4b\xb4\xef\x01\x4c\xfb\xca\x67\xdd\x15\x73\x51\x83\x68\x28\x72\xd3\xe5\x1a\xc6\x45\xdc\xb4\x7d\x8b\x55\x51\x22\xf8\x59\x0a\xef\xb4\xb1\x6d\xea\x3b\x7c\x13\x10\xae\x6c\x8e\x8d\xc8\x25\x17\x95\xf5\x92\x51\x1b\x42\xa2\x9c\xd8\xa5\x70\x25\x86\xbf\x91\x87\x95\x98\x1f\x19\xca\x5f\x3e\xf3\x12\x16\xcc\x36\x8d\x3c\x0d\xa0\xa9\xfe\x28\x84\xe1\x6d\x5f\xc8\x7a\x0c\xe1\x30\xdf\x9d\x79\x22\x49\x1d\x2f\x70\xb5\x94\x9b\x8c\x34\xfb\x19\x84\x5f\xaa\xcd\x38\xd7\x64\xf1\x65\x3d\xbb\x44\x33\x63\x6f\xb1\x4d\x4c\x38\x4f\x64\xf2\xa1\xc1\x01\x90\x62\x83\x38\x2a\x44\x33\xe6\xf2\x2c\x20\xfe\x6a\x41\x20\xab\x19\x43\x35\xae\x9c\x57\xf9\x76\x5a\x37\x8f\x8a\x20\x03\x93\x51\x0c\x8c\x48\x78\x3d\xfe\x04\x79\x7e\x15\xe5\xa7\x73\xe4\x71\x85\x52\xe4\xf6\xfb\xef\x26\xf8\xf1\x31\xea\x35\x7e\x85\x84\x71\x4a\xcd\x02\x65\x9b\x3f\x7f\xfd\xb3\x35\x75\xe1\x2a\xe7\x03\x5c\x24\x18\x97\xda\xa8\x7c\xf6\x96\xa1\xb7\x43\x5c\xe0\x42\x9a\x86\x7e\xe8\xc8\x84\x7a\x2e\x3a\x98\xff\xb9\x1b\x5e\x70\xc6\xc2\x45\xde\x2d\xc6\x0b\xfe\x8f\x96\x18\xf1\xa6\x77\xb8\xf8\xb7\x11\x97\x50\xa0\x72\x33\x33\x48\xe5\x7e\x75\x82\x55\x06\xeb\x88\xaa\xe5\x5f\x59\x29\xca\x12\xcf\xe3\x0d\x44\x8c\x49\xf1\xb2\xc0\xca\xfe\xa2\x13\x5e\xa2\xc8\xe7\x76\xc6\xf8\x3c\x41\xd4\xe7\xb3\xed\x37\x2a\x0f\x14\x8d\xc7\x5e\xe7\x1c\xef\x93\xb8\xdd\xe3\x7b\x1b\xeb\xa6\xd0\xa3\x12\xd5\xfd\xd3\xb9\x35\x07\xa4\xd1\xa3\x8b\x1a\x95\xdc\xdd\x03\xe7\xf4\x43\x50\xf7\xa2\x80\xe7\x1c\x90\x1a\x8c\xec\xf5\x71\xda\xca\x04\xb9\x33\x9d\x9e\x34\x8d\x38\x1a\x7b\xf1\x4d\x4e\x72\xdf\x3d\x76\x43\x1d\xe6\x47\x71\x67\x57\xcb\xab\xe3\x8d\xc5\x5d\xcd\x02\x5e\xb2\x3f\x97\x82\xeb\xd6\x3f\x30\x24\x9a\x76\xb7\xb9\x79\xc8\x75\x15\xc7\xed\x14\x66\xb2\x73\x63\xf2\x1a\xa8\x1e\xa0\x68\x26\x61\xaf\x7d\x73\x91\x16\x67\x83\x8f\xc2\xdf\x09\x69\xd7\xfe\x60\xa9\x99\xd5\x2b\x2d\x0d\x58\xd1\xc6\xb6\x5e\x42\x2b\xf4\x72\xaf\xf6\x45\x97\x20\xf5\x27\x15\xc2\xa6\x53\x92\xde\x1b\xa0\x5a\x05\x17\x86\xa7\xa4\xe2\xce\x47\xa5\xa7\xfc\x5b\xab\x71\x82\x18\xe8\x18\x1f\x0e\x8f\x41\x34\xd5\xf1\xf8\xff\x06\x00\xa2\x9f\x41\xb9\xcf\x70\x00\x00")
 
 func svcTransport_wsGoTplBytes() ([]byte, error) {
 	return bindataRead(
@@ -335,8 +377,8 @@ func svcTransport_wsGoTpl() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "svc/transport_ws.go.tpl", size: 6420, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd0, 0x3c, 0x3e, 0x86, 0x2d, 0xbf, 0x1a, 0x54, 0x8, 0xc4, 0x79, 0x27, 0x96, 0x70, 0x33, 0x89, 0x5c, 0x5, 0x7b, 0x4e, 0x94, 0x2c, 0x0, 0x8d, 0x9e, 0x1c, 0x46, 0xd, 0x63, 0x16, 0xcd, 0x48}}
+	info := bindataFileInfo{name: "svc/transport_ws.go.tpl", size: 28879, mode: os.FileMode(0644), modTime: time.Unix(1464111000, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x49, 0xfc, 0xc6, 0x70, 0x78, 0xf0, 0xe0, 0xd, 0x4a, 0x37, 0x5f, 0xb6, 0xff, 0x55, 0xb6, 0xe2, 0xe2, 0x83, 0x46, 0xad, 0x91, 0x32, 0xbf, 0x66, 0xce, 0xb2, 0x2f, 0x3f, 0xb5, 0xe8, 0x50, 0x29}}
 	return a, nil
 }
 
@@ -431,6 +473,7 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() (*asset, error){
+	"cmd/NAME-cli/main.go.tpl":         cmdNameCliMainGoTpl,
 	"cmd/NAME/main.go.tpl":             cmdNameMainGoTpl,
 	"handlers/handlers.go.tpl":         handlersHandlersGoTpl,
 	"handlers/handlers.methods.go.tpl": handlersHandlersMethodsGoTpl,
@@ -438,6 +481,7 @@ var _bindata = map[string]func() (*asset, error){
 	"handlers/middlewares.go.tpl":      handlersMiddlewaresGoTpl,
 	"svc/client/grpc/client.go.tpl":    svcClientGrpcClientGoTpl,
 	"svc/client/http/client.go.tpl":    svcClientHttpClientGoTpl,
+	"svc/client/ws/client.go.tpl":      svcClientWsClientGoTpl,
 	"svc/config.go.tpl":                svcConfigGoTpl,
 	"svc/endpoints.go.tpl":             svcEndpointsGoTpl,
 	"svc/server/run.go.tpl":            svcServerRunGoTpl,
@@ -496,6 +540,9 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"NAME": {nil, map[string]*bintree{
 			"main.go.tpl": {cmdNameMainGoTpl, map[string]*bintree{}},
 		}},
+		"NAME-cli": {nil, map[string]*bintree{
+			"main.go.tpl": {cmdNameCliMainGoTpl, map[string]*bintree{}},
+		}},
 	}},
 	"handlers": {nil, map[string]*bintree{
 		"handlers.go.tpl":         {handlersHandlersGoTpl, map[string]*bintree{}},
@@ -511,6 +558,9 @@ var _bintree = &bintree{nil, map[string]*bintree{
 			"http": {nil, map[string]*bintree{
 				"client.go.tpl": {svcClientHttpClientGoTpl, map[string]*bintree{}},
 			}},
+			"ws": {nil, map[string]*bintree{
+				"client.go.tpl": {svcClientWsClientGoTpl, map[string]*bintree{}},
+			}},
 		}},
 		"config.go.tpl":    {svcConfigGoTpl, map[string]*bintree{}},
 		"endpoints.go.tpl": {svcEndpointsGoTpl, map[string]*bintree{}},
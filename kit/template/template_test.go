@@ -0,0 +1,153 @@
+package template_test
+
+import (
+	"bytes"
+	"go/format"
+	"io"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/niiigoo/hawk/kit/generic"
+	"github.com/niiigoo/hawk/kit/handlers"
+	"github.com/niiigoo/hawk/kit/template"
+	"github.com/niiigoo/hawk/proto"
+)
+
+// module is the Go module of testdata/sample, the generated code of sample.proto is tested by the tests of the module
+const module = "github.com/test/sample"
+
+// TestGeneratedService renders the templates for testdata/sample and runs the tests of the module, e.g. the round
+// trips of the WebSocket client in svc/client/ws. The dependencies of the module have to be available.
+func TestGeneratedService(t *testing.T) {
+	if testing.Short() {
+		t.Skip("builds the generated service")
+	}
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("go not found")
+	}
+
+	dir := t.TempDir()
+	if err := copyDir(dir, filepath.Join("testdata", "sample")); err != nil {
+		t.Fatal(err)
+	}
+	p := proto.NewService()
+	if err := p.Parse(filepath.Join(dir, "sample.proto")); err != nil {
+		t.Fatal(err)
+	}
+	files, err := render(p.Definition(), generic.Config{GoPackage: module, PBPackage: module, CLI: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		name = filepath.Join(dir, name)
+		if err = os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err = os.WriteFile(name, content, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pkg, err := filepath.Abs(filepath.Join("..", "..", "pkg"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	run(t, dir, "mod", "edit", "-replace", "github.com/niiigoo/hawk/pkg="+pkg)
+	if out, err := command(dir, "mod", "download"); err != nil {
+		t.Skipf("dependencies not available: %s", out)
+	}
+	run(t, dir, "vet", "./...")
+	run(t, dir, "test", "-count=1", "./...")
+}
+
+// render applies the templates of the service like the generator including the command line client
+func render(def *proto.Definition, conf generic.Config) (map[string][]byte, error) {
+	data := generic.NewDefinitionData(def, conf)
+	files := make(map[string][]byte)
+	for _, tpl := range template.AssetNames() {
+		if parts := strings.Split(tpl, "."); len(parts) > 3 {
+			tpl = parts[0] + "." + strings.Join(parts[2:], ".")
+		}
+		name := strings.TrimSuffix(strings.ReplaceAll(tpl, "NAME", "sample"), ".tpl")
+		if _, ok := files[name]; ok {
+			continue
+		}
+
+		var r generic.Renderable
+		switch tpl {
+		case handlers.ServerHandlerPath:
+			var err error
+			if r, err = handlers.NewServices(def.Services, nil); err != nil {
+				return nil, err
+			}
+		case handlers.HookPath:
+			r = handlers.NewHook(nil)
+		case handlers.MiddlewaresPath:
+			r = handlers.NewMiddlewares()
+		}
+
+		var reader io.Reader
+		var err error
+		if r != nil {
+			reader, err = r.Render(data)
+		} else {
+			var asset []byte
+			if asset, err = template.Asset(tpl); err != nil {
+				return nil, err
+			}
+			reader, err = data.ApplyTemplate(string(asset), tpl)
+		}
+		if err != nil {
+			return nil, err
+		}
+		code, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, err
+		}
+		if code, err = generic.FixImports(code, data.GoImports()); err != nil {
+			return nil, &fs.PathError{Op: "imports", Path: name, Err: err}
+		}
+		if files[name], err = format.Source(code); err != nil {
+			return nil, &fs.PathError{Op: "format", Path: name, Err: err}
+		}
+	}
+	return files, nil
+}
+
+func copyDir(dst, src string) error {
+	return filepath.WalkDir(src, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(src, path)
+		name := filepath.Join(dst, rel)
+		if err = os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+			return err
+		}
+		return os.WriteFile(name, content, 0644)
+	})
+}
+
+func command(dir string, args ...string) (string, error) {
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+	out := new(bytes.Buffer)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	err := cmd.Run()
+	return out.String(), err
+}
+
+func run(t *testing.T, dir string, args ...string) {
+	if out, err := command(dir, args...); err != nil {
+		t.Fatalf("go %s: %v\n%s", strings.Join(args, " "), err, out)
+	}
+}
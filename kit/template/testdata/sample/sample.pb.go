@@ -0,0 +1,305 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        (unknown)
+// source: sample.proto
+
+package sample
+
+import (
+	_ "github.com/niiigoo/hawk/pkg/options"
+	common "github.com/test/sample/common"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Request struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	N             int32                  `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Request) Reset() {
+	*x = Request{}
+	mi := &file_sample_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request) ProtoMessage() {}
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	mi := &file_sample_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_sample_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Request) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Request) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+type Response struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	N             int32                  `protobuf:"varint,2,opt,name=n,proto3" json:"n,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	mi := &file_sample_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_sample_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_sample_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Response) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Response) GetN() int32 {
+	if x != nil {
+		return x.N
+	}
+	return 0
+}
+
+type ListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          *common.Page           `protobuf:"bytes,1,opt,name=page,proto3" json:"page,omitempty"`
+	Kind          common.Kind            `protobuf:"varint,2,opt,name=kind,proto3,enum=sample.common.Kind" json:"kind,omitempty"`
+	Limit         *int32                 `protobuf:"varint,3,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	Sort          *common.Kind           `protobuf:"varint,4,opt,name=sort,proto3,enum=sample.common.Kind,oneof" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRequest) Reset() {
+	*x = ListRequest{}
+	mi := &file_sample_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRequest) ProtoMessage() {}
+
+func (x *ListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sample_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRequest.ProtoReflect.Descriptor instead.
+func (*ListRequest) Descriptor() ([]byte, []int) {
+	return file_sample_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListRequest) GetPage() *common.Page {
+	if x != nil {
+		return x.Page
+	}
+	return nil
+}
+
+func (x *ListRequest) GetKind() common.Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return common.Kind(0)
+}
+
+func (x *ListRequest) GetLimit() int32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+func (x *ListRequest) GetSort() common.Kind {
+	if x != nil && x.Sort != nil {
+		return *x.Sort
+	}
+	return common.Kind(0)
+}
+
+var File_sample_proto protoreflect.FileDescriptor
+
+const file_sample_proto_rawDesc = "" +
+	"\n" +
+	"\fsample.proto\x12\x06sample\x1a'googleapis/google/api/annotations.proto\x1a\x12hawk/options.proto\x1a\x13common/common.proto\"'\n" +
+	"\aRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\f\n" +
+	"\x01n\x18\x02 \x01(\x05R\x01n\"(\n" +
+	"\bResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\f\n" +
+	"\x01n\x18\x02 \x01(\x05R\x01n\"\xbb\x01\n" +
+	"\vListRequest\x12'\n" +
+	"\x04page\x18\x01 \x01(\v2\x13.sample.common.PageR\x04page\x12'\n" +
+	"\x04kind\x18\x02 \x01(\x0e2\x13.sample.common.KindR\x04kind\x12\x19\n" +
+	"\x05limit\x18\x03 \x01(\x05H\x00R\x05limit\x88\x01\x01\x12,\n" +
+	"\x04sort\x18\x04 \x01(\x0e2\x13.sample.common.KindH\x01R\x04sort\x88\x01\x01B\b\n" +
+	"\x06_limitB\a\n" +
+	"\x05_sort2\x9b\x05\n" +
+	"\x06Sample\x12=\n" +
+	"\x03Get\x12\x0f.sample.Request\x1a\x10.sample.Response\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/items/{id}\x12@\n" +
+	"\x04List\x12\x13.sample.ListRequest\x1a\x13.sample.common.Item\"\x0e\x82\xd3\xe4\x93\x02\b\x12\x06/items\x12L\n" +
+	"\x04Find\x12\x13.sample.ListRequest\x1a\x13.sample.common.Item\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/pages/{page.size}\x12g\n" +
+	"\x06Search\x12\x13.sample.ListRequest\x1a\x13.sample.common.Item\"3\x82\xd3\xe4\x93\x02-Z\x16\x12\x14/search/kinds/{kind}\x12\x13/search/{page.size}\x12E\n" +
+	"\x05Count\x12\x13.sample.common.Page\x1a\x10.sample.Response\"\x15\x82\xd3\xe4\x93\x02\x0f\x12\r/count/{size}\x12)\n" +
+	"\x04Wait\x12\x0f.sample.Request\x1a\x10.sample.Response\x12G\n" +
+	"\x05Watch\x12\x0f.sample.Request\x1a\x10.sample.Response\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/items/{id}/watch0\x01\x12-\n" +
+	"\x06Upload\x12\x0f.sample.Request\x1a\x10.sample.Response(\x01\x12-\n" +
+	"\x04Chat\x12\x0f.sample.Request\x1a\x10.sample.Response(\x010\x01\x125\n" +
+	"\aUpdated\x12\x10.sample.Response\x1a\x10.sample.Response\"\x06\xc2\xf3\x18\x02\x18\x01\x1a\t\xc2\xf3\x18\x05\x1a\x03/wsB\n" +
+	"Z\b.;sampleb\x06proto3"
+
+var (
+	file_sample_proto_rawDescOnce sync.Once
+	file_sample_proto_rawDescData []byte
+)
+
+func file_sample_proto_rawDescGZIP() []byte {
+	file_sample_proto_rawDescOnce.Do(func() {
+		file_sample_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_sample_proto_rawDesc), len(file_sample_proto_rawDesc)))
+	})
+	return file_sample_proto_rawDescData
+}
+
+var file_sample_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_sample_proto_goTypes = []any{
+	(*Request)(nil),     // 0: sample.Request
+	(*Response)(nil),    // 1: sample.Response
+	(*ListRequest)(nil), // 2: sample.ListRequest
+	(*common.Page)(nil), // 3: sample.common.Page
+	(common.Kind)(0),    // 4: sample.common.Kind
+	(*common.Item)(nil), // 5: sample.common.Item
+}
+var file_sample_proto_depIdxs = []int32{
+	3,  // 0: sample.ListRequest.page:type_name -> sample.common.Page
+	4,  // 1: sample.ListRequest.kind:type_name -> sample.common.Kind
+	4,  // 2: sample.ListRequest.sort:type_name -> sample.common.Kind
+	0,  // 3: sample.Sample.Get:input_type -> sample.Request
+	2,  // 4: sample.Sample.List:input_type -> sample.ListRequest
+	2,  // 5: sample.Sample.Find:input_type -> sample.ListRequest
+	2,  // 6: sample.Sample.Search:input_type -> sample.ListRequest
+	3,  // 7: sample.Sample.Count:input_type -> sample.common.Page
+	0,  // 8: sample.Sample.Wait:input_type -> sample.Request
+	0,  // 9: sample.Sample.Watch:input_type -> sample.Request
+	0,  // 10: sample.Sample.Upload:input_type -> sample.Request
+	0,  // 11: sample.Sample.Chat:input_type -> sample.Request
+	1,  // 12: sample.Sample.Updated:input_type -> sample.Response
+	1,  // 13: sample.Sample.Get:output_type -> sample.Response
+	5,  // 14: sample.Sample.List:output_type -> sample.common.Item
+	5,  // 15: sample.Sample.Find:output_type -> sample.common.Item
+	5,  // 16: sample.Sample.Search:output_type -> sample.common.Item
+	1,  // 17: sample.Sample.Count:output_type -> sample.Response
+	1,  // 18: sample.Sample.Wait:output_type -> sample.Response
+	1,  // 19: sample.Sample.Watch:output_type -> sample.Response
+	1,  // 20: sample.Sample.Upload:output_type -> sample.Response
+	1,  // 21: sample.Sample.Chat:output_type -> sample.Response
+	1,  // 22: sample.Sample.Updated:output_type -> sample.Response
+	13, // [13:23] is the sub-list for method output_type
+	3,  // [3:13] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_sample_proto_init() }
+func file_sample_proto_init() {
+	if File_sample_proto != nil {
+		return
+	}
+	file_sample_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sample_proto_rawDesc), len(file_sample_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_sample_proto_goTypes,
+		DependencyIndexes: file_sample_proto_depIdxs,
+		MessageInfos:      file_sample_proto_msgTypes,
+	}.Build()
+	File_sample_proto = out.File
+	file_sample_proto_goTypes = nil
+	file_sample_proto_depIdxs = nil
+}
@@ -0,0 +1,455 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: sample.proto
+
+package sample
+
+import (
+	context "context"
+	common "github.com/test/sample/common"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Sample_Get_FullMethodName     = "/sample.Sample/Get"
+	Sample_List_FullMethodName    = "/sample.Sample/List"
+	Sample_Find_FullMethodName    = "/sample.Sample/Find"
+	Sample_Search_FullMethodName  = "/sample.Sample/Search"
+	Sample_Count_FullMethodName   = "/sample.Sample/Count"
+	Sample_Wait_FullMethodName    = "/sample.Sample/Wait"
+	Sample_Watch_FullMethodName   = "/sample.Sample/Watch"
+	Sample_Upload_FullMethodName  = "/sample.Sample/Upload"
+	Sample_Chat_FullMethodName    = "/sample.Sample/Chat"
+	Sample_Updated_FullMethodName = "/sample.Sample/Updated"
+)
+
+// SampleClient is the client API for Sample service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type SampleClient interface {
+	Get(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
+	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*common.Item, error)
+	Find(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*common.Item, error)
+	Search(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*common.Item, error)
+	Count(ctx context.Context, in *common.Page, opts ...grpc.CallOption) (*Response, error)
+	Wait(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error)
+	Watch(ctx context.Context, in *Request, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Response], error)
+	Upload(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Request, Response], error)
+	Chat(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[Request, Response], error)
+	Updated(ctx context.Context, in *Response, opts ...grpc.CallOption) (*Response, error)
+}
+
+type sampleClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewSampleClient(cc grpc.ClientConnInterface) SampleClient {
+	return &sampleClient{cc}
+}
+
+func (c *sampleClient) Get(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Sample_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sampleClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*common.Item, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Item)
+	err := c.cc.Invoke(ctx, Sample_List_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sampleClient) Find(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*common.Item, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Item)
+	err := c.cc.Invoke(ctx, Sample_Find_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sampleClient) Search(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*common.Item, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(common.Item)
+	err := c.cc.Invoke(ctx, Sample_Search_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sampleClient) Count(ctx context.Context, in *common.Page, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Sample_Count_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sampleClient) Wait(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Sample_Wait_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sampleClient) Watch(ctx context.Context, in *Request, opts ...grpc.CallOption) (grpc.ServerStreamingClient[Response], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Sample_ServiceDesc.Streams[0], Sample_Watch_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Request, Response]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Sample_WatchClient = grpc.ServerStreamingClient[Response]
+
+func (c *sampleClient) Upload(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[Request, Response], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Sample_ServiceDesc.Streams[1], Sample_Upload_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Request, Response]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Sample_UploadClient = grpc.ClientStreamingClient[Request, Response]
+
+func (c *sampleClient) Chat(ctx context.Context, opts ...grpc.CallOption) (grpc.BidiStreamingClient[Request, Response], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &Sample_ServiceDesc.Streams[2], Sample_Chat_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[Request, Response]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Sample_ChatClient = grpc.BidiStreamingClient[Request, Response]
+
+func (c *sampleClient) Updated(ctx context.Context, in *Response, opts ...grpc.CallOption) (*Response, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Response)
+	err := c.cc.Invoke(ctx, Sample_Updated_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SampleServer is the server API for Sample service.
+// All implementations must embed UnimplementedSampleServer
+// for forward compatibility.
+type SampleServer interface {
+	Get(context.Context, *Request) (*Response, error)
+	List(context.Context, *ListRequest) (*common.Item, error)
+	Find(context.Context, *ListRequest) (*common.Item, error)
+	Search(context.Context, *ListRequest) (*common.Item, error)
+	Count(context.Context, *common.Page) (*Response, error)
+	Wait(context.Context, *Request) (*Response, error)
+	Watch(*Request, grpc.ServerStreamingServer[Response]) error
+	Upload(grpc.ClientStreamingServer[Request, Response]) error
+	Chat(grpc.BidiStreamingServer[Request, Response]) error
+	Updated(context.Context, *Response) (*Response, error)
+	mustEmbedUnimplementedSampleServer()
+}
+
+// UnimplementedSampleServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedSampleServer struct{}
+
+func (UnimplementedSampleServer) Get(context.Context, *Request) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedSampleServer) List(context.Context, *ListRequest) (*common.Item, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedSampleServer) Find(context.Context, *ListRequest) (*common.Item, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Find not implemented")
+}
+func (UnimplementedSampleServer) Search(context.Context, *ListRequest) (*common.Item, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
+}
+func (UnimplementedSampleServer) Count(context.Context, *common.Page) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Count not implemented")
+}
+func (UnimplementedSampleServer) Wait(context.Context, *Request) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Wait not implemented")
+}
+func (UnimplementedSampleServer) Watch(*Request, grpc.ServerStreamingServer[Response]) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+func (UnimplementedSampleServer) Upload(grpc.ClientStreamingServer[Request, Response]) error {
+	return status.Errorf(codes.Unimplemented, "method Upload not implemented")
+}
+func (UnimplementedSampleServer) Chat(grpc.BidiStreamingServer[Request, Response]) error {
+	return status.Errorf(codes.Unimplemented, "method Chat not implemented")
+}
+func (UnimplementedSampleServer) Updated(context.Context, *Response) (*Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Updated not implemented")
+}
+func (UnimplementedSampleServer) mustEmbedUnimplementedSampleServer() {}
+func (UnimplementedSampleServer) testEmbeddedByValue()                {}
+
+// UnsafeSampleServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to SampleServer will
+// result in compilation errors.
+type UnsafeSampleServer interface {
+	mustEmbedUnimplementedSampleServer()
+}
+
+func RegisterSampleServer(s grpc.ServiceRegistrar, srv SampleServer) {
+	// If the following call pancis, it indicates UnimplementedSampleServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Sample_ServiceDesc, srv)
+}
+
+func _Sample_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SampleServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sample_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SampleServer).Get(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sample_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SampleServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sample_List_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SampleServer).List(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sample_Find_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SampleServer).Find(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sample_Find_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SampleServer).Find(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sample_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SampleServer).Search(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sample_Search_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SampleServer).Search(ctx, req.(*ListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sample_Count_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.Page)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SampleServer).Count(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sample_Count_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SampleServer).Count(ctx, req.(*common.Page))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sample_Wait_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SampleServer).Wait(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sample_Wait_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SampleServer).Wait(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Sample_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Request)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SampleServer).Watch(m, &grpc.GenericServerStream[Request, Response]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Sample_WatchServer = grpc.ServerStreamingServer[Response]
+
+func _Sample_Upload_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SampleServer).Upload(&grpc.GenericServerStream[Request, Response]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Sample_UploadServer = grpc.ClientStreamingServer[Request, Response]
+
+func _Sample_Chat_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(SampleServer).Chat(&grpc.GenericServerStream[Request, Response]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type Sample_ChatServer = grpc.BidiStreamingServer[Request, Response]
+
+func _Sample_Updated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Response)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SampleServer).Updated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Sample_Updated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SampleServer).Updated(ctx, req.(*Response))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Sample_ServiceDesc is the grpc.ServiceDesc for Sample service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Sample_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sample.Sample",
+	HandlerType: (*SampleServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Sample_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _Sample_List_Handler,
+		},
+		{
+			MethodName: "Find",
+			Handler:    _Sample_Find_Handler,
+		},
+		{
+			MethodName: "Search",
+			Handler:    _Sample_Search_Handler,
+		},
+		{
+			MethodName: "Count",
+			Handler:    _Sample_Count_Handler,
+		},
+		{
+			MethodName: "Wait",
+			Handler:    _Sample_Wait_Handler,
+		},
+		{
+			MethodName: "Updated",
+			Handler:    _Sample_Updated_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _Sample_Watch_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Upload",
+			Handler:       _Sample_Upload_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "Chat",
+			Handler:       _Sample_Chat_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "sample.proto",
+}
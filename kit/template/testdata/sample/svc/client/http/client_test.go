@@ -0,0 +1,289 @@
+package http_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/test/sample"
+	"github.com/test/sample/common"
+	"github.com/test/sample/svc"
+	httpclient "github.com/test/sample/svc/client/http"
+	"github.com/test/sample/svc/server"
+)
+
+// service echoes the requests, the types of List and Count are part of the Go package of common/common.proto.
+// Watch streams N responses and fails afterward if the id is `fail`.
+type service struct {
+	pb.UnimplementedSampleServer
+}
+
+func (s *service) Get(_ context.Context, in *pb.Request) (*pb.Response, error) {
+	return &pb.Response{Id: in.Id, N: in.N}, nil
+}
+
+func (s *service) List(_ context.Context, in *pb.ListRequest) (*common.Item, error) {
+	if in.Limit != nil {
+		return &common.Item{Id: fmt.Sprint(*in.Limit), Kind: in.GetSort()}, nil
+	}
+	if in.Page == nil {
+		return &common.Item{}, nil
+	}
+	return &common.Item{Id: in.GetPage().String(), Kind: in.Kind}, nil
+}
+
+func (s *service) Watch(in *pb.Request, stream pb.Sample_WatchServer) error {
+	for i := int32(0); i < in.N; i++ {
+		if err := stream.Send(&pb.Response{Id: in.Id, N: i}); err != nil {
+			return err
+		}
+	}
+	if in.Id == "fail" {
+		return errors.New("watch failed")
+	}
+	return nil
+}
+
+func (s *service) Find(_ context.Context, in *pb.ListRequest) (*common.Item, error) {
+	return &common.Item{Id: fmt.Sprint(in.GetPage().GetSize())}, nil
+}
+
+func (s *service) Search(_ context.Context, in *pb.ListRequest) (*common.Item, error) {
+	return &common.Item{Id: fmt.Sprint(in.GetPage().GetSize()), Kind: in.Kind}, nil
+}
+
+func (s *service) Count(_ context.Context, in *common.Page) (*pb.Response, error) {
+	return &pb.Response{N: in.Size, Id: in.Kind.String()}, nil
+}
+
+// start serves the HTTP transport and returns its URL
+func start(t *testing.T) string {
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	handler := svc.MakeHTTPHandler(logrus.NewEntry(log), server.NewEndpoints(&service{}), nil, svc.WebSocketConfig{})
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return srv.URL
+}
+
+func serve(t *testing.T) pb.SampleServer {
+	client, err := httpclient.New(start(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+// watch streams the responses of Watch without the generated client
+func watch(t *testing.T, accept, query string) (*http.Response, string) {
+	req, err := http.NewRequest(http.MethodGet, start(t)+"/items/"+query, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Accept", accept)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, string(body)
+}
+
+func TestUnary(t *testing.T) {
+	client := serve(t)
+
+	resp, err := client.Get(context.Background(), &pb.Request{Id: "a", N: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Id != "a" || resp.N != 1 {
+		t.Errorf("unexpected response %v", resp)
+	}
+}
+
+func TestImportedResponse(t *testing.T) {
+	client := serve(t)
+
+	page := &common.Page{Size: 10, Kind: common.Kind_KIND_ITEM}
+	resp, err := client.List(context.Background(), &pb.ListRequest{Page: page, Kind: common.Kind_KIND_ITEM})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Id != page.String() || resp.Kind != common.Kind_KIND_ITEM {
+		t.Errorf("unexpected response %v", resp)
+	}
+}
+
+func TestOptional(t *testing.T) {
+	client := serve(t)
+
+	limit, sort := int32(0), common.Kind_KIND_ITEM
+	resp, err := client.List(context.Background(), &pb.ListRequest{Limit: &limit, Sort: &sort})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Id != "0" || resp.Kind != common.Kind_KIND_ITEM {
+		t.Errorf("unexpected response %v", resp)
+	}
+
+	// unset fields are omitted, they stay nil
+	resp, err = client.List(context.Background(), &pb.ListRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Id != "" {
+		t.Errorf("unexpected response %v", resp)
+	}
+}
+
+func TestImportedRequest(t *testing.T) {
+	client := serve(t)
+
+	resp, err := client.Count(context.Background(), &common.Page{Size: 3, Kind: common.Kind_KIND_ITEM})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.N != 3 || resp.Id != "KIND_ITEM" {
+		t.Errorf("unexpected response %v", resp)
+	}
+}
+
+func TestNestedPath(t *testing.T) {
+	client := serve(t)
+
+	resp, err := client.Find(context.Background(), &pb.ListRequest{Page: &common.Page{Size: 5}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Id != "5" {
+		t.Errorf("unexpected response %v", resp)
+	}
+
+	// the path cannot be built without the parent of the path variable
+	_, err = client.Find(context.Background(), &pb.ListRequest{})
+	if err == nil || !strings.Contains(err.Error(), "req.Page is required") {
+		t.Errorf("expected the missing parent to be reported, got %v", err)
+	}
+}
+
+func TestBindingChoice(t *testing.T) {
+	client := serve(t)
+
+	resp, err := client.Search(context.Background(), &pb.ListRequest{Kind: common.Kind_KIND_ITEM})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Id != "0" || resp.Kind != common.Kind_KIND_ITEM {
+		t.Errorf("unexpected response %v", resp)
+	}
+
+	// every binding of Search has path fields, none of them is populated
+	_, err = client.Search(context.Background(), &pb.ListRequest{})
+	if err == nil || !strings.Contains(err.Error(), "no binding of Search matches the request") {
+		t.Errorf("expected no binding to match, got %v", err)
+	}
+}
+
+func TestServerStream(t *testing.T) {
+	client, err := httpclient.NewStreamClient(start(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stream, err := client.Watch(context.Background(), &pb.Request{Id: "a", N: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Close()
+	n := int32(0)
+	for ; stream.Next(); n++ {
+		if msg := stream.Msg(); msg.Id != "a" || msg.N != n {
+			t.Errorf("expected response %d, got %v", n, msg)
+		}
+	}
+	if err = stream.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("expected 3 responses, got %d", n)
+	}
+}
+
+func TestServerStreamError(t *testing.T) {
+	client, err := httpclient.NewStreamClient(start(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stream, err := client.Watch(context.Background(), &pb.Request{Id: "fail", N: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stream.Close()
+	if !stream.Next() || stream.Msg().Id != "fail" {
+		t.Fatalf("expected a response before the error, got %v", stream.Err())
+	}
+	if stream.Next() || stream.Err() == nil || !strings.Contains(stream.Err().Error(), "watch failed") {
+		t.Errorf("expected the error of the stream, got %v", stream.Err())
+	}
+}
+
+func TestServerStreamNDJSON(t *testing.T) {
+	resp, body := watch(t, "application/x-ndjson", "a/watch?n=2")
+
+	if ct := resp.Header.Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Errorf("expected newline-delimited JSON, got %q", ct)
+	}
+	lines := strings.Split(strings.TrimSuffix(body, "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 lines, got %q", body)
+	}
+	for i, line := range lines {
+		result, ok := strings.CutPrefix(line, `{"result":`)
+		var msg pb.Response
+		if !ok || protojson.Unmarshal([]byte(strings.TrimSuffix(result, "}")), &msg) != nil || msg.N != int32(i) {
+			t.Errorf("unexpected line %q", line)
+		}
+	}
+
+	_, body = watch(t, "application/x-ndjson", "fail/watch?n=1")
+	if !strings.HasSuffix(body, `{"error":"watch failed"}`+"\n") {
+		t.Errorf("expected the error as last line, got %q", body)
+	}
+}
+
+func TestServerStreamSSE(t *testing.T) {
+	resp, body := watch(t, "text/event-stream", "a/watch?n=2")
+
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("expected server-sent events, got %q", ct)
+	}
+	events := strings.Split(strings.TrimSuffix(body, "\n\n"), "\n\n")
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, got %q", body)
+	}
+	for i, event := range events {
+		data, ok := strings.CutPrefix(event, "data: ")
+		var msg pb.Response
+		if !ok || protojson.Unmarshal([]byte(data), &msg) != nil || msg.N != int32(i) {
+			t.Errorf("unexpected event %q", event)
+		}
+	}
+
+	_, body = watch(t, "text/event-stream", "fail/watch?n=1")
+	if !strings.HasSuffix(body, "event: error\ndata: {\"error\":\"watch failed\"}\n\n") {
+		t.Errorf("expected the error as last event, got %q", body)
+	}
+}
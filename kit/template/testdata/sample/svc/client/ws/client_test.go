@@ -0,0 +1,279 @@
+package ws_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+
+	pb "github.com/test/sample"
+	"github.com/test/sample/svc"
+	"github.com/test/sample/svc/client/ws"
+	"github.com/test/sample/svc/server"
+)
+
+// service echoes the requests, Wait and Watch of a negative number block until the request is canceled
+type service struct {
+	pb.UnimplementedSampleServer
+	canceled chan struct{}
+}
+
+func (s *service) Get(_ context.Context, in *pb.Request) (*pb.Response, error) {
+	return &pb.Response{Id: in.Id, N: in.N}, nil
+}
+
+func (s *service) Wait(ctx context.Context, _ *pb.Request) (*pb.Response, error) {
+	<-ctx.Done()
+	close(s.canceled)
+	return nil, ctx.Err()
+}
+
+func (s *service) Watch(in *pb.Request, stream pb.Sample_WatchServer) error {
+	if in.N < 0 {
+		<-stream.Context().Done()
+		return nil
+	}
+	for i := int32(0); i < in.N; i++ {
+		if err := stream.Send(&pb.Response{Id: in.Id, N: i}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *service) Upload(stream pb.Sample_UploadServer) error {
+	resp := &pb.Response{}
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(resp)
+		} else if err != nil {
+			return err
+		}
+		resp.Id += in.Id
+		resp.N += in.N
+	}
+}
+
+func (s *service) Chat(stream pb.Sample_ChatServer) error {
+	for {
+		in, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		if err = stream.Send(&pb.Response{Id: in.Id, N: in.N * 2}); err != nil {
+			return err
+		}
+	}
+}
+
+// serve starts the WebSocket pool with a single worker, the requests are executed one by one
+func serve(t *testing.T) (*service, string) {
+	impl := &service{canceled: make(chan struct{})}
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	pool := svc.NewPool(logrus.NewEntry(log), server.NewEndpoints(impl), svc.WebSocketConfig{Workers: 1})
+	srv := httptest.NewServer(pool)
+	t.Cleanup(srv.Close)
+	return impl, "ws" + strings.TrimPrefix(srv.URL, "http")
+}
+
+func dial(t *testing.T, url string) *ws.Conn {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := ws.Dial(ctx, url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+// raw exchanges a message with the server without the generated client
+func raw(t *testing.T, url string, messages ...svc.Message) svc.Message {
+	conn, _, err := websocket.DefaultDialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	for _, msg := range messages {
+		if err = conn.WriteJSON(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+	var reply svc.Message
+	for reply.Status == 0 {
+		_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		if err = conn.ReadJSON(&reply); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return reply
+}
+
+func TestUnary(t *testing.T) {
+	_, url := serve(t)
+	client := ws.New(dial(t, url))
+
+	resp, err := client.Get(context.Background(), &pb.Request{Id: "a", N: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Id != "a" || resp.N != 1 {
+		t.Errorf("unexpected response %v", resp)
+	}
+}
+
+func TestServerStream(t *testing.T) {
+	_, url := serve(t)
+	client := ws.NewStreamClient(dial(t, url))
+
+	stream, err := client.Watch(context.Background(), &pb.Request{Id: "a", N: 3})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := int32(0); i < 3; i++ {
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.N != i {
+			t.Errorf("expected response %d, got %v", i, resp)
+		}
+	}
+	if _, err = stream.Recv(); err != io.EOF {
+		t.Errorf("expected end of the stream, got %v", err)
+	}
+}
+
+func TestClientStream(t *testing.T) {
+	_, url := serve(t)
+	client := ws.NewStreamClient(dial(t, url))
+
+	stream, err := client.Upload(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []string{"a", "b", "c"} {
+		if err = stream.Send(&pb.Request{Id: id, N: 1}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	resp, err := stream.CloseAndRecv()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Id != "abc" || resp.N != 3 {
+		t.Errorf("unexpected response %v", resp)
+	}
+}
+
+func TestBidiStream(t *testing.T) {
+	_, url := serve(t)
+	client := ws.NewStreamClient(dial(t, url))
+
+	stream, err := client.Chat(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := int32(1); i <= 3; i++ {
+		if err = stream.Send(&pb.Request{N: i}); err != nil {
+			t.Fatal(err)
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.N != i*2 {
+			t.Errorf("expected response %d, got %v", i*2, resp)
+		}
+	}
+	if err = stream.CloseSend(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = stream.Recv(); err != io.EOF {
+		t.Errorf("expected end of the stream, got %v", err)
+	}
+}
+
+func TestCancel(t *testing.T) {
+	impl, url := serve(t)
+	client := ws.New(dial(t, url))
+
+	// the only worker is busy, the cancellation is read regardless
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := client.Wait(ctx, &pb.Request{}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	select {
+	case <-impl.canceled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("request not canceled on the server")
+	}
+
+	if _, err := client.Get(context.Background(), &pb.Request{Id: "a"}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUnknownMethod(t *testing.T) {
+	_, url := serve(t)
+
+	reply := raw(t, url, svc.Message{Method: "Missing", RequestID: "1", Data: json.RawMessage(`{}`)})
+	if reply.RequestID != "1" || reply.Status != http.StatusNotFound {
+		t.Errorf("expected status 404, got %+v", reply)
+	}
+}
+
+func TestServerStreamRequest(t *testing.T) {
+	_, url := serve(t)
+
+	// the stream stays open, the second request is rejected
+	reply := raw(t, url,
+		svc.Message{Method: "Watch", RequestID: "1", Data: json.RawMessage(`{"n":-1}`)},
+		svc.Message{Method: "Watch", RequestID: "1", Data: json.RawMessage(`{"n":1}`)},
+	)
+	if reply.RequestID != "1" || reply.Command != svc.CommandEnd || reply.Status != http.StatusBadRequest {
+		t.Errorf("expected end of the stream with status 400, got %+v", reply)
+	}
+}
+
+func TestEvent(t *testing.T) {
+	_, url := serve(t)
+	conn := dial(t, url)
+	client := ws.New(conn)
+
+	events := make(chan *pb.Response, 1)
+	client.OnUpdated(func(topic string, event *pb.Response) {
+		if topic == "items" {
+			events <- event
+		}
+	})
+	if err := conn.Subscribe(context.Background(), "items"); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := svc.Events.Updated(context.Background(), svc.ToTopic("items"), &pb.Response{Id: "a"})
+	if err != nil || n != 1 {
+		t.Fatalf("expected the event to be sent to 1 client, got %d (%v)", n, err)
+	}
+	select {
+	case event := <-events:
+		if event.Id != "a" {
+			t.Errorf("unexpected event %v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("event not received")
+	}
+}
@@ -0,0 +1,540 @@
+package ts
+
+const typescriptTemplate = `// Code generated by hawk. DO NOT EDIT.
+// Rerunning hawk will overwrite this file.
+{{- if .Package}}
+// Package: {{.Package}}
+{{- end}}
+{{- range .Enums}}
+
+{{Doc "" .Description}}export type {{.Name}} = {{if .Values}}{{range $i, $v := .Values}}{{if $i}} | {{end}}{{$v}}{{end}}{{else}}never{{end}};
+{{- end}}
+{{- range .Messages}}
+
+{{Doc "" .Description}}export interface {{.Name}} {
+{{- range .Fields}}
+{{Doc "  " .Description}}  {{.Name}}?: {{.Type}};
+{{- end}}
+}
+{{- end}}
+{{- if or .HTTP .WebSocket}}
+
+/** Options of a single call */
+export interface CallOptions {
+  /** aborts the call, the server cancels the request */
+  signal?: AbortSignal;
+  /** headers of the HTTP request, ignored by the WebSocket transport */
+  headers?: Record<string, string>;
+}
+
+/**
+ * ApiError is thrown if the server responds with an error, it keeps the error id and the reasons written by
+ * the package pkg/exception. The status is 0 if the WebSocket connection has been closed or lost.
+ */
+export class ApiError extends Error {
+  constructor(
+    readonly status: number,
+    message: string,
+    readonly errorId?: string,
+    readonly reasons?: Record<string, string>,
+  ) {
+    super(message);
+    this.name = "ApiError";
+  }
+}
+
+/** toApiError decodes the error body {"error": "message"} or {"error": {"message", "error_id", "reasons"}} */
+function toApiError(status: number, body: unknown, fallback: string): ApiError {
+  const error = typeof body === "object" && body !== null ? (body as { error?: unknown }).error : undefined;
+  if (typeof error === "string") {
+    return new ApiError(status, error);
+  }
+  if (typeof error === "object" && error !== null) {
+    const e = error as { message?: string; error_id?: string; reasons?: Record<string, string> };
+    return new ApiError(status, e.message || fallback, e.error_id, e.reasons);
+  }
+  return new ApiError(status, fallback);
+}
+{{- end}}
+{{- if .HTTP}}
+
+/** Options of the HTTP clients */
+export interface HttpOptions {
+  /** headers sent with every request, e.g. the authorization */
+  headers?: Record<string, string> | (() => Record<string, string> | Promise<Record<string, string>>);
+  /** implementation of fetch, the global one by default */
+  fetch?: typeof fetch;
+}
+
+/** HttpTransport sends the requests of the HTTP clients, the paths are appended to the base URL */
+export class HttpTransport {
+  private readonly baseUrl: string;
+
+  constructor(baseUrl: string, private readonly options: HttpOptions = {}) {
+    this.baseUrl = baseUrl.replace(/\/+$/, "");
+  }
+
+  /** unary sends the request and decodes the response, the body is assigned to the field responseBody if given */
+  async unary<T>(method: string, path: string, query: URLSearchParams, body: unknown, responseBody: string, options?: CallOptions): Promise<T> {
+    const response = await this.fetch(method, path, query, body, "application/json", options);
+    const data: unknown = await response.json();
+    return (responseBody ? { [responseBody]: data } : data) as T;
+  }
+
+  /** stream sends the request and yields the responses of the newline-delimited JSON stream */
+  async *stream<T>(method: string, path: string, query: URLSearchParams, body: unknown, options?: CallOptions): AsyncGenerator<T, void, undefined> {
+    const response = await this.fetch(method, path, query, body, "application/x-ndjson", options);
+    if (!response.body) {
+      return;
+    }
+    const reader = response.body.getReader();
+    const decoder = new TextDecoder();
+    let buffer = "";
+    try {
+      for (;;) {
+        const { done, value } = await reader.read();
+        buffer += decoder.decode(value, { stream: !done });
+        const lines = buffer.split("\n");
+        buffer = done ? "" : lines.pop() ?? "";
+        for (const line of lines) {
+          if (line.trim() === "") {
+            continue;
+          }
+          const item = JSON.parse(line) as { result?: T; error?: string };
+          if (item.error !== undefined) {
+            throw new ApiError(500, item.error);
+          }
+          yield (item.result ?? {}) as T;
+        }
+        if (done) {
+          return;
+        }
+      }
+    } finally {
+      reader.cancel().catch(() => undefined);
+    }
+  }
+
+  private async fetch(method: string, path: string, query: URLSearchParams, body: unknown, accept: string, options: CallOptions = {}) {
+    const defaults = typeof this.options.headers === "function" ? await this.options.headers() : this.options.headers;
+    const headers: Record<string, string> = { ...defaults, ...options.headers, Accept: accept };
+    if (body !== undefined) {
+      headers["Content-Type"] = "application/json";
+    }
+    const search = query.toString();
+    const response = await (this.options.fetch ?? fetch)(this.baseUrl + path + (search ? "?" + search : ""), {
+      method,
+      headers,
+      body: body === undefined ? undefined : JSON.stringify(body),
+      signal: options.signal,
+    });
+    if (!response.ok) {
+      const text = await response.text();
+      let data: unknown;
+      try {
+        data = JSON.parse(text);
+      } catch {
+        data = undefined;
+      }
+      throw toApiError(response.status, data, text.trim() || response.statusText);
+    }
+    return response;
+  }
+}
+
+/** query builds the query of the parameters: repeated values are added once per item, maps as name[key] */
+function query(...params: [string, unknown, ("json" | "map")?][]): URLSearchParams {
+  const search = new URLSearchParams();
+  for (const [name, value, format] of params) {
+    if (value === undefined || value === null) {
+      continue;
+    }
+    if (format === "json") {
+      search.append(name, JSON.stringify(value));
+    } else if (format === "map") {
+      for (const [key, v] of Object.entries(value as Record<string, string>)) {
+        search.append(name + "[" + key + "]", v);
+      }
+    } else {
+      for (const v of Array.isArray(value) ? value : [value]) {
+        search.append(name, String(v));
+      }
+    }
+  }
+  return search;
+}
+{{- end}}
+{{- if .WebSocket}}
+
+/** WebSocketMessage is a frame of the WebSocket transport using the subprotocol hawk.json */
+export interface WebSocketMessage {
+  method?: string;
+  data?: unknown;
+  command?: "end" | "cancel" | "subscribe" | "unsubscribe" | "event";
+  request_id?: string;
+  status?: number;
+  topic?: string;
+}
+
+/** WebSocketHandler receives the messages of a request, message is undefined if the request failed locally */
+export type WebSocketHandler = (message: WebSocketMessage | undefined, error?: unknown) => void;
+
+/** Options of a WebSocketConnection */
+export interface WebSocketOptions {
+  /** implementation of WebSocket, the global one by default */
+  WebSocket?: typeof WebSocket;
+  /** delay of the first reconnect in milliseconds, doubled for each failed attempt (default 500) */
+  minBackoff?: number;
+  /** maximum delay of the reconnects in milliseconds (default 30000) */
+  maxBackoff?: number;
+  /** receives the errors not returned to a caller, e.g. invalid messages */
+  onError?: (error: unknown) => void;
+}
+
+/**
+ * WebSocketConnection is a connection to the WebSocket endpoint of a service, e.g. ws://localhost:5050/ws.
+ * A lost connection is reestablished with exponential backoff: running calls and streams fail, new calls
+ * wait for the connection and the topics are subscribed again.
+ */
+export class WebSocketConnection {
+  private socket?: InstanceType<typeof WebSocket>;
+  private ready!: Promise<InstanceType<typeof WebSocket>>;
+  private resolveReady!: (socket: InstanceType<typeof WebSocket>) => void;
+  private rejectReady!: (error: unknown) => void;
+  private closed = false;
+  private attempts = 0;
+  private lastId = 0;
+  private readonly handlers = new Map<string, WebSocketHandler>();
+  private readonly listeners = new Map<string, Set<(payload: unknown, topic: string) => void>>();
+  private readonly topics = new Set<string>();
+
+  constructor(private readonly url: string, private readonly options: WebSocketOptions = {}) {
+    this.waitForConnection();
+    this.connect();
+  }
+
+  /** send sends the message once the connection is established */
+  async send(message: WebSocketMessage): Promise<void> {
+    const socket = await this.ready;
+    if (this.closed) {
+      throw new ApiError(0, "connection closed");
+    }
+    socket.send(JSON.stringify(message));
+  }
+
+  /** call sends the request and resolves with the response, aborting the signal cancels the request */
+  call<T>(method: string, request: unknown, options: CallOptions = {}): Promise<T> {
+    return this.request({ method, data: request }, options.signal) as Promise<T>;
+  }
+
+  /** stream opens a stream of the method, the request is required for server streams */
+  stream<Req, Resp>(method: string, request?: Req): WebSocketStream<Req, Resp> {
+    const id = this.nextId();
+    const stream = new WebSocketStream<Req, Resp>(id, (message) => this.send(message), (handler) => {
+      this.handlers.set(id, handler);
+      return () => this.handlers.delete(id);
+    });
+    this.send({ method, data: request, request_id: id }).catch((error) => this.handlers.get(id)?.(undefined, error));
+    return stream;
+  }
+
+  /** on registers a listener of the event, the returned function removes it */
+  on<T>(event: string, listener: (payload: T, topic: string) => void): () => void {
+    let listeners = this.listeners.get(event);
+    if (!listeners) {
+      listeners = new Set();
+      this.listeners.set(event, listeners);
+    }
+    const l = listener as (payload: unknown, topic: string) => void;
+    listeners.add(l);
+    return () => {
+      listeners?.delete(l);
+    };
+  }
+
+  /** subscribe subscribes the connection to the events of the topic, the topic is subscribed again after reconnecting */
+  async subscribe(topic: string, options: CallOptions = {}): Promise<void> {
+    await this.request({ command: "subscribe", topic }, options.signal);
+    this.topics.add(topic);
+  }
+
+  /** unsubscribe unsubscribes the connection from the events of the topic */
+  async unsubscribe(topic: string, options: CallOptions = {}): Promise<void> {
+    this.topics.delete(topic);
+    await this.request({ command: "unsubscribe", topic }, options.signal);
+  }
+
+  /** close closes the connection, running and further calls fail */
+  close(): void {
+    this.closed = true;
+    this.rejectReady(new ApiError(0, "connection closed"));
+    this.socket?.close();
+  }
+
+  private request(message: WebSocketMessage, signal?: AbortSignal): Promise<unknown> {
+    const id = this.nextId();
+    return new Promise((resolve, reject) => {
+      if (signal?.aborted) {
+        reject(signal.reason);
+        return;
+      }
+      const abort = () => {
+        this.handlers.delete(id);
+        this.send({ request_id: id, command: "cancel" }).catch(() => undefined);
+        reject(signal?.reason);
+      };
+      signal?.addEventListener("abort", abort, { once: true });
+      this.handlers.set(id, (response, error) => {
+        this.handlers.delete(id);
+        signal?.removeEventListener("abort", abort);
+        const status = response?.status ?? 0;
+        if (response === undefined) {
+          reject(error);
+        } else if (status < 200 || status >= 300) {
+          reject(toApiError(status, response.data, "status " + status));
+        } else {
+          resolve(response.data ?? {});
+        }
+      });
+      this.send({ ...message, request_id: id }).catch((error) => this.handlers.get(id)?.(undefined, error));
+    });
+  }
+
+  private nextId(): string {
+    this.lastId++;
+    return String(this.lastId);
+  }
+
+  private waitForConnection(): void {
+    this.ready = new Promise((resolve, reject) => {
+      this.resolveReady = resolve;
+      this.rejectReady = reject;
+    });
+    this.ready.catch(() => undefined);
+  }
+
+  private connect(): void {
+    const socket = new (this.options.WebSocket ?? WebSocket)(this.url, "hawk.json");
+    let open = false;
+    this.socket = socket;
+    socket.onopen = () => {
+      open = true;
+      this.attempts = 0;
+      this.resolveReady(socket);
+      for (const topic of this.topics) {
+        this.request({ command: "subscribe", topic }).catch((error) => this.options.onError?.(error));
+      }
+    };
+    socket.onmessage = (event) => this.dispatch(event.data);
+    // some implementations fire no close event if the connection could not be established
+    let lost = false;
+    socket.onerror = socket.onclose = () => {
+      if (lost) {
+        return;
+      }
+      lost = true;
+      if (open) {
+        if (!this.closed) {
+          this.waitForConnection();
+        }
+        this.fail(new ApiError(0, this.closed ? "connection closed" : "connection lost"));
+      }
+      if (!this.closed) {
+        const delay = Math.min((this.options.minBackoff ?? 500) * 2 ** this.attempts, this.options.maxBackoff ?? 30000);
+        this.attempts++;
+        setTimeout(() => this.connect(), delay);
+      }
+    };
+  }
+
+  private dispatch(data: unknown): void {
+    let message: WebSocketMessage;
+    try {
+      message = JSON.parse(String(data)) as WebSocketMessage;
+    } catch (error) {
+      this.options.onError?.(error);
+      return;
+    }
+    if (message.command === "event") {
+      for (const listener of this.listeners.get(message.method ?? "") ?? []) {
+        try {
+          listener(message.data ?? {}, message.topic ?? "");
+        } catch (error) {
+          this.options.onError?.(error);
+        }
+      }
+      return;
+    }
+    this.handlers.get(message.request_id ?? "")?.(message);
+  }
+
+  private fail(error: unknown): void {
+    const handlers = [...this.handlers.values()];
+    this.handlers.clear();
+    for (const handler of handlers) {
+      handler(undefined, error);
+    }
+  }
+}
+
+/** WebSocketStream is a stream of a WebSocketConnection, the responses are read by recv or for await */
+export class WebSocketStream<Req, Resp> implements AsyncIterable<Resp> {
+  private readonly responses: Resp[] = [];
+  private readonly release: () => void;
+  private finished = false;
+  private error: unknown;
+  private wake?: () => void;
+
+  constructor(
+    readonly id: string,
+    private readonly post: (message: WebSocketMessage) => Promise<void>,
+    register: (handler: WebSocketHandler) => () => void,
+  ) {
+    this.release = register((message, error) => this.receive(message, error));
+  }
+
+  /** send sends a request of a client or bidirectional stream */
+  send(request: Req): Promise<void> {
+    return this.post({ request_id: this.id, data: request });
+  }
+
+  /** end ends the requests of a client or bidirectional stream */
+  end(): Promise<void> {
+    return this.post({ request_id: this.id, command: "end" });
+  }
+
+  /** cancel cancels the stream, recv returns undefined afterwards */
+  cancel(): void {
+    if (this.finished) {
+      return;
+    }
+    this.post({ request_id: this.id, command: "cancel" }).catch(() => undefined);
+    this.finish();
+  }
+
+  /** recv resolves with the next response, undefined at the end of the stream. Errors are thrown as ApiError. */
+  async recv(): Promise<Resp | undefined> {
+    while (this.responses.length === 0 && !this.finished) {
+      await new Promise<void>((resolve) => (this.wake = resolve));
+    }
+    if (this.responses.length > 0) {
+      return this.responses.shift();
+    }
+    if (this.error !== undefined) {
+      throw this.error;
+    }
+    return undefined;
+  }
+
+  async *[Symbol.asyncIterator](): AsyncGenerator<Resp, void, undefined> {
+    for (;;) {
+      const response = await this.recv();
+      if (response === undefined) {
+        return;
+      }
+      yield response;
+    }
+  }
+
+  private receive(message: WebSocketMessage | undefined, error?: unknown): void {
+    const status = message?.status ?? 0;
+    if (message === undefined) {
+      this.finish(error);
+    } else if (status < 200 || status >= 300) {
+      this.finish(toApiError(status, message.data, "status " + status));
+    } else if (message.command === "end") {
+      this.finish();
+    } else {
+      this.responses.push((message.data ?? {}) as Resp);
+      this.notify();
+    }
+  }
+
+  private finish(error?: unknown): void {
+    if (this.finished) {
+      return;
+    }
+    this.finished = true;
+    this.error = error;
+    this.release();
+    this.notify();
+  }
+
+  private notify(): void {
+    const wake = this.wake;
+    this.wake = undefined;
+    wake?.();
+  }
+}
+{{- end}}
+{{- range .Services}}
+{{- if .HTTPMethods}}
+
+/** {{.Name}}HttpClient calls the methods of {{.Name}} via HTTP */
+export class {{.Name}}HttpClient {
+  private readonly transport: HttpTransport;
+
+  /** baseUrl is the URL of the server including a path the service is served under, e.g. http://localhost:5050 */
+  constructor(baseUrl: string, options?: HttpOptions) {
+    this.transport = new HttpTransport(baseUrl, options);
+  }
+{{- range .HTTPMethods}}
+
+{{Doc "  " .Description}}  {{.Name}}(req: {{.Request}}, options?: CallOptions): {{if .Stream}}AsyncGenerator<{{.Response}}, void, undefined>{{else}}Promise<{{.Response}}>{{end}} {
+{{- range .Bindings}}
+{{- if .Condition}}
+    if ({{.Condition}}) {
+      {{template "call" .}}
+    }
+{{- else}}
+    {{template "call" .}}
+{{- end}}
+{{- end}}
+{{- if .NoMatch}}
+{{- if .Stream}}
+    throw new Error({{Quote .NoMatch}});
+{{- else}}
+    return Promise.reject(new Error({{Quote .NoMatch}}));
+{{- end}}
+{{- end}}
+  }
+{{- end}}
+}
+{{- end}}
+{{- if .WSPath}}
+
+/** {{.Name}}WebSocketClient calls the methods of {{.Name}} via a WebSocketConnection to the path {{.WSPath}} */
+export class {{.Name}}WebSocketClient {
+  /** path of the WebSocket endpoint of the service */
+  static readonly path = {{Quote .WSPath}};
+
+  constructor(readonly connection: WebSocketConnection) {}
+{{- range .WSMethods}}
+
+{{Doc "  " .Description}}
+{{- if .RequestStream}}  {{.Name}}(): WebSocketStream<{{.Request}}, {{.Response}}> {
+    return this.connection.stream<{{.Request}}, {{.Response}}>({{Quote .Method}});
+  }
+{{- else if .ResponseStream}}  {{.Name}}(req: {{.Request}}): WebSocketStream<{{.Request}}, {{.Response}}> {
+    return this.connection.stream<{{.Request}}, {{.Response}}>({{Quote .Method}}, req);
+  }
+{{- else}}  {{.Name}}(req: {{.Request}}, options?: CallOptions): Promise<{{.Response}}> {
+    return this.connection.call<{{.Response}}>({{Quote .Method}}, req, options);
+  }
+{{- end}}
+{{- end}}
+{{- range .Events}}
+
+{{Doc "  " .Description}}  on{{.Name}}(listener: (payload: {{.Payload}}, topic: string) => void): () => void {
+    return this.connection.on<{{.Payload}}>({{Quote .Name}}, listener);
+  }
+{{- end}}
+}
+{{- end}}
+{{- end}}
+{{define "call" -}}
+return this.transport.{{if .Stream}}stream{{else}}unary{{end}}<{{.Response}}>({{Quote .Method}}, {{.Path}}, query(
+{{- range $i, $q := .Query}}{{if $i}}, {{end}}[{{Quote $q.Name}}, {{$q.Value}}{{if $q.Format}}, {{Quote $q.Format}}{{end}}]{{end -}}
+), {{if .Body}}{{.Body}}{{else}}undefined{{end}}, {{if not .Stream}}{{Quote .ResponseBody}}, {{end}}options);
+{{- end -}}
+`
@@ -0,0 +1,496 @@
+// Package ts renders a TypeScript client of the services defined in a proto definition: interfaces of the
+// messages and enums as encoded by protojson (using the proto names of the fields), clients of the HTTP
+// bindings and clients of the WebSocket transport.
+package ts
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/iancoleman/strcase"
+	"github.com/niiigoo/hawk/kit/http"
+	"github.com/niiigoo/hawk/proto"
+	pio "github.com/niiigoo/hawk/proto/io"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"io"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// File is passed to the TypeScript template
+type File struct {
+	Package  string
+	Enums    []*Enum
+	Messages []*Message
+	Services []*Service
+	// HTTP is true if any method has an HTTP binding
+	HTTP bool
+	// WebSocket is true if any service is available via WebSocket
+	WebSocket bool
+}
+
+type Enum struct {
+	Name        string
+	Description string
+	Values      []string
+}
+
+type Message struct {
+	Name        string
+	Description string
+	Fields      []*Field
+}
+
+type Field struct {
+	Name        string
+	Type        string
+	Description string
+}
+
+type Service struct {
+	Name        string
+	Description string
+	// WSPath is the path of the WebSocket endpoint, empty if the service is not available via WebSocket
+	WSPath      string
+	HTTPMethods []*HTTPMethod
+	WSMethods   []*WSMethod
+	Events      []*Event
+}
+
+// HTTPMethod is a method of the HTTP client, the first binding whose Condition holds is used
+type HTTPMethod struct {
+	Name        string
+	Description string
+	Request     string
+	Response    string
+	Stream      bool
+	Bindings    []*Binding
+	// NoMatch is the error thrown if no Condition holds, empty if the last binding has no Condition
+	NoMatch string
+}
+
+type Binding struct {
+	// Condition is the TypeScript expression checking the path variables of `req` are set, empty for a binding
+	// without path variables
+	Condition    string
+	Method       string
+	Path         string
+	Query        []*QueryParam
+	Body         string
+	ResponseBody string
+	// Response and Stream are copied from the method
+	Response string
+	Stream   bool
+}
+
+// QueryParam is a field of the request sent as query parameter, Format is the argument of `query`
+type QueryParam struct {
+	Name   string
+	Value  string
+	Format string
+}
+
+type WSMethod struct {
+	Name           string
+	Method         string
+	Description    string
+	Request        string
+	Response       string
+	RequestStream  bool
+	ResponseStream bool
+}
+
+type Event struct {
+	Name        string
+	Description string
+	Payload     string
+}
+
+// reserved are the names declared by the template, messages and enums must not use them
+var reserved = map[string]bool{
+	"CallOptions":         true,
+	"ApiError":            true,
+	"HttpOptions":         true,
+	"HttpTransport":       true,
+	"WebSocketMessage":    true,
+	"WebSocketHandler":    true,
+	"WebSocketOptions":    true,
+	"WebSocketConnection": true,
+	"WebSocketStream":     true,
+}
+
+// scalars maps the proto scalars to their TypeScript type, 64-bit integers are encoded as strings by protojson
+var scalars = map[pio.Scalar]string{
+	pio.Double:   "number",
+	pio.Float:    "number",
+	pio.Int32:    "number",
+	pio.Int64:    "string",
+	pio.Uint32:   "number",
+	pio.Uint64:   "string",
+	pio.Sint32:   "number",
+	pio.Sint64:   "string",
+	pio.Fixed32:  "number",
+	pio.Fixed64:  "string",
+	pio.SFixed32: "number",
+	pio.SFixed64: "string",
+	pio.Bool:     "boolean",
+	pio.String:   "string",
+	pio.Bytes:    "string",
+}
+
+// wellKnownTypes maps the well-known types to their TypeScript type (see protojson)
+var wellKnownTypes = map[string]string{
+	"google.protobuf.Timestamp":   "string",
+	"google.protobuf.Duration":    "string",
+	"google.protobuf.FieldMask":   "string",
+	"google.protobuf.Empty":       "Record<string, never>",
+	"google.protobuf.Struct":      "{ [key: string]: unknown }",
+	"google.protobuf.Value":       "unknown",
+	"google.protobuf.ListValue":   "unknown[]",
+	"google.protobuf.Any":         `{ "@type": string; [key: string]: unknown }`,
+	"google.protobuf.DoubleValue": "number | null",
+	"google.protobuf.FloatValue":  "number | null",
+	"google.protobuf.Int64Value":  "string | null",
+	"google.protobuf.UInt64Value": "string | null",
+	"google.protobuf.Int32Value":  "number | null",
+	"google.protobuf.UInt32Value": "number | null",
+	"google.protobuf.BoolValue":   "boolean | null",
+	"google.protobuf.StringValue": "string | null",
+	"google.protobuf.BytesValue":  "string | null",
+}
+
+// TemplateFuncs are the helper functions used in the TypeScript template
+var TemplateFuncs = template.FuncMap{
+	"Doc":   doc,
+	"Quote": strconv.Quote,
+}
+
+// builder resolves the type names, referenced nested types and types of imported files are collected in imported
+type builder struct {
+	def      *proto.Definition
+	imported []*proto.Symbol
+	seen     map[string]bool
+}
+
+// NewFile collects the messages, enums and services of the definition. Nested types and types of imported files
+// are added if they are referenced.
+func NewFile(def *proto.Definition) (*File, error) {
+	b := &builder{
+		def:  def,
+		seen: make(map[string]bool),
+	}
+	f := &File{
+		Package:  def.Package(),
+		Enums:    make([]*Enum, 0),
+		Messages: make([]*Message, 0),
+		Services: make([]*Service, 0, len(def.Services)),
+	}
+
+	for _, svc := range def.Services {
+		s := b.service(svc)
+		f.HTTP = f.HTTP || len(s.HTTPMethods) > 0
+		f.WebSocket = f.WebSocket || s.WSPath != ""
+		f.Services = append(f.Services, s)
+	}
+	for _, msg := range def.Messages() {
+		f.Messages = append(f.Messages, b.message(msg.Name, qualify(def.Package(), msg.Name), msg))
+	}
+	for _, enum := range def.Enums() {
+		f.Enums = append(f.Enums, newEnum(enum.Name, enum))
+	}
+	// the fields of these types may reference further ones
+	for i := 0; i < len(b.imported); i++ {
+		sym := b.imported[i]
+		if sym.Message != nil {
+			f.Messages = append(f.Messages, b.message(b.typeName(sym), sym.FullName, sym.Message))
+		} else {
+			f.Enums = append(f.Enums, newEnum(b.typeName(sym), sym.Enum))
+		}
+	}
+
+	for _, m := range f.Messages {
+		if reserved[m.Name] {
+			return nil, errors.New(fmt.Sprintf("message `%s` collides with a type of the client", m.Name))
+		}
+	}
+	for _, e := range f.Enums {
+		if reserved[e.Name] {
+			return nil, errors.New(fmt.Sprintf("enum `%s` collides with a type of the client", e.Name))
+		}
+	}
+
+	return f, nil
+}
+
+// Render executes the TypeScript template
+func (f *File) Render() (io.Reader, error) {
+	t, err := template.New("ts").Funcs(TemplateFuncs).Parse(typescriptTemplate)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse template")
+	}
+	buf := bytes.NewBuffer(nil)
+	err = t.Execute(buf, f)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to execute template")
+	}
+	return buf, nil
+}
+
+func (b *builder) service(svc *proto.Service) *Service {
+	s := &Service{
+		Name:        svc.Name,
+		Description: svc.Comments.String(),
+		WSPath:      svc.WSPath,
+		HTTPMethods: make([]*HTTPMethod, 0),
+		WSMethods:   make([]*WSMethod, 0),
+		Events:      make([]*Event, 0),
+	}
+	scope := b.def.Package()
+	for _, m := range svc.Methods {
+		request, response := b.reference(scope, m.Request), b.reference(scope, m.Response)
+		// client streams are only available via gRPC and WebSocket
+		if len(m.HttpBindings) > 0 && !m.RequestStream {
+			method := &HTTPMethod{
+				Name:        strcase.ToLowerCamel(m.Name),
+				Description: m.Comments.String(),
+				Request:     request,
+				Response:    response,
+				Stream:      m.ResponseStream,
+			}
+			for _, choice := range http.NewMethod(m).BindingChoices() {
+				binding := newBinding(choice)
+				binding.Response, binding.Stream = response, m.ResponseStream
+				method.Bindings = append(method.Bindings, binding)
+			}
+			if method.Bindings[len(method.Bindings)-1].Condition != "" {
+				method.NoMatch = "no binding of " + m.Name + " matches the request"
+			}
+			s.HTTPMethods = append(s.HTTPMethods, method)
+		}
+		if svc.WSPath != "" {
+			s.WSMethods = append(s.WSMethods, &WSMethod{
+				Name:           strcase.ToLowerCamel(m.Name),
+				Method:         m.Name,
+				Description:    m.Comments.String(),
+				Request:        request,
+				Response:       response,
+				RequestStream:  m.RequestStream,
+				ResponseStream: m.ResponseStream,
+			})
+		}
+	}
+	if svc.WSPath != "" {
+		for _, e := range svc.Events {
+			s.Events = append(s.Events, &Event{
+				Name:        e.Name,
+				Description: e.Comments.String(),
+				Payload:     b.reference(scope, e.Request),
+			})
+		}
+	}
+	return s
+}
+
+// newBinding translates the binding into the arguments of `HttpTransport.unary` and `HttpTransport.stream`
+func newBinding(choice http.BindingChoice) *Binding {
+	b := &Binding{
+		Method:       strings.ToUpper(choice.Binding.Method),
+		Path:         pathExpression(choice.Binding.PathParts()),
+		Query:        make([]*QueryParam, 0),
+		ResponseBody: choice.Binding.ResponseBody,
+	}
+	if choice.Condition != "" {
+		conditions := make([]string, 0)
+		for _, f := range choice.Binding.PathFields() {
+			conditions = append(conditions, access(f.Name))
+		}
+		b.Condition = strings.Join(conditions, " && ")
+	}
+	if choice.Binding.Body == "*" {
+		b.Body = "req"
+	} else if choice.Binding.Body != "" {
+		b.Body = access(choice.Binding.Body) + " ?? null"
+	}
+
+	for _, f := range choice.Binding.Fields {
+		if f.Location == "query" {
+			b.Query = append(b.Query, &QueryParam{Name: f.QueryParamName, Value: access(f.Name), Format: queryFormat(f)})
+		}
+	}
+	for _, oneOf := range choice.Binding.OneOfFields {
+		if oneOf.Location != "query" {
+			continue
+		}
+		for i := range oneOf.Options {
+			f := &oneOf.Options[i]
+			b.Query = append(b.Query, &QueryParam{Name: f.QueryParamName, Value: access(f.Name), Format: queryFormat(f)})
+		}
+	}
+	return b
+}
+
+// pathExpression returns the TypeScript expression building the path, the values of the variables are escaped
+func pathExpression(segments [][]http.PathPart) string {
+	exprs := make([]string, 0)
+	var literal string
+	for i, segment := range segments {
+		if i > 0 {
+			literal += "/"
+		}
+		for _, part := range segment {
+			if part.Variable == "" {
+				literal += part.Literal
+				continue
+			}
+			if literal != "" {
+				exprs = append(exprs, strconv.Quote(literal))
+				literal = ""
+			}
+			value := "String(" + access(part.Variable) + ` ?? "")`
+			if part.MultiSegment {
+				exprs = append(exprs, value+`.split("/").map(encodeURIComponent).join("/")`)
+			} else {
+				exprs = append(exprs, "encodeURIComponent("+value+")")
+			}
+		}
+	}
+	if literal != "" || len(exprs) == 0 {
+		exprs = append(exprs, strconv.Quote(literal))
+	}
+	return strings.Join(exprs, " + ")
+}
+
+// queryFormat returns how the value is added to the query: string maps as `name[key]=value`, messages as JSON
+func queryFormat(f *http.Field) string {
+	switch {
+	case f.IsStringMap:
+		return "map"
+	case f.WellKnown != nil && !f.Repeated, f.IsBaseType, f.IsEnum:
+		return ""
+	default:
+		return "json"
+	}
+}
+
+// access returns the TypeScript expression reading the field of the request `req`, e.g. `req.org?.id` for `org.id`
+func access(path string) string {
+	return "req." + strings.ReplaceAll(path, ".", "?.")
+}
+
+func (b *builder) message(name, scope string, msg *pio.Message) *Message {
+	m := &Message{
+		Name:        name,
+		Description: msg.Comments.String(),
+		Fields:      make([]*Field, 0),
+	}
+	for _, entry := range msg.Entries {
+		if entry.Field != nil {
+			m.Fields = append(m.Fields, b.field(scope, entry.Field, ""))
+		} else if entry.OneOf != nil {
+			for _, e := range entry.OneOf.Entries {
+				if e.Field != nil {
+					m.Fields = append(m.Fields, b.field(scope, e.Field, entry.OneOf.Name))
+				}
+			}
+		}
+	}
+	return m
+}
+
+func (b *builder) field(scope string, field *pio.Field, oneOf string) *Field {
+	f := &Field{
+		Name:        field.Name,
+		Type:        b.fieldType(scope, &field.Type),
+		Description: field.Comments.String(),
+	}
+	if field.Repeated {
+		if strings.ContainsAny(f.Type, " |") {
+			f.Type = "(" + f.Type + ")"
+		}
+		f.Type += "[]"
+	}
+	if oneOf != "" {
+		f.Description = strings.TrimSpace(f.Description + "\n\nOnly one field of the oneof `" + oneOf + "` may be set.")
+	}
+	return f
+}
+
+func (b *builder) fieldType(scope string, t *pio.Type) string {
+	if t.Scalar > pio.None {
+		return scalars[t.Scalar]
+	}
+	if t.Map != nil {
+		return "{ [key: string]: " + b.fieldType(scope, t.Map.Value) + " }"
+	}
+	return b.reference(scope, t.Reference)
+}
+
+// reference returns the name of the referenced message or enum, the name is resolved within the scope
+func (b *builder) reference(scope, name string) string {
+	if t, ok := wellKnownTypes[strings.TrimPrefix(name, ".")]; ok {
+		return t
+	}
+	if sym, ok := b.def.Resolve(scope, name); ok {
+		if t, ok := wellKnownTypes[sym.FullName]; ok {
+			return t
+		}
+		if (sym.File != nil || sym.Nested()) && !b.seen[sym.FullName] {
+			b.seen[sym.FullName] = true
+			b.imported = append(b.imported, sym)
+		}
+		return b.typeName(sym)
+	}
+	log.Warnf("type `%s` is not defined, using `unknown`", name)
+	return "unknown"
+}
+
+// typeName returns the TypeScript name of the symbol, the names of nested types and types of other packages are
+// joined by an underscore, e.g. `User_Address` or `common_Page`
+func (b *builder) typeName(sym *proto.Symbol) string {
+	name := sym.FullName
+	if sym.Package == b.def.Package() {
+		name = sym.Name
+	}
+	return strings.ReplaceAll(name, ".", "_")
+}
+
+func newEnum(name string, enum *pio.Enum) *Enum {
+	e := &Enum{
+		Name:        name,
+		Description: enum.Comments.String(),
+		Values:      make([]string, 0, len(enum.Values)),
+	}
+	for _, v := range enum.Values {
+		if v.Value != nil {
+			e.Values = append(e.Values, strconv.Quote(v.Value.Key))
+		}
+	}
+	return e
+}
+
+// doc returns the text as JSDoc comment indented by indent, empty if there is no text
+func doc(indent, text string) string {
+	if text == "" {
+		return ""
+	}
+	text = strings.ReplaceAll(text, "*/", `*\/`)
+	lines := strings.Split(text, "\n")
+	if len(lines) == 1 {
+		return indent + "/** " + text + " */\n"
+	}
+	var sb strings.Builder
+	sb.WriteString(indent + "/**\n")
+	for _, line := range lines {
+		sb.WriteString(strings.TrimRight(indent+" * "+line, " ") + "\n")
+	}
+	sb.WriteString(indent + " */\n")
+	return sb.String()
+}
+
+func qualify(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
@@ -0,0 +1,242 @@
+package ts
+
+import (
+	"github.com/niiigoo/hawk/proto"
+	"github.com/stretchr/testify/suite"
+	"io"
+	"testing"
+)
+
+const definition = `
+syntax = "proto3";
+
+package sample;
+
+// Manages the users
+service Sample {
+	option (config) = {
+		HttpPrefix: "/api/sample"
+		WebSocketPath: "/ws"
+	};
+
+	// Returns a single user
+	rpc GetUser(GetUserRequest) returns (User) {
+		option (google.api.http) = {
+			get: "/users/{id}"
+			additional_bindings {
+				get: "/orgs/{org.id}/users/{id}"
+			}
+		};
+	}
+	rpc Wrap(Envelope) returns (Envelope) {
+		option (google.api.http) = {
+			post: "/files/{path=files/**}"
+			body: "user"
+			response_body: "user"
+		};
+	}
+	rpc Watch(GetUserRequest) returns (stream User) {
+		option (google.api.http) = {
+			get: "/users/{id}/watch"
+		};
+	}
+	rpc Chat(stream User) returns (stream User);
+	// The user has been changed
+	rpc UserUpdated(User) returns (User) {
+		option (hawk.v1.method).web_socket_event = true;
+	}
+}
+
+message GetUserRequest {
+	string id = 1; // Identifier of the user
+	Org org = 2;
+	repeated Role roles = 3;
+	map<string, string> labels = 4;
+	User.Address near = 5;
+	oneof selector {
+		string name = 6;
+		int64 number = 7;
+	}
+	repeated Org orgs = 8;
+}
+
+message Org {
+	string id = 1;
+}
+
+message Envelope {
+	string path = 1;
+	User user = 2;
+}
+
+// A user
+// the account
+message User {
+	string id = 1;
+	repeated Address addresses = 2;
+	Role role = 3;
+	google.protobuf.Timestamp created = 4;
+	repeated google.protobuf.Int32Value scores = 5;
+
+	message Address {
+		string city = 1;
+	}
+}
+
+enum Role {
+	ROLE_UNKNOWN = 0;
+	ROLE_ADMIN = 1;
+}
+`
+
+type TypeScriptTestSuite struct {
+	suite.Suite
+	file *File
+}
+
+func TestTypeScriptTestSuite(t *testing.T) {
+	suite.Run(t, new(TypeScriptTestSuite))
+}
+
+func (s *TypeScriptTestSuite) SetupTest() {
+	p := proto.NewService()
+	s.Require().NoError(p.ParseString(definition))
+	file, err := NewFile(p.Definition())
+	s.Require().NoError(err)
+	s.file = file
+}
+
+func (s *TypeScriptTestSuite) TestMessages() {
+	names := make([]string, 0)
+	for _, m := range s.file.Messages {
+		names = append(names, m.Name)
+	}
+	s.Equal([]string{"GetUserRequest", "Org", "Envelope", "User", "User_Address"}, names)
+
+	req := s.file.Messages[0]
+	s.Equal("id", req.Fields[0].Name)
+	s.Equal("Identifier of the user", req.Fields[0].Description)
+	s.Equal("Role[]", req.Fields[2].Type)
+	s.Equal("{ [key: string]: string }", req.Fields[3].Type)
+	s.Equal("User_Address", req.Fields[4].Type)
+	s.Equal("string", req.Fields[6].Type)
+	s.Contains(req.Fields[6].Description, "oneof `selector`")
+
+	user := s.file.Messages[3]
+	s.Equal("User_Address[]", user.Fields[1].Type)
+	s.Equal("string", user.Fields[3].Type)
+	s.Equal("(number | null)[]", user.Fields[4].Type)
+
+	s.Require().Len(s.file.Enums, 1)
+	s.Equal([]string{`"ROLE_UNKNOWN"`, `"ROLE_ADMIN"`}, s.file.Enums[0].Values)
+}
+
+func (s *TypeScriptTestSuite) TestHTTPMethods() {
+	s.True(s.file.HTTP)
+	s.Require().Len(s.file.Services, 1)
+	methods := s.file.Services[0].HTTPMethods
+	s.Require().Len(methods, 3)
+
+	get := methods[0]
+	s.Equal("getUser", get.Name)
+	s.Require().Len(get.Bindings, 2)
+	s.Equal("req.org?.id && req.id", get.Bindings[0].Condition)
+	s.Equal(`"/api/sample/orgs/" + encodeURIComponent(String(req.org?.id ?? "")) + "/users/" + encodeURIComponent(String(req.id ?? ""))`, get.Bindings[0].Path)
+	s.Equal("req.id", get.Bindings[1].Condition)
+	s.Equal("no binding of GetUser matches the request", get.NoMatch)
+	s.Equal(`"/api/sample/users/" + encodeURIComponent(String(req.id ?? ""))`, get.Bindings[1].Path)
+
+	query := make(map[string]*QueryParam)
+	for _, q := range get.Bindings[1].Query {
+		query[q.Name] = q
+	}
+	s.Equal(&QueryParam{Name: "org.id", Value: "req.org?.id"}, query["org.id"])
+	s.Equal(&QueryParam{Name: "roles", Value: "req.roles"}, query["roles"])
+	s.Equal(&QueryParam{Name: "labels", Value: "req.labels", Format: "map"}, query["labels"])
+	s.Equal(&QueryParam{Name: "near.city", Value: "req.near?.city"}, query["near.city"])
+	s.Equal(&QueryParam{Name: "orgs", Value: "req.orgs", Format: "json"}, query["orgs"])
+	s.Equal(&QueryParam{Name: "number", Value: "req.number"}, query["number"])
+
+	wrap := methods[1]
+	s.Equal(`"/api/sample/files/" + String(req.path ?? "").split("/").map(encodeURIComponent).join("/")`, wrap.Bindings[0].Path)
+	s.Equal("req.user ?? null", wrap.Bindings[0].Body)
+	s.Equal("user", wrap.Bindings[0].ResponseBody)
+
+	s.True(methods[2].Stream)
+	s.True(methods[2].Bindings[0].Stream)
+}
+
+func (s *TypeScriptTestSuite) TestWebSocket() {
+	s.True(s.file.WebSocket)
+	svc := s.file.Services[0]
+	s.Equal("/api/sample/ws", svc.WSPath)
+	s.Require().Len(svc.WSMethods, 4)
+	s.Equal("GetUser", svc.WSMethods[0].Method)
+	s.Equal("chat", svc.WSMethods[3].Name)
+	s.True(svc.WSMethods[3].RequestStream)
+	s.Require().Len(svc.Events, 1)
+	s.Equal(&Event{Name: "UserUpdated", Description: "The user has been changed", Payload: "User"}, svc.Events[0])
+}
+
+func (s *TypeScriptTestSuite) TestRender() {
+	r, err := s.file.Render()
+	s.Require().NoError(err)
+	data, err := io.ReadAll(r)
+	s.Require().NoError(err)
+
+	ts := string(data)
+	s.Contains(ts, "export type Role = \"ROLE_UNKNOWN\" | \"ROLE_ADMIN\";\n")
+	s.Contains(ts, "/**\n * A user\n * the account\n */\nexport interface User {\n")
+	s.Contains(ts, "  /** Identifier of the user */\n  id?: string;\n")
+	s.Contains(ts, "export class SampleHttpClient {")
+	s.Contains(ts, "    if (req.org?.id && req.id) {\n      return this.transport.unary<User>(\"GET\", ")
+	s.Contains(ts, `query(["roles", req.roles], ["labels", req.labels, "map"]`)
+	s.Contains(ts, `, req.user ?? null, "user", options);`)
+	s.Contains(ts, "  watch(req: GetUserRequest, options?: CallOptions): AsyncGenerator<User, void, undefined> {\n    if (req.id) {\n      return this.transport.stream<User>(")
+	s.Contains(ts, "    }\n    return Promise.reject(new Error(\"no binding of GetUser matches the request\"));\n  }\n")
+	s.Contains(ts, "    }\n    throw new Error(\"no binding of Watch matches the request\");\n  }\n")
+	s.Contains(ts, "export class SampleWebSocketClient {\n  /** path of the WebSocket endpoint of the service */\n  static readonly path = \"/api/sample/ws\";")
+	s.Contains(ts, "  chat(): WebSocketStream<User, User> {\n    return this.connection.stream<User, User>(\"Chat\");\n  }")
+	s.Contains(ts, "  onUserUpdated(listener: (payload: User, topic: string) => void): () => void {")
+}
+
+func (s *TypeScriptTestSuite) TestWithoutTransports() {
+	p := proto.NewService()
+	s.Require().NoError(p.ParseString(`
+syntax = "proto3";
+package sample;
+service Sample {
+	rpc Get(Empty) returns (Empty);
+}
+message Empty {}
+enum Nothing {}
+`))
+	file, err := NewFile(p.Definition())
+	s.Require().NoError(err)
+	s.False(file.HTTP)
+	s.False(file.WebSocket)
+
+	r, err := file.Render()
+	s.Require().NoError(err)
+	data, err := io.ReadAll(r)
+	s.Require().NoError(err)
+	s.Contains(string(data), "export type Nothing = never;")
+	s.NotContains(string(data), "ApiError")
+}
+
+func (s *TypeScriptTestSuite) TestReservedName() {
+	p := proto.NewService()
+	s.Require().NoError(p.ParseString(`
+syntax = "proto3";
+package sample;
+message ApiError {}
+`))
+	_, err := NewFile(p.Definition())
+	s.ErrorContains(err, "`ApiError`")
+}
+
+func (s *TypeScriptTestSuite) TestDoc() {
+	s.Empty(doc("  ", ""))
+	s.Equal("  /** a *\\/ b */\n", doc("  ", "a */ b"))
+	s.Equal("/**\n * a\n *\n * b\n */\n", doc("", "a\n\nb"))
+}
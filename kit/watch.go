@@ -0,0 +1,214 @@
+package kit
+
+import (
+	"fmt"
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	log "github.com/sirupsen/logrus"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+// WatchOptions configure the watch mode
+type WatchOptions struct {
+	ServiceOptions
+	// Debounce is the time waited for further changes before the service is generated
+	Debounce time.Duration
+	// Run builds and restarts the service cmd/NAME after every successful generation
+	Run bool
+	// Args are passed to the service started by Run
+	Args []string
+}
+
+// positionPattern matches the position at the start of an error message, e.g. `sample.proto:12:3: `
+var positionPattern = regexp.MustCompile(`^\S+:\d+:\d+: `)
+
+// Watch generates the service whenever the proto file, its imports or `protoc.yaml` change. Errors are printed
+// without stopping, the watch ends by an interrupt.
+func (g generator) Watch(options WatchOptions, args ...string) error {
+	f, err := g.protoService.DetectFile(args...)
+	if err != nil {
+		return errors.Wrap(err, "proto file not found")
+	}
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.Wrap(err, "failed to watch files")
+	}
+	defer func() {
+		_ = watcher.Close()
+	}()
+
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, os.Interrupt)
+	defer signal.Stop(interrupt)
+
+	w := &watch{
+		generator: g,
+		options:   options,
+		watcher:   watcher,
+		files:     make(map[string]bool),
+		dirs:      make(map[string]bool),
+	}
+	defer w.process.stop()
+
+	w.generate(f)
+	var pending <-chan time.Time
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return nil
+			}
+			if w.files[filepath.Clean(event.Name)] && !event.Has(fsnotify.Chmod) {
+				log.WithField("file", event.Name).Debug("File changed")
+				pending = time.After(options.Debounce)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return nil
+			}
+			log.WithError(err).Warn("Watching files failed")
+		case <-pending:
+			pending = nil
+			w.generate(f)
+		case <-interrupt:
+			return nil
+		}
+	}
+}
+
+type watch struct {
+	generator
+	options WatchOptions
+	watcher *fsnotify.Watcher
+	// files contains the absolute paths of the watched files, their directories are watched to notice files
+	// replaced by editors
+	files   map[string]bool
+	dirs    map[string]bool
+	process *process
+}
+
+// generate generates the service from the file and restarts it if enabled, the watched files are updated by the
+// imports of the file
+func (w *watch) generate(file string) {
+	start := time.Now()
+	err := w.Service(w.options.ServiceOptions, file)
+
+	paths := []string{file, filepath.Join(w.dir, "protoc.yaml"), filepath.Join(w.dir, "protoc.yml")}
+	for _, i := range w.protoService.Imports() {
+		if i.Path != "" {
+			paths = append(paths, i.Path)
+		}
+	}
+	w.add(paths...)
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errorMessage(err))
+		return
+	}
+	log.WithField("duration", time.Since(start).Round(time.Millisecond)).Info("Generated service")
+
+	if w.options.Run {
+		w.restart(serviceName(w.protoService.Definition()))
+	}
+}
+
+// add watches the files
+func (w *watch) add(paths ...string) {
+	for _, path := range paths {
+		abs, err := filepath.Abs(path)
+		if err != nil {
+			continue
+		}
+		w.files[abs] = true
+
+		dir := filepath.Dir(abs)
+		if w.dirs[dir] {
+			continue
+		}
+		if err = w.watcher.Add(dir); err != nil {
+			log.WithError(err).WithField("dir", dir).Warn("Cannot watch directory")
+			continue
+		}
+		w.dirs[dir] = true
+	}
+}
+
+// restart builds the service cmd/NAME, the running service is only stopped if the build succeeds
+func (w *watch) restart(name string) {
+	bin := filepath.Join(w.dir, ".hawk", "bin", name)
+	build := exec.Command("go", "build", "-o", bin+".tmp", "./cmd/"+name)
+	build.Dir = w.dir
+	build.Stdout = os.Stdout
+	build.Stderr = os.Stderr
+	if err := build.Run(); err != nil {
+		log.WithError(err).Error("Building the service failed")
+		return
+	}
+
+	w.process.stop()
+	w.process = nil
+	if err := os.Rename(bin+".tmp", bin); err != nil {
+		log.WithError(err).Error("Cannot replace the service binary")
+		return
+	}
+
+	cmd := exec.Command(bin, w.options.Args...)
+	cmd.Dir = w.dir
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		log.WithError(err).Error("Starting the service failed")
+		return
+	}
+	log.WithField("pid", cmd.Process.Pid).Info("Started service " + name)
+
+	w.process = &process{cmd: cmd, done: make(chan struct{})}
+	go func(p *process) {
+		_ = p.cmd.Wait()
+		close(p.done)
+	}(w.process)
+}
+
+// process is a running service
+type process struct {
+	cmd  *exec.Cmd
+	done chan struct{}
+}
+
+// stop interrupts the process and kills it if it does not exit within 5 seconds
+func (p *process) stop() {
+	if p == nil {
+		return
+	}
+	select {
+	case <-p.done:
+		return
+	default:
+	}
+
+	if err := p.cmd.Process.Signal(os.Interrupt); err != nil {
+		_ = p.cmd.Process.Kill()
+	}
+	select {
+	case <-p.done:
+	case <-time.After(5 * time.Second):
+		_ = p.cmd.Process.Kill()
+		<-p.done
+	}
+}
+
+// errorMessage returns the message of the cause if it starts with a position in a proto file (e.g. a syntax error),
+// otherwise the full message
+func errorMessage(err error) string {
+	if cause := errors.Cause(err).Error(); positionPattern.MatchString(cause) {
+		return cause
+	}
+	return err.Error()
+}
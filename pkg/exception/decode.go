@@ -0,0 +1,96 @@
+package exception
+
+import (
+	"encoding/json"
+	"errors"
+	"google.golang.org/grpc/codes"
+	"net/http"
+	"strings"
+)
+
+// maxMessageSize limits the message taken from a body which is not a JSON error
+const maxMessageSize = 8192
+
+// Decode turns the body of an HTTP error response back into an Exception keeping the status code, error id and
+// reasons. Both bodies written by an Exception and plain `{"error":"message"}` bodies are supported, any other body
+// is used as message. The gRPC code is derived from the HTTP status code.
+func Decode(httpStatus int, body []byte) Exception {
+	e := exception{
+		httpStatus: httpStatus,
+		grpcCode:   codeOf(httpStatus),
+	}
+
+	var wrapper struct {
+		Error json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal(body, &wrapper); err == nil && len(wrapper.Error) > 0 {
+		var detail struct {
+			Message string            `json:"message"`
+			ErrorId string            `json:"error_id"`
+			Reasons map[string]string `json:"reasons"`
+		}
+		if json.Unmarshal(wrapper.Error, &e.Message) == nil {
+			body = nil
+		} else if json.Unmarshal(wrapper.Error, &detail) == nil {
+			e.Message, e.ErrorId, e.Reasons = detail.Message, detail.ErrorId, detail.Reasons
+			body = nil
+		}
+	}
+	if len(body) > maxMessageSize {
+		body = body[:maxMessageSize]
+	}
+	if e.Message == "" {
+		e.Message = strings.TrimSpace(string(body))
+	}
+	if e.Message == "" {
+		e.Message = http.StatusText(httpStatus)
+	}
+
+	return e
+}
+
+// ErrorID returns the id of the error if err is an Exception, the id refers to the logged error
+func ErrorID(err error) string {
+	var e exception
+	if errors.As(err, &e) {
+		return e.ErrorId
+	}
+	return ""
+}
+
+// ErrorReasons returns the reasons of the error if err is an Exception, e.g. the violated fields of a request
+func ErrorReasons(err error) map[string]string {
+	var e exception
+	if errors.As(err, &e) {
+		return e.Reasons
+	}
+	return nil
+}
+
+// codeOf maps an HTTP status code to the gRPC code, the inverse of the variables Internal, NotFound, etc.
+func codeOf(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return codes.InvalidArgument
+	case http.StatusUnauthorized:
+		return codes.Unauthenticated
+	case http.StatusForbidden:
+		return codes.PermissionDenied
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusConflict:
+		return codes.AlreadyExists
+	case http.StatusTooManyRequests:
+		return codes.ResourceExhausted
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusGatewayTimeout:
+		return codes.DeadlineExceeded
+	}
+	if httpStatus >= http.StatusInternalServerError {
+		return codes.Internal
+	}
+	return codes.Unknown
+}
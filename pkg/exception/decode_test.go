@@ -0,0 +1,66 @@
+package exception
+
+import (
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc/codes"
+	"net/http"
+	"testing"
+)
+
+type DecodeTestSuite struct {
+	suite.Suite
+}
+
+func (s *DecodeTestSuite) TestRoundTrip() {
+	in := Error("invalid request", map[string]string{"name": "required"}, http.StatusUnprocessableEntity, codes.InvalidArgument)
+	body, err := in.(Exception).MarshalJSON()
+	s.Require().NoError(err)
+
+	out := Decode(http.StatusUnprocessableEntity, body)
+	s.Equal("invalid request", out.Error())
+	s.Equal(http.StatusUnprocessableEntity, out.StatusCode())
+	s.Equal(codes.InvalidArgument, out.GRPCStatus().Code())
+	s.Equal(ErrorID(in), ErrorID(out))
+	s.NotEmpty(ErrorID(out))
+	s.Equal(map[string]string{"name": "required"}, ErrorReasons(out))
+}
+
+func (s *DecodeTestSuite) TestPlainError() {
+	out := Decode(http.StatusNotFound, []byte(`{"error":"user not found"}`))
+	s.Equal("user not found", out.Error())
+	s.Equal(codes.NotFound, out.GRPCStatus().Code())
+	s.Empty(ErrorID(out))
+	s.Nil(ErrorReasons(out))
+}
+
+func (s *DecodeTestSuite) TestOtherBody() {
+	s.Equal("bad gateway", Decode(http.StatusBadGateway, []byte("bad gateway\n")).Error())
+	s.Equal(`{"message":"x"}`, Decode(http.StatusBadRequest, []byte(`{"message":"x"}`)).Error())
+	s.Equal(http.StatusText(http.StatusServiceUnavailable), Decode(http.StatusServiceUnavailable, nil).Error())
+	s.Equal(http.StatusText(http.StatusForbidden), Decode(http.StatusForbidden, []byte(`{"error":{}}`)).Error())
+}
+
+func (s *DecodeTestSuite) TestCodes() {
+	for status, code := range map[int]codes.Code{
+		http.StatusBadRequest:          codes.InvalidArgument,
+		http.StatusUnauthorized:        codes.Unauthenticated,
+		http.StatusForbidden:           codes.PermissionDenied,
+		http.StatusConflict:            codes.AlreadyExists,
+		http.StatusTooManyRequests:     codes.ResourceExhausted,
+		http.StatusGatewayTimeout:      codes.DeadlineExceeded,
+		http.StatusInternalServerError: codes.Internal,
+		http.StatusBadGateway:          codes.Internal,
+		http.StatusTeapot:              codes.Unknown,
+	} {
+		s.Equal(code, Decode(status, nil).GRPCStatus().Code(), status)
+	}
+}
+
+func (s *DecodeTestSuite) TestAccessors() {
+	s.Empty(ErrorID(nil))
+	s.Nil(ErrorReasons(http.ErrBodyNotAllowed))
+}
+
+func TestDecodeTestSuite(t *testing.T) {
+	suite.Run(t, &DecodeTestSuite{})
+}
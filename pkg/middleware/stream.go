@@ -0,0 +1,132 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/niiigoo/hawk/pkg/exception"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"net/http"
+	"runtime/debug"
+	"sync/atomic"
+	"time"
+)
+
+// StreamEndpoint is the streaming counterpart of endpoint.Endpoint. The request is the message of a server stream,
+// it is nil for client and bidirectional streams.
+type StreamEndpoint func(ctx context.Context, request interface{}, stream grpc.ServerStream) error
+
+// StreamMiddleware is a chainable behavior modifier for stream endpoints, it gets passed the name of the method
+type StreamMiddleware func(string, StreamEndpoint) StreamEndpoint
+
+// CatchPanicStream is the streaming counterpart of CatchPanic
+func CatchPanicStream(method string, next StreamEndpoint) StreamEndpoint {
+	return func(ctx context.Context, request interface{}, stream grpc.ServerStream) (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				if e, ok := r.(error); ok {
+					err = e
+				} else {
+					err = errors.New(fmt.Sprintf("%v", r))
+				}
+				err = exception.ErrorLog(ctx, logrus.FatalLevel, "error.panic", err, nil, http.StatusInternalServerError, codes.Internal, logrus.Fields{
+					"method": method,
+					"stack":  string(debug.Stack()),
+				})
+			}
+		}()
+
+		return next(ctx, request, stream)
+	}
+}
+
+// StreamLogging returns a stream middleware that logs the duration of each stream, the number of messages
+// sent and received and the resulting error if any.
+func StreamLogging(logger *logrus.Entry, fields logrus.Fields) StreamMiddleware {
+	return func(method string, next StreamEndpoint) StreamEndpoint {
+		return func(ctx context.Context, request interface{}, stream grpc.ServerStream) (err error) {
+			log := GetLogger(ctx)
+			if log == nil {
+				log = logger
+			}
+			counter := &countingStream{ServerStream: stream}
+			defer func(begin time.Time) {
+				log = log.WithFields(logrus.Fields{
+					"method":   method,
+					"took":     time.Since(begin),
+					"sent":     counter.sent.Load(),
+					"received": counter.received.Load(),
+					"error":    err,
+				})
+				if len(fields) > 0 {
+					log = log.WithFields(fields)
+				}
+				log.Info("stream completed")
+			}(time.Now())
+			return next(ctx, request, counter)
+		}
+	}
+}
+
+// LoggerToStreamContext is the streaming counterpart of LoggerToContext
+func LoggerToStreamContext(logger *logrus.Entry) StreamMiddleware {
+	return func(method string, next StreamEndpoint) StreamEndpoint {
+		return func(ctx context.Context, request interface{}, stream grpc.ServerStream) error {
+			if logger != nil {
+				defaultLogger = logger
+				log := GetLogger(ctx)
+				if log == nil {
+					log = logger
+				}
+
+				log = log.WithField("method", method)
+				if t, ok := ctx.Value("transport").(string); ok {
+					log = log.WithField("transport", t)
+				}
+
+				ctx = context.WithValue(ctx, "log", log)
+			}
+
+			return next(ctx, request, stream)
+		}
+	}
+}
+
+// StreamGuard returns a stream middleware rejecting the stream if the guard reports an error, the context
+// returned by the guard is passed to the next endpoint. It may be used for authentication.
+func StreamGuard(guard func(ctx context.Context, method string) (context.Context, error)) StreamMiddleware {
+	return func(method string, next StreamEndpoint) StreamEndpoint {
+		return func(ctx context.Context, request interface{}, stream grpc.ServerStream) error {
+			ctx, err := guard(ctx, method)
+			if err != nil {
+				return err
+			}
+			return next(ctx, request, stream)
+		}
+	}
+}
+
+// countingStream counts the messages sent and received
+type countingStream struct {
+	grpc.ServerStream
+	sent     atomic.Int64
+	received atomic.Int64
+}
+
+func (s *countingStream) SendMsg(m interface{}) error {
+	err := s.ServerStream.SendMsg(m)
+	if err == nil {
+		s.sent.Add(1)
+	}
+	return err
+}
+
+func (s *countingStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil {
+		s.received.Add(1)
+	}
+	return err
+}
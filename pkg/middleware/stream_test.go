@@ -0,0 +1,93 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"io"
+	"testing"
+)
+
+type StreamTestSuite struct {
+	suite.Suite
+}
+
+type testStream struct {
+	grpc.ServerStream
+	received int
+}
+
+func (s *testStream) SendMsg(m interface{}) error {
+	return nil
+}
+
+func (s *testStream) RecvMsg(m interface{}) error {
+	if s.received == 2 {
+		return io.EOF
+	}
+	s.received++
+	return nil
+}
+
+func (s *StreamTestSuite) TestStreamLogging() {
+	var output bytes.Buffer
+	logger := logrus.New()
+	logger.SetOutput(&output)
+	logger.SetFormatter(&logrus.JSONFormatter{})
+
+	err := StreamLogging(logger.WithField("name", "test"), nil)(
+		"Chat",
+		func(ctx context.Context, request interface{}, stream grpc.ServerStream) error {
+			for stream.RecvMsg(nil) == nil {
+				s.NoError(stream.SendMsg(nil))
+			}
+			return nil
+		},
+	)(context.Background(), nil, &testStream{})
+	s.NoError(err)
+
+	var out map[string]interface{}
+	s.Require().NoError(json.Unmarshal(output.Bytes(), &out))
+	s.Equal("Chat", out["method"])
+	s.Equal(float64(2), out["sent"])
+	s.Equal(float64(2), out["received"])
+	s.Nil(out["error"])
+}
+
+func (s *StreamTestSuite) TestCatchPanicStream() {
+	err := CatchPanicStream("Chat", func(ctx context.Context, request interface{}, stream grpc.ServerStream) error {
+		panic("boom")
+	})(context.Background(), nil, &testStream{})
+
+	s.Error(err)
+}
+
+func (s *StreamTestSuite) TestStreamGuard() {
+	denied := errors.New("denied")
+	called := false
+	next := func(ctx context.Context, request interface{}, stream grpc.ServerStream) error {
+		called = true
+		s.Equal("bob", ctx.Value("user"))
+		return nil
+	}
+
+	err := StreamGuard(func(ctx context.Context, method string) (context.Context, error) {
+		return ctx, denied
+	})("Chat", next)(context.Background(), nil, &testStream{})
+	s.ErrorIs(err, denied)
+	s.False(called)
+
+	err = StreamGuard(func(ctx context.Context, method string) (context.Context, error) {
+		return context.WithValue(ctx, "user", "bob"), nil
+	})("Chat", next)(context.Background(), nil, &testStream{})
+	s.NoError(err)
+	s.True(called)
+}
+
+func TestStreamTestSuite(t *testing.T) {
+	suite.Run(t, &StreamTestSuite{})
+}
@@ -0,0 +1,7 @@
+// Package options contains the Go types of hawk/options.proto, the options of the services generated by hawk.
+// The extensions (e.g. E_Method) read the options of the descriptors at runtime:
+//
+//	opts := proto.GetExtension(method.Options(), options.E_Method).(*options.MethodOptions)
+package options
+
+//go:generate protoc -I=../../proto --go_out=. --go_opt=module=github.com/niiigoo/hawk/pkg/options hawk/options.proto
@@ -0,0 +1,436 @@
+// Options of the services generated by hawk. The file is added to the include path by hawk:
+//
+//   import "hawk/options.proto";
+//
+//   service Sample {
+//     option (hawk.v1.service) = {
+//       http_prefix: "/api/sample"
+//     };
+//   }
+//
+// The options are versioned by the package, incompatible changes are released as a new package (e.g. `hawk.v2`).
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.32.0
+// 	protoc        (unknown)
+// source: hawk/options.proto
+
+package options
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ServiceOptions configure the transports of a service
+type ServiceOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Prefix of the HTTP paths, e.g. `/api/sample`
+	HttpPrefix string `protobuf:"bytes,1,opt,name=http_prefix,json=httpPrefix,proto3" json:"http_prefix,omitempty"`
+	// Compresses the HTTP responses of all methods unless a method overrides it
+	HttpCompress bool `protobuf:"varint,2,opt,name=http_compress,json=httpCompress,proto3" json:"http_compress,omitempty"`
+	// Path of the WebSocket endpoint relative to the prefix, the WebSocket transport is disabled if empty
+	WebSocketPath string `protobuf:"bytes,3,opt,name=web_socket_path,json=webSocketPath,proto3" json:"web_socket_path,omitempty"`
+	// Serves all methods by the WebSocket transport unless a method overrides it
+	WebSocketByDefault bool `protobuf:"varint,4,opt,name=web_socket_by_default,json=webSocketByDefault,proto3" json:"web_socket_by_default,omitempty"`
+	// Maximum size of a WebSocket message in bytes, the default of the server is used if 0
+	WebSocketMaxMessageSize uint32 `protobuf:"varint,5,opt,name=web_socket_max_message_size,json=webSocketMaxMessageSize,proto3" json:"web_socket_max_message_size,omitempty"`
+}
+
+func (x *ServiceOptions) Reset() {
+	*x = ServiceOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hawk_options_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ServiceOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServiceOptions) ProtoMessage() {}
+
+func (x *ServiceOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_hawk_options_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServiceOptions.ProtoReflect.Descriptor instead.
+func (*ServiceOptions) Descriptor() ([]byte, []int) {
+	return file_hawk_options_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ServiceOptions) GetHttpPrefix() string {
+	if x != nil {
+		return x.HttpPrefix
+	}
+	return ""
+}
+
+func (x *ServiceOptions) GetHttpCompress() bool {
+	if x != nil {
+		return x.HttpCompress
+	}
+	return false
+}
+
+func (x *ServiceOptions) GetWebSocketPath() string {
+	if x != nil {
+		return x.WebSocketPath
+	}
+	return ""
+}
+
+func (x *ServiceOptions) GetWebSocketByDefault() bool {
+	if x != nil {
+		return x.WebSocketByDefault
+	}
+	return false
+}
+
+func (x *ServiceOptions) GetWebSocketMaxMessageSize() uint32 {
+	if x != nil {
+		return x.WebSocketMaxMessageSize
+	}
+	return 0
+}
+
+// MethodOptions configure the transports of a method
+type MethodOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Compresses the HTTP response, overrides `http_compress` of the service
+	HttpCompress *bool `protobuf:"varint,1,opt,name=http_compress,json=httpCompress,proto3,oneof" json:"http_compress,omitempty"`
+	// Serves the method by the WebSocket transport, overrides `web_socket_by_default` of the service
+	WebSocket *bool `protobuf:"varint,2,opt,name=web_socket,json=webSocket,proto3,oneof" json:"web_socket,omitempty"`
+	// Declares an event pushed to the WebSocket clients, the request is the payload. The method is not served.
+	WebSocketEvent bool `protobuf:"varint,3,opt,name=web_socket_event,json=webSocketEvent,proto3" json:"web_socket_event,omitempty"`
+}
+
+func (x *MethodOptions) Reset() {
+	*x = MethodOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hawk_options_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MethodOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MethodOptions) ProtoMessage() {}
+
+func (x *MethodOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_hawk_options_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MethodOptions.ProtoReflect.Descriptor instead.
+func (*MethodOptions) Descriptor() ([]byte, []int) {
+	return file_hawk_options_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MethodOptions) GetHttpCompress() bool {
+	if x != nil && x.HttpCompress != nil {
+		return *x.HttpCompress
+	}
+	return false
+}
+
+func (x *MethodOptions) GetWebSocket() bool {
+	if x != nil && x.WebSocket != nil {
+		return *x.WebSocket
+	}
+	return false
+}
+
+func (x *MethodOptions) GetWebSocketEvent() bool {
+	if x != nil {
+		return x.WebSocketEvent
+	}
+	return false
+}
+
+// FieldOptions describe a field of a message
+type FieldOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Example of the value in the OpenAPI specification, parsed as JSON if valid (e.g. `42` or `["a"]`), a string otherwise
+	Example string `protobuf:"bytes,1,opt,name=example,proto3" json:"example,omitempty"`
+}
+
+func (x *FieldOptions) Reset() {
+	*x = FieldOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hawk_options_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldOptions) ProtoMessage() {}
+
+func (x *FieldOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_hawk_options_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldOptions.ProtoReflect.Descriptor instead.
+func (*FieldOptions) Descriptor() ([]byte, []int) {
+	return file_hawk_options_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FieldOptions) GetExample() string {
+	if x != nil {
+		return x.Example
+	}
+	return ""
+}
+
+var file_hawk_options_proto_extTypes = []protoimpl.ExtensionInfo{
+	{
+		ExtendedType:  (*descriptorpb.ServiceOptions)(nil),
+		ExtensionType: (*ServiceOptions)(nil),
+		Field:         51000,
+		Name:          "hawk.v1.service",
+		Tag:           "bytes,51000,opt,name=service",
+		Filename:      "hawk/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*MethodOptions)(nil),
+		Field:         51000,
+		Name:          "hawk.v1.method",
+		Tag:           "bytes,51000,opt,name=method",
+		Filename:      "hawk/options.proto",
+	},
+	{
+		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
+		ExtensionType: (*FieldOptions)(nil),
+		Field:         51000,
+		Name:          "hawk.v1.field",
+		Tag:           "bytes,51000,opt,name=field",
+		Filename:      "hawk/options.proto",
+	},
+}
+
+// Extension fields to descriptorpb.ServiceOptions.
+var (
+	// optional hawk.v1.ServiceOptions service = 51000;
+	E_Service = &file_hawk_options_proto_extTypes[0]
+)
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// optional hawk.v1.MethodOptions method = 51000;
+	E_Method = &file_hawk_options_proto_extTypes[1]
+)
+
+// Extension fields to descriptorpb.FieldOptions.
+var (
+	// optional hawk.v1.FieldOptions field = 51000;
+	E_Field = &file_hawk_options_proto_extTypes[2]
+)
+
+var File_hawk_options_proto protoreflect.FileDescriptor
+
+var file_hawk_options_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x68, 0x61, 0x77, 0x6b, 0x2f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x68, 0x61, 0x77, 0x6b, 0x2e, 0x76, 0x31, 0x1a, 0x20, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xef, 0x01, 0x0a, 0x0e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x12, 0x23, 0x0a, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x68, 0x74, 0x74, 0x70,
+	0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x65, 0x62, 0x5f,
+	0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x77, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x31, 0x0a, 0x15, 0x77, 0x65, 0x62, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x62,
+	0x79, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x77, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x79, 0x44, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x12, 0x3c, 0x0a, 0x1b, 0x77, 0x65, 0x62, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x77, 0x65, 0x62, 0x53, 0x6f, 0x63,
+	0x6b, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0xa8, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0c, 0x68, 0x74,
+	0x74, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x88, 0x01, 0x01, 0x12, 0x22, 0x0a,
+	0x0a, 0x77, 0x65, 0x62, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x48, 0x01, 0x52, 0x09, 0x77, 0x65, 0x62, 0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x10, 0x77, 0x65, 0x62, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x5f,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x77, 0x65, 0x62,
+	0x53, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f,
+	0x68, 0x74, 0x74, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x42, 0x0d, 0x0a,
+	0x0b, 0x5f, 0x77, 0x65, 0x62, 0x5f, 0x73, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x28, 0x0a, 0x0c,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x3a, 0x54, 0x0a, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0xb8, 0x8e, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x68, 0x61, 0x77,
+	0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x07, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x3a, 0x50, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb8, 0x8e, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x68, 0x61, 0x77, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x3a, 0x4c,
+	0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0xb8, 0x8e, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x68, 0x61, 0x77, 0x6b, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x42, 0x25, 0x5a, 0x23,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6e, 0x69, 0x69, 0x69, 0x67,
+	0x6f, 0x6f, 0x2f, 0x68, 0x61, 0x77, 0x6b, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_hawk_options_proto_rawDescOnce sync.Once
+	file_hawk_options_proto_rawDescData = file_hawk_options_proto_rawDesc
+)
+
+func file_hawk_options_proto_rawDescGZIP() []byte {
+	file_hawk_options_proto_rawDescOnce.Do(func() {
+		file_hawk_options_proto_rawDescData = protoimpl.X.CompressGZIP(file_hawk_options_proto_rawDescData)
+	})
+	return file_hawk_options_proto_rawDescData
+}
+
+var file_hawk_options_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_hawk_options_proto_goTypes = []interface{}{
+	(*ServiceOptions)(nil),              // 0: hawk.v1.ServiceOptions
+	(*MethodOptions)(nil),               // 1: hawk.v1.MethodOptions
+	(*FieldOptions)(nil),                // 2: hawk.v1.FieldOptions
+	(*descriptorpb.ServiceOptions)(nil), // 3: google.protobuf.ServiceOptions
+	(*descriptorpb.MethodOptions)(nil),  // 4: google.protobuf.MethodOptions
+	(*descriptorpb.FieldOptions)(nil),   // 5: google.protobuf.FieldOptions
+}
+var file_hawk_options_proto_depIdxs = []int32{
+	3, // 0: hawk.v1.service:extendee -> google.protobuf.ServiceOptions
+	4, // 1: hawk.v1.method:extendee -> google.protobuf.MethodOptions
+	5, // 2: hawk.v1.field:extendee -> google.protobuf.FieldOptions
+	0, // 3: hawk.v1.service:type_name -> hawk.v1.ServiceOptions
+	1, // 4: hawk.v1.method:type_name -> hawk.v1.MethodOptions
+	2, // 5: hawk.v1.field:type_name -> hawk.v1.FieldOptions
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	3, // [3:6] is the sub-list for extension type_name
+	0, // [0:3] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_hawk_options_proto_init() }
+func file_hawk_options_proto_init() {
+	if File_hawk_options_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_hawk_options_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ServiceOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hawk_options_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MethodOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hawk_options_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_hawk_options_proto_msgTypes[1].OneofWrappers = []interface{}{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_hawk_options_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 3,
+			NumServices:   0,
+		},
+		GoTypes:           file_hawk_options_proto_goTypes,
+		DependencyIndexes: file_hawk_options_proto_depIdxs,
+		MessageInfos:      file_hawk_options_proto_msgTypes,
+		ExtensionInfos:    file_hawk_options_proto_extTypes,
+	}.Build()
+	File_hawk_options_proto = out.File
+	file_hawk_options_proto_rawDesc = nil
+	file_hawk_options_proto_goTypes = nil
+	file_hawk_options_proto_depIdxs = nil
+}
@@ -0,0 +1,39 @@
+package options
+
+import (
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"testing"
+)
+
+type OptionsTestSuite struct {
+	suite.Suite
+}
+
+func (s *OptionsTestSuite) TestExtensions() {
+	s.Equal("hawk.v1.service", string(E_Service.TypeDescriptor().FullName()))
+	s.Equal("google.protobuf.ServiceOptions", string(E_Service.TypeDescriptor().ContainingMessage().FullName()))
+	s.Equal("google.protobuf.MethodOptions", string(E_Method.TypeDescriptor().ContainingMessage().FullName()))
+	s.Equal("google.protobuf.FieldOptions", string(E_Field.TypeDescriptor().ContainingMessage().FullName()))
+}
+
+func (s *OptionsTestSuite) TestRoundTrip() {
+	in := &descriptorpb.MethodOptions{}
+	proto.SetExtension(in, E_Method, &MethodOptions{HttpCompress: proto.Bool(false), WebSocketEvent: true})
+
+	data, err := proto.Marshal(in)
+	s.Require().NoError(err)
+	out := &descriptorpb.MethodOptions{}
+	s.Require().NoError(proto.Unmarshal(data, out))
+
+	opts := proto.GetExtension(out, E_Method).(*MethodOptions)
+	s.False(opts.GetHttpCompress())
+	s.NotNil(opts.HttpCompress)
+	s.Nil(opts.WebSocket)
+	s.True(opts.GetWebSocketEvent())
+}
+
+func TestOptionsTestSuite(t *testing.T) {
+	suite.Run(t, new(OptionsTestSuite))
+}
@@ -0,0 +1,15 @@
+// Package ws contains the Go types of hawk/envelope.proto, the envelope of the WebSocket subprotocol `hawk.proto`.
+// The envelope is encoded by proto.Marshal:
+//
+//	b, err := proto.Marshal(&ws.Envelope{Method: "Get", RequestId: id, Data: data})
+package ws
+
+//go:generate protoc -I=../../proto --go_out=. --go_opt=module=github.com/niiigoo/hawk/pkg/ws hawk/envelope.proto
+
+// Subprotocols negotiated by the header `Sec-WebSocket-Protocol`
+const (
+	// SubprotocolJSON encodes the messages as JSON text frames, the payloads are encoded by protojson
+	SubprotocolJSON = "hawk.json"
+	// SubprotocolProto encodes the messages as binary frames containing an Envelope, the payloads are encoded as protobuf
+	SubprotocolProto = "hawk.proto"
+)
@@ -0,0 +1,60 @@
+package ws
+
+import (
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"testing"
+)
+
+type EnvelopeTestSuite struct {
+	suite.Suite
+}
+
+func (s *EnvelopeTestSuite) TestRoundTrip() {
+	in := &Envelope{
+		Method:    "Chat",
+		Data:      []byte{0x0a, 0x02, 'h', 'i'},
+		Command:   "end",
+		RequestId: "1",
+		Status:    200,
+		Topic:     "orders",
+		Error:     []byte(`{"error":"denied"}`),
+	}
+
+	b, err := proto.Marshal(in)
+	s.Require().NoError(err)
+	out := &Envelope{}
+	s.Require().NoError(proto.Unmarshal(b, out))
+	s.True(proto.Equal(in, out))
+}
+
+func (s *EnvelopeTestSuite) TestWireFormat() {
+	b, err := proto.Marshal(&Envelope{Method: "A", Status: 200})
+	s.Require().NoError(err)
+	s.Equal([]byte{0x0a, 0x01, 'A', 0x28, 0xc8, 0x01}, b)
+
+	b, err = proto.Marshal(&Envelope{})
+	s.Require().NoError(err)
+	s.Empty(b)
+}
+
+func (s *EnvelopeTestSuite) TestUnknownFields() {
+	b := protowire.AppendTag(nil, 20, protowire.VarintType)
+	b = protowire.AppendVarint(b, 42)
+	b = protowire.AppendTag(b, 1, protowire.BytesType)
+	b = protowire.AppendString(b, "Get")
+
+	e := &Envelope{}
+	s.Require().NoError(proto.Unmarshal(b, e))
+	s.Equal("Get", e.Method)
+}
+
+func (s *EnvelopeTestSuite) TestInvalid() {
+	s.Error(proto.Unmarshal([]byte{0x0a, 0x05, 'A'}, &Envelope{}))
+	s.Error(proto.Unmarshal([]byte{0x28}, &Envelope{}))
+}
+
+func TestEnvelopeTestSuite(t *testing.T) {
+	suite.Run(t, &EnvelopeTestSuite{})
+}
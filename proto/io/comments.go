@@ -0,0 +1,211 @@
+package io
+
+import (
+	"github.com/alecthomas/participle/v2/lexer"
+	"strings"
+	"text/scanner"
+)
+
+// Comments holds the comments attached to an element of the definition
+type Comments struct {
+	// Leading is the comment block directly above the element
+	Leading string
+	// Trailing is the comment on the same line behind the element
+	Trailing string
+}
+
+// String returns the leading and trailing comment separated by a new line
+func (c Comments) String() string {
+	if c.Leading != "" && c.Trailing != "" {
+		return c.Leading + "\n" + c.Trailing
+	}
+	return c.Leading + c.Trailing
+}
+
+// commentAttacher assigns the comments of the token stream to the elements of the definition
+type commentAttacher struct {
+	tokens []lexer.Token
+	// index maps the offset of a token to its index in tokens
+	index map[int]int
+}
+
+func attachComments(p *Proto, filename, data string) error {
+	l, err := lex.Lex(filename, strings.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	c := commentAttacher{
+		tokens: make([]lexer.Token, 0),
+		index:  make(map[int]int),
+	}
+	for {
+		t, err := l.Next()
+		if err != nil {
+			return err
+		}
+		c.index[t.Pos.Offset] = len(c.tokens)
+		c.tokens = append(c.tokens, t)
+		if t.EOF() {
+			break
+		}
+	}
+
+	for _, entry := range p.Entries {
+		if entry.Message != nil {
+			c.message(entry.Message)
+		} else if entry.Enum != nil {
+			c.enum(entry.Enum)
+		} else if entry.Service != nil {
+			c.service(entry.Service)
+		}
+	}
+	return nil
+}
+
+func (c commentAttacher) service(s *Service) {
+	s.Comments = c.blockComments(s.Pos, s.EndPos)
+	for _, entry := range s.Entries {
+		if entry.Method != nil {
+			entry.Method.Comments = c.comments(entry.Method.Pos, entry.Method.EndPos)
+		}
+	}
+}
+
+func (c commentAttacher) message(m *Message) {
+	m.Comments = c.blockComments(m.Pos, m.EndPos)
+	for _, entry := range m.Entries {
+		if entry.Field != nil {
+			entry.Field.Comments = c.comments(entry.Field.Pos, entry.Field.EndPos)
+		} else if entry.Message != nil {
+			c.message(entry.Message)
+		} else if entry.Enum != nil {
+			c.enum(entry.Enum)
+		} else if entry.OneOf != nil {
+			entry.OneOf.Comments = c.blockComments(entry.OneOf.Pos, entry.OneOf.EndPos)
+			for _, e := range entry.OneOf.Entries {
+				if e.Field != nil {
+					e.Field.Comments = c.comments(e.Field.Pos, e.Field.EndPos)
+				}
+			}
+		}
+	}
+}
+
+func (c commentAttacher) enum(e *Enum) {
+	e.Comments = c.blockComments(e.Pos, e.EndPos)
+	for _, entry := range e.Values {
+		if entry.Value != nil {
+			entry.Value.Comments = c.comments(entry.Value.Pos, entry.Value.EndPos)
+		}
+	}
+}
+
+// comments returns the comments of the element starting at pos and ending in front of end
+func (c commentAttacher) comments(pos, end lexer.Position) Comments {
+	start, ok := c.index[pos.Offset]
+	if !ok {
+		return Comments{}
+	}
+	stop, ok := c.index[end.Offset]
+	if !ok {
+		return Comments{}
+	}
+
+	return Comments{
+		Leading:  c.leading(start),
+		Trailing: c.trailing(stop),
+	}
+}
+
+// blockComments returns the comments of an element enclosed by braces. Like protoc, the trailing
+// comment is taken from behind the opening brace, the one behind the closing brace is the fallback.
+func (c commentAttacher) blockComments(pos, end lexer.Position) Comments {
+	comments := c.comments(pos, end)
+	start, ok := c.index[pos.Offset]
+	if !ok {
+		return comments
+	}
+	for i := start; i < len(c.tokens) && c.tokens[i].Pos.Offset < end.Offset; i++ {
+		if c.tokens[i].Value == "{" {
+			if trailing := c.trailing(i + 1); trailing != "" {
+				comments.Trailing = trailing
+			}
+			break
+		}
+	}
+	return comments
+}
+
+// leading collects the block of comments directly above the token at index i.
+// A comment behind another token belongs to that token and ends the block.
+func (c commentAttacher) leading(i int) string {
+	line := c.tokens[i].Pos.Line
+	lines := make([]string, 0)
+	for j := i - 1; j >= 0; j-- {
+		t := c.tokens[j]
+		if t.Type != scanner.Comment || endLine(t) != line-1 {
+			break
+		}
+		if j > 0 && c.tokens[j-1].Pos.Line == t.Pos.Line {
+			break
+		}
+		lines = append(commentText(t.Value), lines...)
+		line = t.Pos.Line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// trailing returns the comment on the same line behind the element ending in front of the token at index i.
+// Separators (`;` and `,`) between the element and the comment are skipped.
+func (c commentAttacher) trailing(i int) string {
+	last := i - 1
+	for last >= 0 && c.tokens[last].Type == scanner.Comment {
+		last--
+	}
+	if last < 0 {
+		return ""
+	}
+	line := endLine(c.tokens[last])
+
+	for j := last + 1; j < len(c.tokens); j++ {
+		t := c.tokens[j]
+		if t.Pos.Line != line {
+			break
+		}
+		if t.Type == scanner.Comment {
+			return strings.Join(commentText(t.Value), "\n")
+		}
+		if t.Value != ";" && t.Value != "," {
+			break
+		}
+	}
+	return ""
+}
+
+// endLine returns the line the token ends on
+func endLine(t lexer.Token) int {
+	return t.Pos.Line + strings.Count(t.Value, "\n")
+}
+
+// commentText removes the comment markers and returns the lines of the comment
+func commentText(comment string) []string {
+	if strings.HasPrefix(comment, "//") {
+		return []string{strings.TrimSpace(strings.TrimPrefix(comment, "//"))}
+	}
+
+	comment = strings.TrimSuffix(strings.TrimPrefix(comment, "/*"), "*/")
+	lines := make([]string, 0)
+	for _, line := range strings.Split(comment, "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimSpace(strings.TrimPrefix(line, "*"))
+		if line == "" && len(lines) == 0 {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
@@ -4,8 +4,7 @@ import (
 	"github.com/alecthomas/participle/v2"
 	"github.com/alecthomas/participle/v2/lexer"
 	"io"
-	"regexp"
-	"strings"
+	"text/scanner"
 )
 
 type Boolean bool
@@ -26,7 +25,7 @@ type Entry struct {
 
 	Syntax  string   `parser:"  'syntax' '=' @String"`
 	Package string   `parser:"| 'package' @(Ident ( '.' Ident )*)"`
-	Import  string   `parser:"| 'import' @String"`
+	Import  string   `parser:"| 'import' ( 'public' | 'weak' )? @String"`
 	Message *Message `parser:"| @@"`
 	Service *Service `parser:"| @@"`
 	Enum    *Enum    `parser:"| @@"`
@@ -37,9 +36,11 @@ type Entry struct {
 type Option struct {
 	Pos lexer.Position
 
-	Name  string  `parser:"( '(' @Ident @( '.' Ident )* ')' | @Ident @( '.' @Ident )* )"`
-	Attr  *string `parser:"( '.' @Ident ( '.' @Ident )* )?"`
-	Value *Value  `parser:"'=' @@"`
+	// Custom is set if the name is given in parentheses, i.e. the option is declared by an extension
+	Custom bool    `parser:"( @'('"`
+	Name   string  `parser:"  @Ident @( '.' Ident )* ')' | @Ident @( '.' @Ident )* )"`
+	Attr   *string `parser:"( '.' @Ident ( '.' @Ident )* )?"`
+	Value  *Value  `parser:"'=' @@"`
 }
 
 type Value struct {
@@ -47,7 +48,7 @@ type Value struct {
 
 	String    *string  `parser:"  @String"`
 	Number    *float64 `parser:"| @Float"`
-	Int       *int64   `parser:"| '-'?@Int"`
+	Int       *int64   `parser:"| @('-'? Int)"`
 	Bool      *Boolean `parser:"| @('true' | 'false')"`
 	Reference *string  `parser:"| @Ident @( '.' Ident )*"`
 	Map       *Map     `parser:"| @@"`
@@ -92,15 +93,31 @@ type Range struct {
 	Max   bool   `parser:"           | @'max' ) )? )"`
 }
 
+// Contains reports whether the tag is part of the range of numbers
+func (r Range) Contains(tag int) bool {
+	if r.Ident != "" || tag < r.Start {
+		return false
+	}
+	if r.Max {
+		return true
+	}
+	if r.End == nil {
+		return tag == r.Start
+	}
+	return tag <= *r.End
+}
+
 type Extend struct {
 	Pos lexer.Position
 
-	Reference string   `parser:"'extend' @Ident ( '.' @Ident )*"`
+	Reference string   `parser:"'extend' @('.'? Ident ( '.' Ident )*)"`
 	Fields    []*Field `parser:"'{' ( @@ ';'? )* '}'"`
 }
 
 type Service struct {
-	Pos lexer.Position
+	Pos      lexer.Position
+	EndPos   lexer.Position
+	Comments Comments
 
 	Name    string          `parser:"'service' @Ident"`
 	Entries []*ServiceEntry `parser:"'{' ( @@ ';'? )* '}'"`
@@ -114,7 +131,9 @@ type ServiceEntry struct {
 }
 
 type Method struct {
-	Pos lexer.Position
+	Pos      lexer.Position
+	EndPos   lexer.Position
+	Comments Comments
 
 	Name              string    `parser:"'rpc' @Ident"`
 	StreamingRequest  bool      `parser:"'(' @'stream'?"`
@@ -125,7 +144,9 @@ type Method struct {
 }
 
 type Enum struct {
-	Pos lexer.Position
+	Pos      lexer.Position
+	EndPos   lexer.Position
+	Comments Comments
 
 	Name   string       `parser:"'enum' @Ident"`
 	Values []*EnumEntry `parser:"'{' ( @@ ( ';' )* )* '}'"`
@@ -139,7 +160,9 @@ type EnumEntry struct {
 }
 
 type EnumValue struct {
-	Pos lexer.Position
+	Pos      lexer.Position
+	EndPos   lexer.Position
+	Comments Comments
 
 	Key   string `parser:"@Ident"`
 	Value int    `parser:"'=' @( [ '-' ] Int )"`
@@ -148,7 +171,9 @@ type EnumValue struct {
 }
 
 type Message struct {
-	Pos lexer.Position
+	Pos      lexer.Position
+	EndPos   lexer.Position
+	Comments Comments
 
 	Name    string          `parser:"'message' @Ident"`
 	Entries []*MessageEntry `parser:"'{' @@* '}'"`
@@ -168,7 +193,9 @@ type MessageEntry struct {
 }
 
 type OneOf struct {
-	Pos lexer.Position
+	Pos      lexer.Position
+	EndPos   lexer.Position
+	Comments Comments
 
 	Name    string        `parser:"'oneof' @Ident"`
 	Entries []*OneOfEntry `parser:"'{' ( @@ ';'* )* '}'"`
@@ -182,7 +209,9 @@ type OneOfEntry struct {
 }
 
 type Field struct {
-	Pos lexer.Position
+	Pos      lexer.Position
+	EndPos   lexer.Position
+	Comments Comments
 
 	Optional bool `parser:"(   @'optional'"`
 	Required bool `parser:"  | @'required'"`
@@ -246,7 +275,7 @@ type Type struct {
 
 	Scalar    Scalar   `parser:"  @@"`
 	Map       *MapType `parser:"| @@"`
-	Reference string   `parser:"| @(Ident ( '.' Ident )*)"`
+	Reference string   `parser:"| @('.'? Ident ( '.' Ident )*)"`
 }
 
 type MapType struct {
@@ -256,121 +285,35 @@ type MapType struct {
 	Value *Type `parser:"',' @@ '>'"`
 }
 
-type Path struct {
-	Segments []Segment
-	//SegmentsRaw []string `parser:"'/' (@Element|@Ident)+ ('/' (@Element|@Ident)+)* (?= (':' Ident)?)"`
-	Verb *string `parser:"(':' (@Element|@Ident)+)?"`
-}
-
-type Segment struct {
-	Wildcard *string
-	Literal  *string
-	Variable *Variable
-}
-
-type Variable struct {
-	Field    string   `parser:"@Ident"`
-	Segments []string `parser:"('=' @Ident ('/' @Ident)*)?"`
-	Pattern  *string  `parser:"(':' (@Ident|@Element|@Symbol)+)?"`
-}
-
 var (
-	parser = participle.MustBuild[Proto](participle.Unquote("String"), participle.UseLookahead(2))
-	//parserPath = participle.MustBuild[Path](participle.Lexer(lexer.MustSimple([]lexer.SimpleRule{
-	//	{"Ident", `[a-zA-Z_][a-zA-Z0-9_-]*`},
-	//	{"Symbol", `[/:]`},
-	//	{"Element", `[^/:]+`},
-	//})))
-	//parserPathSegment = participle.MustBuild[Variable](participle.Lexer(lexer.MustSimple([]lexer.SimpleRule{
-	//	{"Ident", `[a-zA-Z_][a-zA-Z0-9_-]*`},
-	//	{"Symbol", `[=:]`},
-	//	{"Slash", `/`},
-	//	{"Element", `[^/]+`},
-	//})))
-	patternVerb = regexp.MustCompile(`(.*):([A-Za-z]+)$`)
+	// lex keeps the comments, they are elided by the parser and attached to the elements afterwards
+	lex = lexer.NewTextScannerLexer(func(s *scanner.Scanner) {
+		s.Mode &^= scanner.SkipComments
+	})
+	parser = participle.MustBuild[Proto](
+		participle.Lexer(lex),
+		participle.Elide("Comment"),
+		participle.Unquote("String"),
+		participle.UseLookahead(2),
+	)
 )
 
 func Parse(filename string, r io.Reader) (*Proto, error) {
-	return parser.Parse(filename, r)
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return ParseString(filename, string(data))
 }
 
 func ParseString(filename string, data string) (*Proto, error) {
-	return parser.ParseString(filename, data)
-}
-
-// TODO: improve this function
-func ParsePath(data string) (*Path, error) {
-	path := Path{
-		Segments: make([]Segment, 0),
-	}
-
-	matches := patternVerb.FindStringSubmatch(data)
-	if len(matches) > 0 {
-		path.Verb = &matches[2]
-		data = matches[1]
+	p, err := parser.ParseString(filename, data)
+	if err != nil {
+		return nil, err
 	}
-
-	parts := strings.Split(strings.TrimPrefix(data, "/"), "/")
-	for i := 0; i < len(parts); i++ {
-		s := parts[i]
-
-		if s == "*" || s == "**" {
-			path.Segments = append(path.Segments, Segment{
-				Wildcard: &s,
-			})
-		} else if strings.HasPrefix(s, "{") {
-			v := Variable{
-				Segments: make([]string, 0),
-			}
-			s = s[1:]
-			pos := strings.Index(s, "=")
-			segments := false
-			if pos >= 0 {
-				segments = true
-				v.Field = s[:pos]
-				s = s[pos+1:]
-				for {
-					pos = strings.Index(s, "}")
-					if pos >= 0 {
-						break
-					}
-					if len(s) > 0 {
-						v.Segments = append(v.Segments, s)
-					}
-					i++
-					s = parts[i]
-				}
-			}
-			s = s[:len(s)-1]
-			if len(s) > 0 {
-				pos = strings.Index(s, ":")
-				if pos < 0 {
-					if segments {
-						v.Segments = append(v.Segments, s)
-					} else {
-						v.Field = s
-					}
-				} else {
-					if pos > 0 {
-						if segments {
-							v.Segments = append(v.Segments, s[:pos])
-						} else {
-							v.Field = s[:pos]
-						}
-					}
-					p := s[pos+1:]
-					v.Pattern = &p
-				}
-			}
-			path.Segments = append(path.Segments, Segment{
-				Variable: &v,
-			})
-		} else {
-			path.Segments = append(path.Segments, Segment{
-				Literal: &s,
-			})
-		}
+	err = attachComments(p, filename, data)
+	if err != nil {
+		return nil, err
 	}
-
-	return &path, nil
+	return p, nil
 }
@@ -126,3 +126,107 @@ func (s *ParserTestSuite) TestParsePath_Complex() {
 	s.Equal("**", *p.Segments[4].Wildcard)
 	s.Require().Len(p.Segments[3].Variable.Segments, 2)
 }
+
+func (s *ParserTestSuite) TestParsePath_VariableVerb() {
+	path := `/v1/users/{user.id}:activate`
+
+	p, err := ParsePath(path)
+
+	s.Require().NoError(err)
+	s.Equal("activate", *p.Verb)
+	s.Require().Len(p.Segments, 3)
+	s.Equal("user.id", p.Segments[2].Variable.Field)
+	s.False(p.Segments[2].Variable.MultiSegment())
+}
+
+func (s *ParserTestSuite) TestParsePath_VariableMultiSegment() {
+	path := `/v1/{name=shelves/*/books/**}`
+
+	p, err := ParsePath(path)
+
+	s.Require().NoError(err)
+	s.Empty(p.Verb)
+	s.Require().Len(p.Segments, 2)
+	s.Equal([]string{"shelves", "*", "books", "**"}, p.Segments[1].Variable.Segments)
+	s.True(p.Segments[1].Variable.MultiSegment())
+}
+
+func (s *ParserTestSuite) TestParsePath_Invalid() {
+	for path, msg := range map[string]string{
+		`v1/entity`:            "has to start with `/`",
+		`/v1//entity`:          "empty segment",
+		`/v1/entity/`:          "empty segment",
+		`/v1/{id`:              "missing `}` of variable `id`",
+		`/v1/{id:[0-9]{1,3}`:   "unbalanced braces in pattern",
+		`/v1/id}`:              "unexpected `}`",
+		`/v1/{a={b}}`:          "nested variables are not allowed",
+		`/v1/{1id}`:            "invalid field path `1id`",
+		`/v1/**/entity`:        "`**` has to be the last segment",
+		`/v1/{name=**}/entity`: "variable `name` containing `**` has to be the last segment",
+		`/v1/{name=**/a}`:      "`**` has to be the last segment of variable `name`",
+		`/v1/{id}/{id}`:        "variable `id` is defined multiple times",
+		`/v1/entity:`:          "verb expected after `:`",
+	} {
+		_, err := ParsePath(path)
+
+		s.Require().Error(err, path)
+		s.Contains(err.Error(), msg, path)
+	}
+}
+
+func (s *ParserTestSuite) TestParse_Comments() {
+	data := `syntax = "proto3";
+
+// Sample service
+// second line
+service Sample { // trailing service
+	/* Get a user
+	 * by id */
+	rpc GetUser(Request) returns (Request); // trailing method
+}
+
+// detached
+
+// The request
+message Request {
+	string id = 1; // the id
+	// leading of name
+	string name = 2 [deprecated = true];
+	oneof selector { // oneof
+		string a = 3;
+	}
+}
+
+enum Role {
+	// zero
+	ROLE_UNKNOWN = 0; // unknown
+}
+`
+
+	p, err := ParseString("", data)
+
+	s.Require().NoError(err)
+	s.Require().Len(p.Entries, 4)
+
+	service := p.Entries[1].Service
+	s.Require().NotNil(service)
+	s.Equal("Sample service\nsecond line", service.Comments.Leading)
+	s.Equal("trailing service", service.Comments.Trailing)
+	s.Equal("Get a user\nby id", service.Entries[0].Method.Comments.Leading)
+	s.Equal("trailing method", service.Entries[0].Method.Comments.Trailing)
+
+	message := p.Entries[2].Message
+	s.Require().NotNil(message)
+	s.Equal("The request", message.Comments.Leading)
+	s.Empty(message.Comments.Trailing)
+	s.Empty(message.Entries[0].Field.Comments.Leading)
+	s.Equal("the id", message.Entries[0].Field.Comments.Trailing)
+	s.Equal("leading of name", message.Entries[1].Field.Comments.Leading)
+	s.Empty(message.Entries[1].Field.Comments.Trailing)
+	s.Equal("oneof", message.Entries[2].OneOf.Comments.Trailing)
+
+	enum := p.Entries[3].Enum
+	s.Require().NotNil(enum)
+	s.Equal("zero", enum.Values[0].Value.Comments.Leading)
+	s.Equal("unknown", enum.Values[0].Value.Comments.Trailing)
+}
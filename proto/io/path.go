@@ -0,0 +1,279 @@
+package io
+
+import (
+	"fmt"
+	"github.com/pkg/errors"
+	"strings"
+)
+
+// Path is the parsed path template of a `google.api.http` binding:
+//
+//	Template = "/" Segments [ Verb ] ;
+//	Segments = Segment { "/" Segment } ;
+//	Segment  = "*" | "**" | LITERAL | Variable ;
+//	Variable = "{" FieldPath [ "=" Segments ] [ ":" Pattern ] "}" ;
+//	FieldPath = IDENT { "." IDENT } ;
+//	Verb     = ":" LITERAL ;
+//
+// The pattern of a variable is an extension of hawk, it is a regular expression the value has to match.
+type Path struct {
+	Segments []Segment
+	Verb     *string
+}
+
+type Segment struct {
+	Wildcard *string
+	Literal  *string
+	Variable *Variable
+}
+
+type Variable struct {
+	// Field is the path of the field, nested fields are separated by dots
+	Field string
+	// Segments are the literals and wildcards the value has to match, `*` if empty
+	Segments []string
+	Pattern  *string
+}
+
+// MultiSegment reports whether the value of the variable may contain slashes
+func (v *Variable) MultiSegment() bool {
+	if len(v.Segments) > 1 {
+		return true
+	}
+	return len(v.Segments) == 1 && v.Segments[0] == "**"
+}
+
+// pathParser is a recursive descent parser of path templates
+type pathParser struct {
+	data string
+	pos  int
+}
+
+// ParsePath parses the path template, see Path for the grammar
+func ParsePath(data string) (*Path, error) {
+	p := &pathParser{data: data}
+	path, err := p.template()
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid path template `%s`", data)
+	}
+	return path, nil
+}
+
+func (p *pathParser) errorf(format string, args ...interface{}) error {
+	return errors.New(fmt.Sprintf("column %d: ", p.pos+1) + fmt.Sprintf(format, args...))
+}
+
+func (p *pathParser) peek() byte {
+	if p.pos >= len(p.data) {
+		return 0
+	}
+	return p.data[p.pos]
+}
+
+func (p *pathParser) template() (*Path, error) {
+	path := &Path{
+		Segments: make([]Segment, 0),
+	}
+	if p.peek() != '/' {
+		return nil, p.errorf("has to start with `/`")
+	}
+	p.pos++
+
+	for {
+		s, err := p.segment()
+		if err != nil {
+			return nil, err
+		}
+		path.Segments = append(path.Segments, s)
+		if p.peek() != '/' {
+			break
+		}
+		p.pos++
+	}
+
+	if p.peek() == ':' {
+		p.pos++
+		verb := p.literal()
+		if verb == "" {
+			return nil, p.errorf("verb expected after `:`")
+		}
+		path.Verb = &verb
+	}
+	if p.pos < len(p.data) {
+		return nil, p.errorf("unexpected `%c`", p.peek())
+	}
+
+	return path, path.validate()
+}
+
+func (p *pathParser) segment() (Segment, error) {
+	switch p.peek() {
+	case '*':
+		wildcard := p.wildcard()
+		return Segment{Wildcard: &wildcard}, nil
+	case '{':
+		v, err := p.variable()
+		if err != nil {
+			return Segment{}, err
+		}
+		return Segment{Variable: v}, nil
+	}
+	literal := p.literal()
+	if literal == "" {
+		if p.peek() == 0 || p.peek() == '/' {
+			return Segment{}, p.errorf("empty segment")
+		}
+		return Segment{}, p.errorf("unexpected `%c`", p.peek())
+	}
+	return Segment{Literal: &literal}, nil
+}
+
+func (p *pathParser) wildcard() string {
+	if strings.HasPrefix(p.data[p.pos:], "**") {
+		p.pos += 2
+		return "**"
+	}
+	p.pos++
+	return "*"
+}
+
+// literal consumes the unreserved and percent-encoded characters of a segment
+func (p *pathParser) literal() string {
+	start := p.pos
+	for p.pos < len(p.data) {
+		c := p.data[p.pos]
+		if c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9' ||
+			c == '-' || c == '.' || c == '_' || c == '~' || c == '%' {
+			p.pos++
+			continue
+		}
+		break
+	}
+	return p.data[start:p.pos]
+}
+
+func (p *pathParser) variable() (*Variable, error) {
+	p.pos++ // {
+	v := &Variable{
+		Segments: make([]string, 0),
+	}
+
+	start := p.pos
+	for p.pos < len(p.data) && p.data[p.pos] != '=' && p.data[p.pos] != ':' && p.data[p.pos] != '}' {
+		p.pos++
+	}
+	v.Field = p.data[start:p.pos]
+	if !validFieldPath(v.Field) {
+		p.pos = start
+		return nil, p.errorf("invalid field path `%s`", v.Field)
+	}
+
+	if p.peek() == '=' {
+		p.pos++
+		for {
+			switch p.peek() {
+			case '*':
+				v.Segments = append(v.Segments, p.wildcard())
+			case '{':
+				return nil, p.errorf("nested variables are not allowed")
+			default:
+				literal := p.literal()
+				if literal == "" {
+					return nil, p.errorf("segment of variable `%s` expected", v.Field)
+				}
+				v.Segments = append(v.Segments, literal)
+			}
+			if p.peek() != '/' {
+				break
+			}
+			p.pos++
+		}
+	}
+
+	if p.peek() == ':' {
+		p.pos++
+		pattern, err := p.pattern()
+		if err != nil {
+			return nil, err
+		}
+		v.Pattern = &pattern
+	}
+
+	if p.peek() != '}' {
+		if p.peek() == 0 {
+			return nil, p.errorf("missing `}` of variable `%s`", v.Field)
+		}
+		return nil, p.errorf("unexpected `%c` in variable `%s`", p.peek(), v.Field)
+	}
+	p.pos++
+
+	for i, s := range v.Segments {
+		if s == "**" && i < len(v.Segments)-1 {
+			return nil, p.errorf("`**` has to be the last segment of variable `%s`", v.Field)
+		}
+	}
+	return v, nil
+}
+
+// pattern consumes the regular expression up to the closing brace of the variable, braces have to be balanced
+func (p *pathParser) pattern() (string, error) {
+	start := p.pos
+	depth := 0
+	for p.pos < len(p.data) {
+		switch p.data[p.pos] {
+		case '\\':
+			p.pos++
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				if p.pos == start {
+					return "", p.errorf("empty pattern")
+				}
+				return p.data[start:p.pos], nil
+			}
+			depth--
+		}
+		p.pos++
+	}
+	return "", p.errorf("unbalanced braces in pattern")
+}
+
+// validate checks the rules spanning multiple segments
+func (path *Path) validate() error {
+	fields := make(map[string]bool)
+	for i, s := range path.Segments {
+		last := i == len(path.Segments)-1
+		if s.Wildcard != nil && *s.Wildcard == "**" && !last {
+			return errors.New("`**` has to be the last segment")
+		}
+		if s.Variable == nil {
+			continue
+		}
+		if fields[s.Variable.Field] {
+			return errors.New("variable `" + s.Variable.Field + "` is defined multiple times")
+		}
+		fields[s.Variable.Field] = true
+		if len(s.Variable.Segments) > 0 && s.Variable.Segments[len(s.Variable.Segments)-1] == "**" && !last {
+			return errors.New("variable `" + s.Variable.Field + "` containing `**` has to be the last segment")
+		}
+	}
+	return nil
+}
+
+func validFieldPath(field string) bool {
+	if field == "" {
+		return false
+	}
+	for _, ident := range strings.Split(field, ".") {
+		if ident == "" {
+			return false
+		}
+		for i, c := range ident {
+			if !(c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_' || i > 0 && c >= '0' && c <= '9') {
+				return false
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,320 @@
+package proto
+
+import (
+	"errors"
+	"fmt"
+	"github.com/alecthomas/participle/v2"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/niiigoo/hawk/proto/io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Severity of an issue, errors prevent the generation while warnings may result in code failing to compile
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Rules checked by Lint
+const (
+	// RuleSyntax reports syntax errors of the file or its imports
+	RuleSyntax = "syntax"
+	// RuleDefinition reports the errors of the definition, e.g. an unknown path parameter
+	RuleDefinition = "definition"
+	// RuleStreamingBinding reports HTTP bindings of client and bidirectional streaming methods
+	RuleStreamingBinding = "streaming-binding"
+	// RuleRepeatedPathParam reports repeated fields bound to the path
+	RuleRepeatedPathParam = "repeated-path-param"
+	// RuleNonScalarParam reports path and query parameters without a text representation
+	RuleNonScalarParam = "non-scalar-param"
+	// RuleDuplicateRoute reports HTTP bindings matching the same requests
+	RuleDuplicateRoute = "duplicate-route"
+	// RuleUnknownConfigKey reports keys of the option `(config)` which are ignored
+	RuleUnknownConfigKey = "unknown-config-key"
+	// RuleDeprecatedOption reports the options declared by the proto files before hawk/options.proto existed
+	RuleDeprecatedOption = "deprecated-option"
+	// RuleReservedField reports fields using a reserved tag or name
+	RuleReservedField = "reserved-field"
+	// RuleDuplicateTag reports fields of a message sharing a tag
+	RuleDuplicateTag = "duplicate-tag"
+)
+
+// Issue is a violation of a rule found by Lint
+type Issue struct {
+	File     string   `json:"file"`
+	Line     int      `json:"line"`
+	Column   int      `json:"column"`
+	Severity Severity `json:"severity"`
+	Rule     string   `json:"rule"`
+	Message  string   `json:"message"`
+}
+
+// String formats the issue like compilers do, e.g. `sample.proto:12:3: error: message (rule)`
+func (i *Issue) String() string {
+	pos := fmt.Sprintf("%d:%d", i.Line, i.Column)
+	if i.File != "" {
+		pos = i.File + ":" + pos
+	}
+	return fmt.Sprintf("%s: %s: %s (%s)", pos, i.Severity, i.Message, i.Rule)
+}
+
+// Lint checks the parsed file against the rules of hawk without compiling it. Unlike DefinitionFromProto it
+// continues after an error, the issues are sorted by their position.
+func Lint(data *io.Proto, imports ...*File) []*Issue {
+	l := &linter{
+		def:    newDefinition(data, imports...),
+		issues: deprecatedOptions(data),
+	}
+	for _, err := range l.def.undeclaredOptions() {
+		l.report(lexer.Position{}, err)
+	}
+
+	services := make([]*Service, 0, len(l.def.services))
+	for _, service := range l.def.services {
+		services = append(services, l.service(service))
+	}
+	l.report(lexer.Position{}, prefixServices(services))
+	l.routes(services)
+
+	for _, msg := range l.def.messages {
+		l.message(msg)
+	}
+
+	sort.SliceStable(l.issues, func(i, j int) bool {
+		a, b := l.issues[i], l.issues[j]
+		if a.File != b.File {
+			return a.File < b.File
+		} else if a.Line != b.Line {
+			return a.Line < b.Line
+		}
+		return a.Column < b.Column
+	})
+	return l.issues
+}
+
+// syntaxIssue returns the issue of a syntax error, false if the error is not caused by the parser
+func syntaxIssue(err error) (*Issue, bool) {
+	var parseErr participle.Error
+	if !errors.As(err, &parseErr) {
+		return nil, false
+	}
+	return newIssue(parseErr.Position(), SeverityError, RuleSyntax, parseErr.Message()), true
+}
+
+func newIssue(pos lexer.Position, severity Severity, rule, msg string) *Issue {
+	return &Issue{
+		File:     pos.Filename,
+		Line:     pos.Line,
+		Column:   pos.Column,
+		Severity: severity,
+		Rule:     rule,
+		Message:  msg,
+	}
+}
+
+type linter struct {
+	def    *Definition
+	issues []*Issue
+}
+
+func (l *linter) add(pos lexer.Position, severity Severity, rule, msg string) {
+	l.issues = append(l.issues, newIssue(pos, severity, rule, msg))
+}
+
+// report adds the error of the definition, it is located at pos unless the error has a position
+func (l *linter) report(pos lexer.Position, err error) bool {
+	if err == nil {
+		return false
+	}
+	msg := err.Error()
+	var located *PositionError
+	if errors.As(err, &located) {
+		pos, msg = located.Pos, located.Err.Error()
+	}
+	l.add(pos, SeverityError, RuleDefinition, msg)
+	return true
+}
+
+// service evaluates the service like serviceFromProto, invalid methods are reported and skipped
+func (l *linter) service(service *io.Service) *Service {
+	s := &Service{
+		Service: service,
+		Name:    service.Name,
+		Methods: make([]*Method, 0),
+	}
+	for _, entry := range service.Entries {
+		if entry.Option != nil {
+			if entry.Option.Name == "config" {
+				l.config(entry.Option)
+			}
+			l.report(entry.Option.Pos, s.parseOption(entry.Option))
+		} else if entry.Method != nil {
+			if l.streamingBinding(entry.Method) {
+				continue
+			}
+			m, err := l.def.methodFromProto(s, entry.Method)
+			if l.report(entry.Method.Pos, err) {
+				continue
+			}
+			if m.Event {
+				if !l.report(m.Pos, m.checkEvent(l.def)) {
+					s.Events = append(s.Events, m)
+				}
+				continue
+			}
+			if l.report(m.Pos, m.CheckParams(l.def)) {
+				continue
+			}
+			l.params(m)
+			s.Methods = append(s.Methods, m)
+		}
+	}
+	if s.HttpPrefix != "" && s.WSPath != "" {
+		s.WSPath = path.Join(s.HttpPrefix, s.WSPath)
+	}
+	return s
+}
+
+// config reports the keys of the option `(config)` which are not evaluated by hawk
+func (l *linter) config(option *io.Option) {
+	if option.Value == nil || option.Value.Map == nil {
+		return
+	}
+	for _, entry := range option.Value.Map.Entries {
+		if entry.Key == nil || entry.Key.Reference == nil {
+			continue
+		}
+		if _, ok := configKeys[*entry.Key.Reference]; !ok {
+			l.add(entry.Pos, SeverityWarning, RuleUnknownConfigKey,
+				fmt.Sprintf("unknown key `%s` of `(config)` is ignored", *entry.Key.Reference))
+		}
+	}
+}
+
+// streamingBinding reports the HTTP bindings of a method streaming the requests
+func (l *linter) streamingBinding(method *io.Method) bool {
+	found := false
+	for _, option := range method.Options {
+		if option.Name == "google.api.http" && method.StreamingRequest {
+			l.add(option.Pos, SeverityError, RuleStreamingBinding, fmt.Sprintf("client and bidirectional streaming "+
+				"methods cannot have `google.api.http` option (method `%s`)", method.Name))
+			found = true
+		}
+	}
+	return found
+}
+
+// params reports the parameters of the bindings which are not supported outside the body
+func (l *linter) params(m *Method) {
+	for _, binding := range m.HttpBindings {
+		for _, p := range binding.Params {
+			if p.Location == LocationBody || p.Type == TypeOneOf {
+				continue
+			}
+			if p.Location == LocationPath && p.Repeated {
+				l.add(binding.Pos, SeverityWarning, RuleRepeatedPathParam, fmt.Sprintf("repeated field `%s` is "+
+					"not supported as path parameter (method `%s`)", p.FieldPath(), m.Name))
+			} else if !p.textual(p.Location) {
+				l.add(binding.Pos, SeverityWarning, RuleNonScalarParam, fmt.Sprintf("%s parameter `%s` is not a "+
+					"scalar, enum or well-known type, the generated code may fail to compile (method `%s`)",
+					p.Location, p.FieldPath(), m.Name))
+			}
+		}
+	}
+}
+
+// textual reports whether the value of the parameter can be given as text in the location
+func (p *Param) textual(location Location) bool {
+	switch {
+	case p.Type == TypeScalar || p.Type == TypeEnum:
+		return true
+	case p.WellKnown() != nil:
+		return !p.Repeated
+	}
+	return location == LocationQuery && p.StringMap()
+}
+
+// variableName matches the name of a variable of a gorilla/mux path, e.g. `{id:`
+var variableName = regexp.MustCompile(`\{[\w.]+:?`)
+
+// routes reports bindings matching the same requests as a previous binding
+func (l *linter) routes(services []*Service) {
+	routes := make(map[string]*OptionHttp)
+	for _, s := range services {
+		for _, m := range s.Methods {
+			for _, binding := range m.HttpBindings {
+				// variables match the same values regardless of their names
+				route := strings.ToUpper(binding.Method) + " " + variableName.ReplaceAllString(binding.GorillaMuxPath(), "{")
+				if other, ok := routes[route]; ok {
+					l.add(binding.Pos, SeverityError, RuleDuplicateRoute, fmt.Sprintf("route `%s %s` is already "+
+						"bound by method `%s` at %s", strings.ToUpper(binding.Method), binding.Template(),
+						other.Parent.Name, other.Pos))
+					continue
+				}
+				routes[route] = binding
+			}
+		}
+	}
+}
+
+// message reports the fields of the message and its nested messages reusing reserved or assigned tags
+func (l *linter) message(msg *io.Message) {
+	ranges := make([]io.Range, 0)
+	names := make(map[string]bool)
+	for _, entry := range msg.Entries {
+		if entry.Reserved == nil {
+			continue
+		}
+		for _, r := range entry.Reserved.Reserved {
+			if r.Ident != "" {
+				names[r.Ident] = true
+			} else {
+				ranges = append(ranges, r)
+			}
+		}
+	}
+
+	tags := make(map[int]*io.Field)
+	check := func(f *io.Field) {
+		for _, option := range f.Options {
+			_, err := hawkOption(option, fieldOptions)
+			l.report(option.Pos, err)
+		}
+		if names[f.Name] {
+			l.add(f.Pos, SeverityError, RuleReservedField, fmt.Sprintf("name of field `%s` is reserved (message `%s`)",
+				f.Name, msg.Name))
+		}
+		for _, r := range ranges {
+			if r.Contains(f.Tag) {
+				l.add(f.Pos, SeverityError, RuleReservedField, fmt.Sprintf("tag %d of field `%s` is reserved (message `%s`)",
+					f.Tag, f.Name, msg.Name))
+				break
+			}
+		}
+		if other, ok := tags[f.Tag]; ok {
+			l.add(f.Pos, SeverityError, RuleDuplicateTag, fmt.Sprintf("tag %d of field `%s` is already used by field "+
+				"`%s` (message `%s`)", f.Tag, f.Name, other.Name, msg.Name))
+			return
+		}
+		tags[f.Tag] = f
+	}
+
+	for _, entry := range msg.Entries {
+		if entry.Field != nil {
+			check(entry.Field)
+		} else if entry.OneOf != nil {
+			for _, e := range entry.OneOf.Entries {
+				if e.Field != nil {
+					check(e.Field)
+				}
+			}
+		} else if entry.Message != nil {
+			l.message(entry.Message)
+		}
+	}
+}
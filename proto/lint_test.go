@@ -0,0 +1,138 @@
+package proto
+
+import (
+	"github.com/niiigoo/hawk/proto/io"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const lintProto = `syntax = "proto3";
+
+package sample;
+
+message Filter {
+	map<string, int32> counts = 1;
+}
+
+message Request {
+	repeated string ids = 1;
+	Filter filter = 2;
+	repeated Filter filters = 3;
+	string name = 4;
+	reserved 5, 8 to 10, "old";
+	int32 size = 9;
+	string old = 11;
+	oneof kind {
+		string user = 4;
+	}
+	message Nested {
+		reserved 2 to max;
+		int64 id = 3;
+	}
+}
+
+service Sample {
+	option (config) = {
+		HttpPrefix: "/api"
+		HttpPrefx: "/v1"
+	};
+
+	rpc Get(Request) returns (Request) {
+		option (google.api.http) = {
+			get: "/items/{ids}"
+		};
+	}
+	rpc Find(Request) returns (Request) {
+		option (google.api.http) = {
+			get: "/items/{name}"
+		};
+	}
+	rpc Missing(Request) returns (Request) {
+		option (google.api.http) = {
+			get: "/missing/{id}"
+		};
+	}
+	rpc Upload(stream Request) returns (Request) {
+		option (google.api.http) = {
+			post: "/upload"
+		};
+	}
+}
+`
+
+type LintTestSuite struct {
+	suite.Suite
+}
+
+func TestLintTestSuite(t *testing.T) {
+	suite.Run(t, new(LintTestSuite))
+}
+
+func (s *LintTestSuite) lint(data string) []string {
+	p, err := io.ParseString("sample.proto", data)
+	s.Require().NoError(err)
+
+	issues := Lint(p)
+	result := make([]string, len(issues))
+	for i, issue := range issues {
+		result[i] = issue.String()
+	}
+	return result
+}
+
+func (s *LintTestSuite) TestLint() {
+	s.Equal([]string{
+		"sample.proto:15:2: error: tag 9 of field `size` is reserved (message `Request`) (reserved-field)",
+		"sample.proto:16:2: error: name of field `old` is reserved (message `Request`) (reserved-field)",
+		"sample.proto:18:3: error: tag 4 of field `user` is already used by field `name` (message `Request`) (duplicate-tag)",
+		"sample.proto:22:3: error: tag 3 of field `id` is reserved (message `Nested`) (reserved-field)",
+		"sample.proto:27:9: warning: option `(config)` is deprecated, use `(hawk.v1.service)` of `hawk/options.proto` " +
+			"(deprecated-option)",
+		"sample.proto:29:3: warning: unknown key `HttpPrefx` of `(config)` is ignored (unknown-config-key)",
+		"sample.proto:34:4: warning: repeated field `ids` is not supported as path parameter (method `Get`) (repeated-path-param)",
+		"sample.proto:34:4: warning: query parameter `filters` is not a scalar, enum or well-known type, " +
+			"the generated code may fail to compile (method `Get`) (non-scalar-param)",
+		"sample.proto:39:4: warning: query parameter `filters` is not a scalar, enum or well-known type, " +
+			"the generated code may fail to compile (method `Find`) (non-scalar-param)",
+		"sample.proto:39:4: error: route `GET /api/items/{name}` is already bound by method `Get` at sample.proto:34:4 (duplicate-route)",
+		"sample.proto:44:4: error: path parameter `id` not found (method `Missing`) (definition)",
+		"sample.proto:48:10: error: client and bidirectional streaming methods cannot have `google.api.http` option " +
+			"(method `Upload`) (streaming-binding)",
+	}, s.lint(lintProto))
+}
+
+func (s *LintTestSuite) TestLint_Valid() {
+	s.Empty(s.lint(`syntax = "proto3";
+message Request {
+	string id = 1;
+	repeated string tags = 2;
+	map<string, string> labels = 3;
+}
+service Sample {
+	rpc Get(Request) returns (Request) {
+		option (google.api.http) = {
+			get: "/items/{id}"
+			additional_bindings {
+				post: "/items/{id}"
+				body: "*"
+			}
+		};
+	}
+}`))
+}
+
+func (s *LintTestSuite) TestLint_Syntax() {
+	dir := s.T().TempDir()
+	file := filepath.Join(dir, "sample.proto")
+	s.Require().NoError(os.WriteFile(file, []byte("syntax = \"proto3\";\nmessage Request {\n\tstring id = ;\n}\n"), 0644))
+
+	issues, err := NewService().Lint(file)
+	s.Require().NoError(err)
+	s.Require().Len(issues, 1)
+	s.Equal(RuleSyntax, issues[0].Rule)
+	s.Equal(file, issues[0].File)
+	s.Equal(3, issues[0].Line)
+	s.Equal(SeverityError, issues[0].Severity)
+}
@@ -3,9 +3,12 @@ package proto
 import (
 	"errors"
 	"fmt"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/iancoleman/strcase"
 	"github.com/niiigoo/hawk/proto/io"
 	errors2 "github.com/pkg/errors"
 	"path"
+	"regexp"
 	"strings"
 )
 
@@ -31,15 +34,43 @@ const (
 	LocationBody           = "body"
 )
 
+// PositionError is an error located in the proto file
+type PositionError struct {
+	Pos lexer.Position
+	Err error
+}
+
+func (e *PositionError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Pos, e.Err)
+}
+
+func (e *PositionError) Unwrap() error {
+	return e.Err
+}
+
+// at locates the error at the position unless it is located already
+func at(pos lexer.Position, err error) error {
+	var located *PositionError
+	if err == nil || errors.As(err, &located) {
+		return err
+	}
+	return &PositionError{Pos: pos, Err: err}
+}
+
 type Definition struct {
-	syntax      string
-	pack        string
-	services    []*io.Service
-	imports     []string
-	enums       []*io.Enum
-	enumsMap    map[string]*io.Enum
-	messages    []*io.Message
-	messagesMap map[string]*io.Message
+	syntax   string
+	pack     string
+	goPack   string
+	services []*io.Service
+	imports  []string
+	files    []*File
+	symbols  map[string]*Symbol
+	// goAliases are the aliases of the Go packages of the imported types by their import path
+	goAliases map[string]string
+	// options are the custom options declared by the file and its imports by their full name
+	options  map[string]bool
+	enums    []*io.Enum
+	messages []*io.Message
 
 	Services []*Service
 }
@@ -48,16 +79,54 @@ func (d Definition) Package() string {
 	return d.pack
 }
 
+// Messages returns the top-level messages in order of their definition
+func (d Definition) Messages() []*io.Message {
+	return d.messages
+}
+
+// Message returns the message of the file with the given name, nested messages are separated by dots (e.g. `User.Address`)
+func (d Definition) Message(name string) (*io.Message, bool) {
+	s, ok := d.symbols[qualify(d.pack, name)]
+	if !ok || s.File != nil || s.Message == nil {
+		return nil, false
+	}
+	return s.Message, true
+}
+
+// Enums returns the top-level enums in order of their definition
+func (d Definition) Enums() []*io.Enum {
+	return d.enums
+}
+
+// Enum returns the enum of the file with the given name, nested enums are separated by dots (e.g. `User.Role`)
+func (d Definition) Enum(name string) (*io.Enum, bool) {
+	s, ok := d.symbols[qualify(d.pack, name)]
+	if !ok || s.File != nil || s.Enum == nil {
+		return nil, false
+	}
+	return s.Enum, true
+}
+
+// Imports returns the imported files which could be found in the include paths
+func (d Definition) Imports() []*File {
+	return d.files
+}
+
 type Service struct {
 	*io.Service
-	Name          string
-	HttpPrefix    string
-	Compressed    *bool
-	WSPath        string
-	WSDefault     *bool
-	WSMaxSize     uint
-	Methods       []*Method
-	QueryWithTime bool
+	Name       string
+	HttpPrefix string
+	Compressed *bool
+	WSPath     string
+	WSDefault  *bool
+	WSMaxSize  uint
+	Methods    []*Method
+	// Events are the methods with the option `(hawk.v1.method).web_socket_event`, the request is the payload pushed to the WebSocket
+	// clients. They are not served by any transport.
+	Events []*Method
+	// GoPrefix is prepended to the Go identifiers generated for the service.
+	// It is empty unless the definition contains multiple services.
+	GoPrefix string
 }
 
 type Method struct {
@@ -71,11 +140,21 @@ type Method struct {
 	HttpBindings   []*OptionHttp
 	Compressed     bool
 	WebSocket      bool
+	// Event is true if the method declares an event pushed to the WebSocket clients
+	Event bool
+
+	// RequestType and ResponseType are the resolved messages, ResponseType
+	// is nil if the message is not available (e.g. a well-known type)
+	RequestType  *Symbol
+	ResponseType *Symbol
 
 	Parent *Service
 }
 
 type OptionHttp struct {
+	// Pos is the position of the HTTP method (e.g. `get`) within the option
+	Pos lexer.Position
+
 	Method       string
 	PathRaw      string
 	Body         string
@@ -92,97 +171,315 @@ type Param struct {
 	Type        Type
 	Location    Location
 	OneOfFields map[string]*Param
+	// Symbol is the message or enum of the field, nil for other types
+	Symbol *Symbol
+	// Parents are the messages containing a nested field (e.g. `org` of `org.id`), outermost first.
+	// They are empty for the fields of the request.
+	Parents []*Param
 }
 
-func (d Definition) getType(field *io.Field) Type {
+// GoImport returns the import of the Go package of the type of the parameter, nil if it is part of the Go package of
+// the definition or a well-known type
+func (p *Param) GoImport() *GoImport {
+	if p.Symbol == nil || p.WellKnown() != nil {
+		return nil
+	}
+	return p.Symbol.GoImport()
+}
+
+// FieldPath returns the path of the field relative to the request, nested fields are separated by dots
+func (p *Param) FieldPath() string {
+	names := make([]string, 0, len(p.Parents)+1)
+	for _, parent := range p.Parents {
+		names = append(names, parent.Name)
+	}
+	return strings.Join(append(names, p.Name), ".")
+}
+
+// Scope returns the full name of the message containing the field
+func (p *Param) Scope(m *Method) string {
+	if len(p.Parents) > 0 {
+		return p.Parents[len(p.Parents)-1].Symbol.FullName
+	}
+	return m.RequestType.FullName
+}
+
+// GoRequest returns the name of the Go type of the request
+func (m *Method) GoRequest() string {
+	if m.RequestType != nil {
+		return m.RequestType.GoIdent()
+	}
+	return strcase.ToCamel(m.Request)
+}
+
+// GoResponse returns the name of the Go type of the response
+func (m *Method) GoResponse() string {
+	if m.ResponseType != nil {
+		return m.ResponseType.GoIdent()
+	}
+	return strcase.ToCamel(m.Response)
+}
+
+// GoRequestType returns the Go type of the request qualified by the alias of its package, e.g. `pb.Request`
+func (m *Method) GoRequestType() string {
+	if m.RequestType != nil {
+		return m.RequestType.GoType()
+	}
+	return "pb." + m.GoRequest()
+}
+
+// GoResponseType returns the Go type of the response qualified by the alias of its package, e.g. `pb.Response`
+func (m *Method) GoResponseType() string {
+	if m.ResponseType != nil {
+		return m.ResponseType.GoType()
+	}
+	return "pb." + m.GoResponse()
+}
+
+// GoImports returns the imports of the Go packages of the request, the response and the parameters of the HTTP
+// bindings which differ from the Go package of the definition, each package is returned once
+func (m *Method) GoImports() []*GoImport {
+	imports := make([]*GoImport, 0)
+	add := func(i *GoImport) {
+		if i == nil {
+			return
+		}
+		for _, other := range imports {
+			if other.Path == i.Path {
+				return
+			}
+		}
+		imports = append(imports, i)
+	}
+	for _, s := range []*Symbol{m.RequestType, m.ResponseType} {
+		if s != nil {
+			add(s.GoImport())
+		}
+	}
+	for _, binding := range m.HttpBindings {
+		for _, p := range binding.Params {
+			for _, parent := range p.Parents {
+				add(parent.GoImport())
+			}
+			add(p.GoImport())
+			for _, option := range p.OneOfFields {
+				add(option.GoImport())
+			}
+		}
+	}
+	return imports
+}
+
+// Streaming reports whether the request or the response is a stream
+func (m *Method) Streaming() bool {
+	return m.RequestStream || m.ResponseStream
+}
+
+// getType returns the kind of the field's type, 0 if the type is unknown.
+// Messages and enums are resolved within the scope and returned as well.
+func (d Definition) getType(scope string, field *io.Field) (Type, *Symbol) {
 	if field.Type.Scalar > io.None {
-		return TypeScalar
+		return TypeScalar, nil
 	} else if field.Type.Map != nil {
-		return TypeMap
-	} else if _, ok := d.messagesMap[field.Type.Reference]; ok {
-		return TypeMessage
-	} else if _, ok = d.enumsMap[field.Type.Reference]; ok {
-		return TypeEnum
+		return TypeMap, nil
+	} else if s, ok := d.Resolve(scope, field.Type.Reference); ok {
+		return s.Type(), s
 	}
-	return 0
+	return 0, nil
 }
 
+// GorillaMuxPath translates the path template into a path of gorilla/mux including the prefix of the service.
+// Wildcards become variables named `_wildcardN` (`*`) and `_wildcardsN` (`**`), the values may be ignored.
 func (o *OptionHttp) GorillaMuxPath() string {
 	var path string
 	if o.Parent != nil && o.Parent.Parent != nil {
 		path = strings.TrimSuffix(o.Parent.Parent.HttpPrefix, "/")
 	}
 
-	l := len(o.Path.Segments)
 	for i, segment := range o.Path.Segments {
+		if segment.Wildcard != nil && *segment.Wildcard == "**" {
+			// `**` matches zero or more segments, the slash in front of it is optional
+			if path == "" {
+				path += "/"
+			}
+			path += fmt.Sprintf("{_wildcards%d:(?:/.*)?}", i)
+			continue
+		}
 		path += "/"
 		if segment.Literal != nil {
 			path += *segment.Literal
 		} else if segment.Wildcard != nil {
-			if len(*segment.Wildcard) > 1 && i == l-1 {
-				// TODO: Does gorilla support **?
-				path += ".*"
-			} else {
-				path += ".*"
-			}
+			path += fmt.Sprintf("{_wildcard%d}", i)
 		} else if segment.Variable != nil {
 			path += "{" + segment.Variable.Field
 			if segment.Variable.Pattern != nil {
 				path += ":" + *segment.Variable.Pattern
+			} else if pattern := variablePattern(segment.Variable.Segments); pattern != "" {
+				path += ":" + pattern
 			}
 			path += "}"
 		}
 	}
+	if o.Path.Verb != nil {
+		path += ":" + *o.Path.Verb
+	}
 
 	return path
 }
 
-func (m *Method) CheckParams(def *Definition) error {
-	msg, ok := def.messagesMap[m.Request]
-	if !ok {
-		return errors.New("message `" + m.Request + "` not found")
+// Template returns the path template as defined including the prefix of the service
+func (o *OptionHttp) Template() string {
+	var prefix string
+	if o.Parent != nil && o.Parent.Parent != nil {
+		prefix = strings.TrimSuffix(o.Parent.Parent.HttpPrefix, "/")
 	}
+	return prefix + o.PathRaw
+}
+
+// variablePattern returns the regular expression matching the segments of a variable,
+// empty if the default pattern of gorilla/mux (a single segment) applies
+func variablePattern(segments []string) string {
+	if len(segments) == 0 || len(segments) == 1 && segments[0] == "*" {
+		return ""
+	}
+	var pattern string
+	for i, s := range segments {
+		if s == "**" {
+			if i == 0 {
+				pattern += ".*"
+			} else {
+				pattern += "(?:/.*)?"
+			}
+			continue
+		}
+		if i > 0 {
+			pattern += "/"
+		}
+		if s == "*" {
+			pattern += "[^/]+"
+		} else {
+			pattern += regexp.QuoteMeta(s)
+		}
+	}
+	return pattern
+}
+
+// params returns the fields of the message as parameters in order of their definition.
+// Oneofs are combined to a single parameter.
+func (d Definition) params(msg *Symbol) ([]string, map[string]*Param) {
+	names := make([]string, 0, len(msg.Message.Entries))
 	fields := make(map[string]*Param)
-	for _, f := range msg.Entries {
+	for _, f := range msg.Message.Entries {
 		if f.Field != nil {
-			fields[f.Field.Name] = &Param{
-				Field: f.Field,
-				Type:  def.getType(f.Field),
-			}
+			names = append(names, f.Field.Name)
+			fields[f.Field.Name] = d.param(msg.FullName, f.Field)
 		} else if f.OneOf != nil {
+			names = append(names, f.OneOf.Name)
 			fields[f.OneOf.Name] = &Param{
 				OneOfFields: map[string]*Param{},
 				Field:       &io.Field{Name: f.OneOf.Name},
 				Type:        TypeOneOf,
 			}
 			for _, entry := range f.OneOf.Entries {
-				fields[f.OneOf.Name].OneOfFields[entry.Field.Name] = &Param{
-					Field: entry.Field,
-					Type:  def.getType(entry.Field),
+				if entry.Field == nil {
+					continue
 				}
+				fields[f.OneOf.Name].OneOfFields[entry.Field.Name] = d.param(msg.FullName, entry.Field)
 			}
 		}
 	}
+	return names, fields
+}
+
+func (d Definition) param(scope string, field *io.Field) *Param {
+	p := &Param{
+		Field: field,
+	}
+	p.Type, p.Symbol = d.getType(scope, field)
+	return p
+}
+
+// pathParam resolves the field of a path variable, nested fields (e.g. `org.id`) are resolved through the
+// messages of the intermediate fields
+func (m *Method) pathParam(def *Definition, fields map[string]*Param, path string) (*Param, error) {
+	names := strings.Split(path, ".")
+	p, ok := fields[names[0]]
+	parents := make([]*Param, 0, len(names)-1)
+	for _, name := range names[1:] {
+		if !ok || p.Type == TypeOneOf {
+			break
+		}
+		if !p.nestable() {
+			return nil, errors.New(fmt.Sprintf("`%s` of path parameter `%s` is not a singular message (method `%s`)",
+				p.FieldPath(), path, m.Name))
+		}
+		parents = append(parents, p)
+		_, nested := def.params(p.Symbol)
+		p, ok = nested[name]
+		if ok {
+			p.Parents = parents
+		}
+	}
+	if !ok || p.Type == TypeOneOf {
+		return nil, errors.New(fmt.Sprintf("path parameter `%s` not found (method `%s`)", path, m.Name))
+	}
+	return p, nil
+}
+
+// nestable reports whether the fields of the parameter may be addressed by a field path
+func (p *Param) nestable() bool {
+	return p.Type == TypeMessage && !p.Repeated && p.Symbol != nil && p.Symbol.Message != nil && p.WellKnown() == nil
+}
+
+// nestedParams returns the scalar, enum and well-known fields of the message, nested messages are expanded
+// recursively. Maps, oneofs and repeated messages are not supported as nested query parameters.
+func (d Definition) nestedParams(parent *Param, seen map[string]bool) []*Param {
+	if seen[parent.Symbol.FullName] {
+		return nil
+	}
+	seen[parent.Symbol.FullName] = true
+	defer delete(seen, parent.Symbol.FullName)
+
+	parents := append(append(make([]*Param, 0, len(parent.Parents)+1), parent.Parents...), parent)
+	names, fields := d.params(parent.Symbol)
+	result := make([]*Param, 0, len(names))
+	for _, name := range names {
+		p := fields[name]
+		p.Parents = parents
+		if p.nestable() {
+			result = append(result, d.nestedParams(p, seen)...)
+		} else if p.Type == TypeScalar || p.Type == TypeEnum || p.WellKnown() != nil && !p.Repeated {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func (m *Method) CheckParams(def *Definition) error {
+	msg, ok := def.Resolve(def.pack, m.Request)
+	if !ok || msg.Message == nil {
+		return errors.New("message `" + m.Request + "` not found")
+	}
+	m.RequestType = msg
+	if resp, ok := def.Resolve(def.pack, m.Response); ok && resp.Message != nil {
+		m.ResponseType = resp
+	}
 
 	for _, binding := range m.HttpBindings {
 		binding.Params = make([]*Param, 0)
 
+		// every binding gets its own parameters, the location differs between them
+		names, fields := def.params(msg)
 		params := make(map[string]bool)
-		for _, entry := range msg.Entries {
-			if entry.Field != nil {
-				params[entry.Field.Name] = false
-			} else if entry.OneOf != nil {
-				params[entry.OneOf.Name] = false
-			}
-		}
 
 		for _, s := range binding.Path.Segments {
 			if s.Variable != nil {
-				if _, ok = fields[s.Variable.Field]; !ok {
-					return errors.New(fmt.Sprintf("path parameter `%s` not found (method `%s`)", s.Variable.Field, m.Name))
+				p, err := m.pathParam(def, fields, s.Variable.Field)
+				if err != nil {
+					return at(binding.Pos, err)
 				}
-				fields[s.Variable.Field].Location = LocationPath
-				binding.Params = append(binding.Params, fields[s.Variable.Field])
+				p.Location = LocationPath
+				binding.Params = append(binding.Params, p)
 				params[s.Variable.Field] = true
 			}
 		}
@@ -194,15 +491,26 @@ func (m *Method) CheckParams(def *Definition) error {
 					binding.Params = append(binding.Params, f)
 					params[binding.Body] = true
 				} else {
-					return errors.New(fmt.Sprintf("body field `%s` not found (method `%s`)", binding.Body, m.Name))
+					return at(binding.Pos, errors.New(fmt.Sprintf("body field `%s` not found (method `%s`)", binding.Body, m.Name)))
 				}
 			}
 
-			for name, handled := range params {
-				if handled {
+			for _, name := range names {
+				if params[name] {
 					continue
 				}
 
+				if fields[name].nestable() {
+					// messages are expanded to their fields, e.g. `?page.size=10`
+					for _, p := range def.nestedParams(fields[name], make(map[string]bool)) {
+						if params[p.FieldPath()] {
+							continue
+						}
+						p.Location = LocationQuery
+						binding.Params = append(binding.Params, p)
+					}
+					continue
+				}
 				fields[name].Location = LocationQuery
 				binding.Params = append(binding.Params, fields[name])
 			}
@@ -216,12 +524,38 @@ func (s *Service) CheckParams(def *Definition) error {
 	for _, method := range s.Methods {
 		err := method.CheckParams(def)
 		if err != nil {
-			return err
+			return at(method.Pos, err)
+		}
+	}
+	for _, event := range s.Events {
+		err := event.checkEvent(def)
+		if err != nil {
+			return at(event.Pos, err)
 		}
 	}
 	return nil
 }
 
+// checkEvent resolves the payload of the event
+func (m *Method) checkEvent(def *Definition) error {
+	msg, ok := def.Resolve(def.pack, m.Request)
+	if !ok || msg.Message == nil {
+		return errors.New("message `" + m.Request + "` not found")
+	}
+	m.RequestType = msg
+	return nil
+}
+
+// StreamingUsed reports whether the service has a streaming method
+func (s *Service) StreamingUsed() bool {
+	for _, m := range s.Methods {
+		if m.Streaming() {
+			return true
+		}
+	}
+	return false
+}
+
 func (s *Service) CompressionUsed() bool {
 	for _, m := range s.Methods {
 		if m.Compressed {
@@ -255,40 +589,59 @@ func (d Definition) methodFromProto(s *Service, method *io.Method) (*Method, err
 
 	for _, option := range method.Options {
 		if option.Name == "google.api.http" {
-			if method.StreamingRequest || method.StreamingResponse {
-				return nil, errors.New("streaming methods cannot have `google.api.http` option (method `" + method.Name + "`)")
+			if method.StreamingRequest {
+				return nil, at(option.Pos, errors.New("client and bidirectional streaming methods cannot have `google.api.http` option (method `"+method.Name+"`)"))
 			}
 
 			if option.Value == nil || option.Value.Map == nil {
-				return nil, errors.New("invalid value provided for `google.api.http` (method `" + method.Name + "`)")
+				return nil, at(option.Pos, errors.New("invalid value provided for `google.api.http` (method `"+method.Name+"`)"))
 			}
-			err := m.parseBinding(option.Value.Map.Entries)
+			err := m.parseBinding(option.Pos, option.Value.Map.Entries)
 			if err != nil {
-				return nil, err
-			}
-		} else if option.Name == "httpCompress" {
-			if option.Value == nil || option.Value.Bool == nil {
-				return nil, errors.New("invalid value provided for `httpCompress` (method `" + method.Name + "`)")
+				return nil, at(option.Pos, err)
 			}
-			m.Compressed = bool(*option.Value.Bool)
-		} else if option.Name == "webSocket" {
-			if option.Value == nil || option.Value.Bool == nil {
-				return nil, errors.New("invalid value provided for `webSocket` (method `" + method.Name + "`)")
+		} else if option.Name == "webSocketEvent" {
+			return nil, at(option.Pos, errors.New("option `(webSocketEvent)` is not declared, events are declared by "+
+				"`(hawk.v1.method).web_socket_event` (method `"+method.Name+"`)"))
+		} else {
+			values, err := hawkOption(option, methodOptions)
+			if err != nil {
+				return nil, err
 			}
-			m.WebSocket = bool(*option.Value.Bool)
+			m.applyOptions(values)
 		}
 	}
+	if m.Event && (m.Streaming() || len(m.HttpBindings) > 0) {
+		return nil, errors.New("events cannot be streamed or have `google.api.http` option (method `" + method.Name + "`)")
+	}
 
 	return m, nil
 }
 
-func (m *Method) parseBinding(data []*io.MapEntry) error {
+// applyOptions sets the fields of `hawk.v1.MethodOptions`, the values have been validated by the schema
+func (m *Method) applyOptions(values map[string]*io.Value) {
+	for name, value := range values {
+		switch name {
+		case "http_compress":
+			m.Compressed = bool(*value.Bool)
+		case "web_socket":
+			m.WebSocket = bool(*value.Bool)
+		case "web_socket_event":
+			m.Event = bool(*value.Bool)
+		}
+	}
+}
+
+func (m *Method) parseBinding(pos lexer.Position, data []*io.MapEntry) error {
 	b := &OptionHttp{
+		Pos:    pos,
 		Parent: m,
 	}
+	// additional bindings are parsed after the binding itself to keep the order of definition
+	additional := make([]*io.MapEntry, 0)
 	for _, entry := range data {
 		if entry.Key == nil || entry.Key.Reference == nil {
-			return errors.New("invalid key of `google.api.http` (method `" + m.Name + "`)")
+			return at(entry.Pos, errors.New("invalid key of `google.api.http` (method `"+m.Name+"`)"))
 		}
 		switch *entry.Key.Reference {
 		case "get":
@@ -301,30 +654,32 @@ func (m *Method) parseBinding(data []*io.MapEntry) error {
 			fallthrough
 		case "delete":
 			b.Method = *entry.Key.Reference
+			b.Pos = entry.Pos
 			if entry.Value == nil || entry.Value.String == nil {
-				return errors.New("invalid value provided of `" + *entry.Key.Reference + "` (method `" + m.Name + "`)")
+				return at(entry.Pos, errors.New("invalid value provided of `"+*entry.Key.Reference+"` (method `"+m.Name+"`)"))
 			}
 			b.PathRaw = *entry.Value.String
 		case "body":
 			if entry.Value == nil || entry.Value.String == nil {
-				return errors.New("invalid value provided of `" + *entry.Key.Reference + "` (method `" + m.Name + "`)")
+				return at(entry.Pos, errors.New("invalid value provided of `"+*entry.Key.Reference+"` (method `"+m.Name+"`)"))
 			}
 			b.Body = *entry.Value.String
 		case "response_body":
 			if entry.Value == nil || entry.Value.String == nil {
-				return errors.New("invalid value provided of `" + *entry.Key.Reference + "` (method `" + m.Name + "`)")
+				return at(entry.Pos, errors.New("invalid value provided of `"+*entry.Key.Reference+"` (method `"+m.Name+"`)"))
 			}
 			b.ResponseBody = *entry.Value.String
 		case "custom":
 			if entry.Value == nil || entry.Value.Map == nil {
-				return errors.New("invalid value provided of `" + *entry.Key.Reference + "` (method `" + m.Name + "`)")
+				return at(entry.Pos, errors.New("invalid value provided of `"+*entry.Key.Reference+"` (method `"+m.Name+"`)"))
 			}
+			b.Pos = entry.Pos
 			for _, e := range entry.Value.Map.Entries {
 				if e.Key == nil || e.Key.Reference == nil {
-					return errors.New("invalid attribute of `custom` (method `" + m.Name + "`)")
+					return at(e.Pos, errors.New("invalid attribute of `custom` (method `"+m.Name+"`)"))
 				}
 				if e.Value == nil || e.Value.String == nil {
-					return errors.New("invalid value provided of `" + *e.Key.Reference + "` (method `" + m.Name + "`)")
+					return at(e.Pos, errors.New("invalid value provided of `"+*e.Key.Reference+"` (method `"+m.Name+"`)"))
 				}
 				if *e.Key.Reference == "kind" {
 					b.Method = *e.Value.String
@@ -333,25 +688,29 @@ func (m *Method) parseBinding(data []*io.MapEntry) error {
 				}
 			}
 			if b.Method == "" || b.PathRaw == "" {
-				return errors.New("http binding incomplete (method `" + m.Name + "`)")
+				return at(entry.Pos, errors.New("http binding incomplete (method `"+m.Name+"`)"))
 			}
 		case "additional_bindings":
 			if entry.Value == nil || entry.Value.Map == nil {
-				return errors.New("invalid value provided of `" + *entry.Key.String + "` (method `" + m.Name + "`)")
-			}
-			err := m.parseBinding(entry.Value.Map.Entries)
-			if err != nil {
-				return err
+				return at(entry.Pos, errors.New("invalid value provided of `"+*entry.Key.Reference+"` (method `"+m.Name+"`)"))
 			}
+			additional = append(additional, entry)
 		}
 	}
 	var err error
 	b.Path, err = io.ParsePath(b.PathRaw)
 	if err != nil {
-		return errors2.Wrap(err, "failed to parse path `"+b.PathRaw+"`")
+		return at(b.Pos, errors2.Wrap(err, "failed to parse path `"+b.PathRaw+"`"))
 	}
 	m.HttpBindings = append(m.HttpBindings, b)
 
+	for _, entry := range additional {
+		err = m.parseBinding(entry.Pos, entry.Value.Map.Entries)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -365,34 +724,17 @@ func (d Definition) serviceFromProto(service *io.Service) (*Service, error) {
 		if entry.Method != nil {
 			m, err := d.methodFromProto(s, entry.Method)
 			if err != nil {
-				return nil, err
+				return nil, at(entry.Method.Pos, err)
+			}
+			if m.Event {
+				s.Events = append(s.Events, m)
+			} else {
+				s.Methods = append(s.Methods, m)
 			}
-			s.Methods = append(s.Methods, m)
 		} else if entry.Option != nil {
-			if entry.Option.Name == "config" {
-				if entry.Option.Value == nil || entry.Option.Value.Map == nil {
-					return nil, errors.New("invalid value provided for `(httpConfig)`")
-				}
-				for _, mapEntry := range entry.Option.Value.Map.Entries {
-					switch *mapEntry.Key.Reference {
-					case "HttpPrefix":
-						s.HttpPrefix = *mapEntry.Value.String
-					case "HttpCompress":
-						if mapEntry.Value != nil && mapEntry.Value.Bool != nil {
-							s.Compressed = ref(bool(*mapEntry.Value.Bool))
-						}
-					case "WebSocketPath":
-						s.WSPath = *mapEntry.Value.String
-					case "WebSocketByDefault":
-						if mapEntry.Value != nil && mapEntry.Value.Bool != nil {
-							s.WSDefault = ref(bool(*mapEntry.Value.Bool))
-						}
-					case "WebSocketMaxMessageSize":
-						if mapEntry.Value != nil && mapEntry.Value.Int != nil {
-							s.WSMaxSize = uint(*mapEntry.Value.Int)
-						}
-					}
-				}
+			err := s.parseOption(entry.Option)
+			if err != nil {
+				return nil, err
 			}
 		}
 	}
@@ -402,47 +744,168 @@ func (d Definition) serviceFromProto(service *io.Service) (*Service, error) {
 	return s, nil
 }
 
-func DefinitionFromProto(data *io.Proto) (*Definition, error) {
+// configKeys maps the keys of the option `(config)`, which the proto files declared themselves before
+// hawk/options.proto existed, to the fields of `hawk.v1.ServiceOptions`
+var configKeys = map[string]string{
+	"HttpPrefix":              "http_prefix",
+	"HttpCompress":            "http_compress",
+	"WebSocketPath":           "web_socket_path",
+	"WebSocketByDefault":      "web_socket_by_default",
+	"WebSocketMaxMessageSize": "web_socket_max_message_size",
+}
+
+// parseOption applies the option `(hawk.v1.service)` or `(config)` to the service, other options are skipped
+func (s *Service) parseOption(option *io.Option) error {
+	values, err := hawkOption(option, serviceOptions)
+	if err != nil {
+		return err
+	}
+	s.applyOptions(values)
+	return nil
+}
+
+// configValues returns the values of the option `(config)` by the fields of `hawk.v1.ServiceOptions`, unknown keys
+// are ignored
+func configValues(option *io.Option) (map[string]*io.Value, error) {
+	if option.Value == nil || option.Value.Map == nil {
+		return nil, at(option.Pos, errors.New("invalid value provided for `(config)`"))
+	}
+	fields := extensions[OptionsPackage+".service"].fields
+	values := make(map[string]*io.Value)
+	for _, entry := range option.Value.Map.Entries {
+		if entry.Key == nil || entry.Key.Reference == nil {
+			return nil, at(entry.Pos, errors.New("invalid key of `(config)`"))
+		}
+		name, ok := configKeys[*entry.Key.Reference]
+		if !ok {
+			continue
+		}
+		if !validValue(fields[name], entry.Value) {
+			return nil, at(entry.Pos, errors.New("invalid value provided for `"+*entry.Key.Reference+"`"))
+		}
+		values[name] = entry.Value
+	}
+	return values, nil
+}
+
+// applyOptions sets the fields of `hawk.v1.ServiceOptions`, the values have been validated by the schema
+func (s *Service) applyOptions(values map[string]*io.Value) {
+	for name, value := range values {
+		switch name {
+		case "http_prefix":
+			s.HttpPrefix = *value.String
+		case "http_compress":
+			s.Compressed = ref(bool(*value.Bool))
+		case "web_socket_path":
+			s.WSPath = *value.String
+		case "web_socket_by_default":
+			s.WSDefault = ref(bool(*value.Bool))
+		case "web_socket_max_message_size":
+			s.WSMaxSize = uint(*value.Int)
+		}
+	}
+}
+
+// DefinitionFromProto creates the definition of the parsed file. The types of the
+// imported files are available to the definition, their services are ignored.
+func DefinitionFromProto(data *io.Proto, imports ...*File) (*Definition, error) {
+	d := newDefinition(data, imports...)
+	if errs := d.undeclaredOptions(); len(errs) > 0 {
+		return nil, errs[0]
+	}
+	for _, msg := range d.messages {
+		if err := checkFieldOptions(msg); err != nil {
+			return nil, err
+		}
+	}
+
+	d.Services = make([]*Service, len(d.services))
+	for i, service := range d.services {
+		s, err := d.serviceFromProto(service)
+		if err != nil {
+			return nil, err
+		}
+		err = s.CheckParams(d)
+		if err != nil {
+			return nil, err
+		}
+		d.Services[i] = s
+	}
+
+	err := prefixServices(d.Services)
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// prefixServices sets the prefixes of the Go identifiers if there are multiple services
+func prefixServices(services []*Service) error {
+	if len(services) < 2 {
+		return nil
+	}
+	prefixes := make(map[string]string)
+	for _, s := range services {
+		s.GoPrefix = strcase.ToCamel(strings.TrimSuffix(s.Name, "Service"))
+		if s.GoPrefix == "" {
+			s.GoPrefix = strcase.ToCamel(s.Name)
+		}
+		if other, ok := prefixes[s.GoPrefix]; ok {
+			return at(s.Pos, errors.New("services `"+other+"` and `"+s.Name+"` result in the same Go identifiers"))
+		}
+		prefixes[s.GoPrefix] = s.Name
+	}
+	return nil
+}
+
+// newDefinition collects the entries and symbols of the file and its imports, the services are not evaluated
+func newDefinition(data *io.Proto, imports ...*File) *Definition {
 	d := &Definition{
-		services:    make([]*io.Service, 0),
-		imports:     make([]string, 0),
-		enums:       make([]*io.Enum, 0),
-		enumsMap:    make(map[string]*io.Enum),
-		messages:    make([]*io.Message, 0),
-		messagesMap: make(map[string]*io.Message),
+		services:  make([]*io.Service, 0),
+		imports:   make([]string, 0),
+		files:     imports,
+		symbols:   make(map[string]*Symbol),
+		goAliases: make(map[string]string),
+		options:   make(map[string]bool),
+		enums:     make([]*io.Enum, 0),
+		messages:  make([]*io.Message, 0),
 	}
+	d.pack, d.goPack = fileOptions(data)
 
 	for _, entry := range data.Entries {
 		if entry.Message != nil {
 			d.messages = append(d.messages, entry.Message)
-			d.messagesMap[entry.Message.Name] = entry.Message
 		} else if entry.Enum != nil {
 			d.enums = append(d.enums, entry.Enum)
 		} else if entry.Service != nil {
 			d.services = append(d.services, entry.Service)
 		} else if entry.Syntax != "" {
 			d.syntax = entry.Syntax
-		} else if entry.Package != "" {
-			d.pack = entry.Package
 		} else if entry.Import != "" {
 			d.imports = append(d.imports, entry.Import)
 		}
 	}
 
-	d.Services = make([]*Service, len(d.services))
-	for i, service := range d.services {
-		s, err := d.serviceFromProto(service)
-		if err != nil {
-			return nil, err
+	d.addSymbols(nil, data)
+	d.addOptions(d.pack, data)
+	for _, f := range imports {
+		d.addSymbols(f, f.Proto)
+		d.addOptions(f.Package, f.Proto)
+	}
+	return d
+}
+
+// addOptions adds the options declared by the extensions of the file
+func (d *Definition) addOptions(pkg string, data *io.Proto) {
+	for _, entry := range data.Entries {
+		if entry.Extend == nil {
+			continue
 		}
-		err = s.CheckParams(d)
-		if err != nil {
-			return nil, err
+		for _, f := range entry.Extend.Fields {
+			d.options[qualify(pkg, f.Name)] = true
 		}
-		d.Services[i] = s
 	}
-
-	return d, nil
 }
 
 func ref[T any](v T) *T {
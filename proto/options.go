@@ -0,0 +1,329 @@
+package proto
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"github.com/alecthomas/participle/v2/lexer"
+	"github.com/niiigoo/hawk/proto/io"
+	"strings"
+)
+
+// OptionsFile is the import path of the options of hawk, it is found without adding an include path
+const OptionsFile = "hawk/options.proto"
+
+// OptionsPackage is the proto package of the options, e.g. `(hawk.v1.service)`
+const OptionsPackage = "hawk.v1"
+
+// OptionsProto is the content of hawk/options.proto, its Go types are part of github.com/niiigoo/hawk/pkg/options
+//
+//go:embed hawk/options.proto
+var OptionsProto string
+
+// The messages extended by the options
+const (
+	serviceOptions = "google.protobuf.ServiceOptions"
+	methodOptions  = "google.protobuf.MethodOptions"
+	fieldOptions   = "google.protobuf.FieldOptions"
+)
+
+// extension is an option declared by hawk/options.proto
+type extension struct {
+	name     string
+	extendee string
+	// fields are the fields of the message of the option by name
+	fields map[string]*io.Field
+}
+
+// extensions are the options of hawk by their full name
+var extensions = parseOptions()
+
+func parseOptions() map[string]*extension {
+	data, err := io.ParseString(OptionsFile, OptionsProto)
+	if err != nil {
+		panic(err)
+	}
+
+	messages := make(map[string]*io.Message)
+	for _, entry := range data.Entries {
+		if entry.Message != nil {
+			messages[entry.Message.Name] = entry.Message
+		}
+	}
+
+	result := make(map[string]*extension)
+	for _, entry := range data.Entries {
+		if entry.Extend == nil {
+			continue
+		}
+		for _, f := range entry.Extend.Fields {
+			ext := &extension{
+				name:     qualify(OptionsPackage, f.Name),
+				extendee: strings.TrimPrefix(entry.Extend.Reference, "."),
+				fields:   make(map[string]*io.Field),
+			}
+			for _, e := range messages[f.Type.Reference].Entries {
+				if e.Field != nil {
+					ext.fields[e.Field.Name] = e.Field
+				}
+			}
+			result[ext.name] = ext
+		}
+	}
+	return result
+}
+
+// legacy is an option which the proto files declared themselves before hawk/options.proto existed
+type legacy struct {
+	// extension is the option of hawk replacing it
+	extension string
+	// field is the field of the extension set by the option, the keys of `(config)` are mapped by configKeys
+	field string
+}
+
+// legacyOptions are mapped to the options of hawk by their name
+var legacyOptions = map[string]legacy{
+	"config":       {OptionsPackage + ".service", ""},
+	"httpCompress": {OptionsPackage + ".method", "http_compress"},
+	"webSocket":    {OptionsPackage + ".method", "web_socket"},
+}
+
+// hawkOption validates an option of the package `hawk` or a legacy option against hawk/options.proto and returns the
+// values by the names of the fields. Other options are skipped, nil is returned.
+func hawkOption(option *io.Option, extendee string) (map[string]*io.Value, error) {
+	if l, ok := legacyOptions[option.Name]; ok && option.Custom {
+		return legacyOption(option, extendee, l)
+	}
+	if option.Name != "hawk" && !strings.HasPrefix(option.Name, "hawk.") {
+		return nil, nil
+	}
+	ext, ok := extensions[option.Name]
+	if !ok {
+		return nil, at(option.Pos, errors.New(fmt.Sprintf("option `(%s)` is not declared by `%s` (package `%s`)",
+			option.Name, OptionsFile, OptionsPackage)))
+	}
+	if ext.extendee != extendee {
+		return nil, at(option.Pos, errors.New(fmt.Sprintf("option `(%s)` extends `%s` and cannot be used here",
+			option.Name, ext.extendee)))
+	}
+
+	values := make(map[string]*io.Value)
+	if option.Attr != nil {
+		// a single field, e.g. `option (hawk.v1.method).web_socket = true;`
+		return values, ext.set(values, option.Pos, *option.Attr, option.Value)
+	}
+	if option.Value == nil || option.Value.Map == nil {
+		return nil, at(option.Pos, errors.New("invalid value provided for `("+option.Name+")`"))
+	}
+	for _, entry := range option.Value.Map.Entries {
+		if entry.Key == nil || entry.Key.Reference == nil {
+			return nil, at(entry.Pos, errors.New("invalid key of `("+option.Name+")`"))
+		}
+		if err := ext.set(values, entry.Pos, *entry.Key.Reference, entry.Value); err != nil {
+			return nil, err
+		}
+	}
+	return values, nil
+}
+
+// legacyOption validates a legacy option like the field of the option of hawk replacing it
+func legacyOption(option *io.Option, extendee string, l legacy) (map[string]*io.Value, error) {
+	ext := extensions[l.extension]
+	if ext.extendee != extendee {
+		return nil, at(option.Pos, errors.New(fmt.Sprintf("option `(%s)` extends `%s` and cannot be used here",
+			option.Name, ext.extendee)))
+	}
+	if l.field == "" {
+		return configValues(option)
+	}
+	if option.Attr != nil || !validValue(ext.fields[l.field], option.Value) {
+		return nil, at(option.Pos, errors.New("invalid value provided for `("+option.Name+")`"))
+	}
+	return map[string]*io.Value{l.field: option.Value}, nil
+}
+
+// deprecatedOptions returns the warnings of the legacy options of the services and their methods
+func deprecatedOptions(data *io.Proto) []*Issue {
+	issues := make([]*Issue, 0)
+	add := func(option *io.Option) {
+		l, ok := legacyOptions[option.Name]
+		if !ok || !option.Custom {
+			return
+		}
+		replacement := "(" + l.extension + ")"
+		if l.field != "" {
+			replacement += "." + l.field
+		}
+		issues = append(issues, newIssue(option.Pos, SeverityWarning, RuleDeprecatedOption, fmt.Sprintf(
+			"option `(%s)` is deprecated, use `%s` of `%s`", option.Name, replacement, OptionsFile)))
+	}
+	for _, entry := range data.Entries {
+		if entry.Service == nil {
+			continue
+		}
+		for _, e := range entry.Service.Entries {
+			if e.Option != nil {
+				add(e.Option)
+			} else if e.Method != nil {
+				for _, option := range e.Method.Options {
+					add(option)
+				}
+			}
+		}
+	}
+	return issues
+}
+
+// declared reports whether the option is known to hawk or declared by an extension of the file or its imports.
+// Options of google and options of files which are not found are not validated.
+func (d *Definition) declared(option *io.Option) bool {
+	if !option.Custom || strings.HasPrefix(option.Name, "google.") || option.Name == "hawk" ||
+		strings.HasPrefix(option.Name, "hawk.") {
+		return true
+	}
+	if _, ok := legacyOptions[option.Name]; ok || option.Name == "webSocketEvent" {
+		// validated by the service or method, `(webSocketEvent)` is rejected there
+		return true
+	}
+	// the name is resolved relative to the package and its parents, e.g. `(common.v1.rules)` in `sample.v1`
+	scope := d.pack
+	for {
+		if d.options[qualify(scope, option.Name)] {
+			return true
+		}
+		if scope == "" {
+			break
+		}
+		scope = scope[:max(strings.LastIndex(scope, "."), 0)]
+	}
+	resolved := make(map[string]bool)
+	for _, f := range d.files {
+		resolved[f.Name] = true
+	}
+	for _, name := range d.imports {
+		if !resolved[name] && !strings.HasPrefix(name, "google/") {
+			return true
+		}
+	}
+	return false
+}
+
+// undeclaredOptions returns the errors of the options of the services, methods and fields which are not declared
+func (d *Definition) undeclaredOptions() []error {
+	errs := make([]error, 0)
+	check := func(option *io.Option) {
+		if !d.declared(option) {
+			errs = append(errs, at(option.Pos, errors.New(fmt.Sprintf("option `(%s)` is not declared by the "+
+				"file or its imports", option.Name))))
+		}
+	}
+	var fields func(msg *io.Message)
+	fields = func(msg *io.Message) {
+		for _, entry := range msg.Entries {
+			if entry.Field != nil {
+				for _, option := range entry.Field.Options {
+					check(option)
+				}
+			} else if entry.OneOf != nil {
+				for _, e := range entry.OneOf.Entries {
+					if e.Field != nil {
+						for _, option := range e.Field.Options {
+							check(option)
+						}
+					}
+				}
+			} else if entry.Message != nil {
+				fields(entry.Message)
+			}
+		}
+	}
+
+	for _, service := range d.services {
+		for _, entry := range service.Entries {
+			if entry.Option != nil {
+				check(entry.Option)
+			} else if entry.Method != nil {
+				for _, option := range entry.Method.Options {
+					check(option)
+				}
+			}
+		}
+	}
+	for _, msg := range d.messages {
+		fields(msg)
+	}
+	return errs
+}
+
+// set validates the value of the field and adds it to the values
+func (e *extension) set(values map[string]*io.Value, pos lexer.Position, name string, value *io.Value) error {
+	f, ok := e.fields[name]
+	if !ok {
+		return at(pos, errors.New(fmt.Sprintf("unknown field `%s` of option `(%s)`", name, e.name)))
+	}
+	if !validValue(f, value) {
+		return at(pos, errors.New(fmt.Sprintf("invalid value provided for `%s` of option `(%s)`", name, e.name)))
+	}
+	values[name] = value
+	return nil
+}
+
+// validValue reports whether the value matches the scalar type of the field
+func validValue(f *io.Field, v *io.Value) bool {
+	if v == nil {
+		return false
+	}
+	switch f.Type.Scalar {
+	case io.String:
+		return v.String != nil
+	case io.Bool:
+		return v.Bool != nil
+	case io.Uint32, io.Uint64:
+		return v.Int != nil && *v.Int >= 0
+	case io.Int32, io.Int64:
+		return v.Int != nil
+	}
+	return false
+}
+
+// checkFieldOptions validates the options of hawk of the fields of the message and its nested messages
+func checkFieldOptions(msg *io.Message) error {
+	for _, entry := range msg.Entries {
+		var err error
+		if entry.Field != nil {
+			err = checkFieldOption(entry.Field)
+		} else if entry.OneOf != nil {
+			for _, e := range entry.OneOf.Entries {
+				if e.Field != nil && err == nil {
+					err = checkFieldOption(e.Field)
+				}
+			}
+		} else if entry.Message != nil {
+			err = checkFieldOptions(entry.Message)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkFieldOption(f *io.Field) error {
+	for _, option := range f.Options {
+		if _, err := hawkOption(option, fieldOptions); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FieldExample returns the example of the field given by the option `(hawk.v1.field)`, empty if there is none
+func FieldExample(f *io.Field) string {
+	for _, option := range f.Options {
+		values, err := hawkOption(option, fieldOptions)
+		if err == nil && values["example"] != nil {
+			return *values["example"].String
+		}
+	}
+	return ""
+}
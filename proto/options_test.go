@@ -0,0 +1,218 @@
+package proto
+
+import (
+	"github.com/niiigoo/hawk/proto/io"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+type OptionsTestSuite struct {
+	suite.Suite
+}
+
+func TestOptionsTestSuite(t *testing.T) {
+	suite.Run(t, new(OptionsTestSuite))
+}
+
+func (s *OptionsTestSuite) TestExtensions() {
+	s.Equal([]string{"hawk.v1.field", "hawk.v1.method", "hawk.v1.service"}, keys(extensions))
+	s.Equal(serviceOptions, extensions["hawk.v1.service"].extendee)
+	s.Equal([]string{"http_compress", "web_socket", "web_socket_event"}, keys(extensions["hawk.v1.method"].fields))
+}
+
+func (s *OptionsTestSuite) TestParseString() {
+	p := NewService()
+	s.Require().NoError(p.ParseString(`syntax = "proto3";
+import "hawk/options.proto";
+message Request {
+	string id = 1 [(hawk.v1.field) = { example: "123" }];
+}
+service Sample {
+	option (hawk.v1.service) = {
+		http_prefix: "/api"
+		http_compress: true
+		web_socket_path: "/ws"
+		web_socket_by_default: true
+		web_socket_max_message_size: 1024
+	};
+	rpc Get(Request) returns (Request) {
+		option (hawk.v1.method) = { http_compress: false, web_socket: false };
+	}
+	rpc Changed(Request) returns (Request) {
+		option (hawk.v1.method).web_socket_event = true;
+	}
+}`))
+
+	service := p.Definition().Services[0]
+	s.Equal("/api", service.HttpPrefix)
+	s.Equal("/api/ws", service.WSPath)
+	s.Equal(uint(1024), service.WSMaxSize)
+	s.True(*service.WSDefault)
+	s.Require().Len(service.Methods, 1)
+	s.False(service.Methods[0].Compressed)
+	s.False(service.Methods[0].WebSocket)
+	s.Require().Len(service.Events, 1)
+	s.True(service.Events[0].Compressed)
+	s.True(service.Events[0].WebSocket)
+
+	msg, _ := p.Definition().Message("Request")
+	s.Equal("123", FieldExample(msg.Entries[0].Field))
+}
+
+func (s *OptionsTestSuite) TestParseString_Config() {
+	p := NewService()
+	s.Require().NoError(p.ParseString(`syntax = "proto3";
+service Sample {
+	option (config) = {
+		HttpPrefix: "/api"
+		WebSocketByDefault: true
+		Custom: 1
+	};
+	rpc Get(Request) returns (Request) {
+		option (httpCompress) = true;
+	}
+}
+message Request {}`))
+
+	service := p.Definition().Services[0]
+	s.Equal("/api", service.HttpPrefix)
+	s.True(service.Methods[0].WebSocket)
+	s.True(service.Methods[0].Compressed)
+}
+
+func (s *OptionsTestSuite) TestParseString_Invalid() {
+	for option, msg := range map[string]string{
+		`option (hawk.v1.servce) = { http_prefix: "/api" };`:                  "3:9: option `(hawk.v1.servce)` is not declared by `hawk/options.proto` (package `hawk.v1`)",
+		`option (hawk.v2.service) = { http_prefix: "/api" };`:                 "3:9: option `(hawk.v2.service)` is not declared",
+		`option (hawk.v1.service) = { httpPrefix: "/api" };`:                  "3:31: unknown field `httpPrefix` of option `(hawk.v1.service)`",
+		`option (hawk.v1.service) = { http_prefix: true };`:                   "3:31: invalid value provided for `http_prefix` of option `(hawk.v1.service)`",
+		`option (hawk.v1.service).web_socket_max_message_size = -1;`:          "invalid value provided for `web_socket_max_message_size`",
+		`option (hawk.v1.method) = { web_socket: true };`:                     "3:9: option `(hawk.v1.method)` extends `google.protobuf.MethodOptions` and cannot be used here",
+		`option (config) = { HttpPrefix: 1 };`:                                "3:22: invalid value provided for `HttpPrefix`",
+		`option (confg) = { HttpPrefix: "/api" };`:                            "3:9: option `(confg)` is not declared by the file or its imports",
+		`option (httpCompress) = true;`:                                       "3:9: option `(httpCompress)` extends `google.protobuf.MethodOptions` and cannot be used here",
+		`rpc Get(Request) returns (Request) { option (webSocket) = "yes"; }`:  "invalid value provided for `(webSocket)`",
+		`rpc Get(Request) returns (Request) { option (httpCompres) = true; }`: "option `(httpCompres)` is not declared by the file or its imports",
+	} {
+		p := NewService()
+		err := p.ParseString(`syntax = "proto3";
+service Sample {
+	` + option + `
+}`)
+		s.Require().Error(err, option)
+		s.Contains(err.Error(), msg, option)
+	}
+
+	p := NewService()
+	err := p.ParseString(`syntax = "proto3";
+message Request {
+	message Nested {
+		string id = 1 [(hawk.v1.field) = { sample: "123" }];
+	}
+}`)
+	s.EqualError(err, "4:38: unknown field `sample` of option `(hawk.v1.field)`")
+
+	p = NewService()
+	err = p.ParseString(`syntax = "proto3";
+message Request {
+	string id = 1 [(hawk.v1.field) = { example: "123" }, (exmaple) = "123"];
+}`)
+	s.EqualError(err, "3:55: option `(exmaple)` is not declared by the file or its imports")
+}
+
+func (s *OptionsTestSuite) TestParseString_Declared() {
+	p := NewService()
+	s.Require().NoError(p.ParseString(`syntax = "proto3";
+package sample.v1;
+import "google/protobuf/descriptor.proto";
+extend google.protobuf.MethodOptions {
+	bool cached = 50000;
+}
+service Sample {
+	rpc Get(Request) returns (Request) {
+		option deprecated = true;
+		option (cached) = true;
+		option (sample.v1.cached) = true;
+	}
+}
+message Request {
+	string id = 1 [json_name = "ID", (google.api.field_behavior) = REQUIRED];
+}`))
+
+	// the options of files which are not found are unknown
+	p = NewService()
+	s.Require().NoError(p.ParseString(`syntax = "proto3";
+import "validate/validate.proto";
+message Request {
+	string id = 1 [(validate.rules).string.min_len = 1];
+}`))
+}
+
+func (s *OptionsTestSuite) TestDeprecatedOptions() {
+	data, err := io.ParseString("sample.proto", `syntax = "proto3";
+service Sample {
+	option (config) = { HttpPrefix: "/api" };
+	rpc Get(Request) returns (Request) {
+		option (httpCompress) = true;
+		option (webSocket) = false;
+		option (hawk.v1.method).http_compress = true;
+	}
+}`)
+	s.Require().NoError(err)
+
+	messages := make([]string, 0)
+	for _, issue := range deprecatedOptions(data) {
+		messages = append(messages, issue.String())
+	}
+	s.Equal([]string{
+		"sample.proto:3:9: warning: option `(config)` is deprecated, use `(hawk.v1.service)` of `hawk/options.proto` " +
+			"(deprecated-option)",
+		"sample.proto:5:10: warning: option `(httpCompress)` is deprecated, use `(hawk.v1.method).http_compress` of " +
+			"`hawk/options.proto` (deprecated-option)",
+		"sample.proto:6:10: warning: option `(webSocket)` is deprecated, use `(hawk.v1.method).web_socket` of " +
+			"`hawk/options.proto` (deprecated-option)",
+	}, messages)
+}
+
+func (s *OptionsTestSuite) TestParse_Import() {
+	dir := s.T().TempDir()
+	file := filepath.Join(dir, "sample.proto")
+	s.Require().NoError(os.WriteFile(file, []byte(`syntax = "proto3";
+import "hawk/options.proto";
+service Sample {
+	option (hawk.v1.service) = { http_prefix: "/api" };
+}`), 0644))
+
+	p := NewService()
+	s.Require().NoError(p.Parse(file))
+	s.Require().Len(p.Imports(), 1)
+	s.Equal(OptionsFile, p.Imports()[0].Name)
+	s.Empty(p.Imports()[0].Path)
+	s.Equal(OptionsPackage, p.Imports()[0].Package)
+}
+
+func (s *OptionsTestSuite) TestCreateFile() {
+	file := filepath.Join(s.T().TempDir(), "sample.proto")
+	s.Require().NoError(os.WriteFile(file, make([]byte, 4096), 0644))
+
+	p := NewService()
+	s.Require().NoError(p.CreateFile(file, "sample", "Sample"))
+	s.Require().NoError(p.Parse(file))
+	s.Equal("/api/sample", p.Definition().Services[0].HttpPrefix)
+
+	info, err := os.Stat(file)
+	s.Require().NoError(err)
+	s.Equal(os.FileMode(0644), info.Mode().Perm())
+}
+
+func keys[T any](m map[string]T) []string {
+	result := make([]string, 0, len(m))
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -7,14 +7,17 @@ import (
 	"gopkg.in/yaml.v3"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 )
 
 type Parser interface {
 	DetectFile(args ...string) (string, error)
-	Parse(file string) error
+	Parse(file string, includes ...string) error
 	ParseString(data string) error
 	Definition() *Definition
+	Imports() []*File
+	Lint(file string, includes ...string) ([]*Issue, error)
 	CreateFile(file, pgk, srv string) error
 	CompileProto(file, out string, includes ...string) error
 }
@@ -22,6 +25,7 @@ type Parser interface {
 type service struct {
 	file       string
 	data       *io.Proto
+	imports    []*File
 	definition *Definition
 }
 
@@ -55,28 +59,144 @@ func (p *service) DetectFile(args ...string) (string, error) {
 	return "", errors.New("no .proto file found")
 }
 
-func (p *service) Parse(file string) error {
-	f, err := os.Open(file)
+// Parse parses the file and the imported files which are found in the include paths (including
+// the imports of `protoc.yaml`), the directory of the file is used if no include path is given.
+// Imports which cannot be found (e.g. `google/protobuf/*.proto`) are skipped.
+func (p *service) Parse(file string, includes ...string) error {
+	var err error
+	p.file = file
+	p.data, err = parseFile(file)
+	if err != nil {
+		return err
+	}
+
+	if len(includes) == 0 {
+		includes = []string{filepath.Dir(file)}
+	}
+	p.imports, err = p.parseImports(p.data, p.includePaths(includes))
 	if err != nil {
 		return err
 	}
+
+	p.definition, err = DefinitionFromProto(p.data, p.imports...)
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range deprecatedOptions(p.data) {
+		log.Warnf("%s:%d:%d: %s", issue.File, issue.Line, issue.Column, issue.Message)
+	}
+	return nil
+}
+
+func parseFile(file string) (*io.Proto, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
 	defer func() {
 		_ = f.Close()
 	}()
 
-	p.file = file
-	p.data, err = io.Parse(file, f)
-	if err != nil {
-		return err
+	return io.Parse(file, f)
+}
+
+// parseImports parses the imports of the file recursively, each file is parsed once
+func (p *service) parseImports(data *io.Proto, includes []string) ([]*File, error) {
+	files := make([]*File, 0)
+	seen := make(map[string]bool)
+	queue := []*io.Proto{data}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, entry := range current.Entries {
+			if entry.Import == "" || seen[entry.Import] {
+				continue
+			}
+			seen[entry.Import] = true
+
+			path, ok := findImport(entry.Import, includes)
+			var imported *io.Proto
+			var err error
+			if ok {
+				imported, err = parseFile(path)
+			} else if entry.Import == OptionsFile {
+				// the options of hawk are part of hawk unless the include paths contain another version
+				imported, err = io.ParseString(OptionsFile, OptionsProto)
+			} else {
+				log.Debugf("import `%s` not found in include paths, its types are unknown", entry.Import)
+				continue
+			}
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse import '%s'", entry.Import)
+			}
+			f := &File{
+				Name:  entry.Import,
+				Path:  path,
+				Proto: imported,
+			}
+			f.Package, f.GoPackage = fileOptions(imported)
+			files = append(files, f)
+			queue = append(queue, imported)
+		}
 	}
+	return files, nil
+}
 
-	p.definition, err = DefinitionFromProto(p.data)
+func findImport(name string, includes []string) (string, bool) {
+	for _, dir := range includes {
+		path := filepath.Join(dir, name)
+		if info, err := os.Stat(path); err == nil && !info.IsDir() {
+			return path, true
+		}
+	}
+	return "", false
+}
 
-	return err
+// includePaths appends the imports of `protoc.yaml` and expands the environment variables
+func (p *service) includePaths(includes []string) []string {
+	config := p.parseConfig()
+	if len(config.Imports) > 0 {
+		includes = append(includes, config.Imports...)
+	}
+	paths := make([]string, len(includes))
+	for i, include := range includes {
+		paths[i] = os.ExpandEnv(include)
+	}
+	return paths
+}
+
+// Imports returns the imported files found by the last call of Parse, the path of hawk/options.proto is empty unless it
+// has been found in the include paths
+func (p *service) Imports() []*File {
+	return p.imports
+}
+
+// Lint parses the file and its imports like Parse and checks them by Lint, syntax errors are returned as issues
+func (p *service) Lint(file string, includes ...string) ([]*Issue, error) {
+	data, err := parseFile(file)
+	if issue, ok := syntaxIssue(err); ok {
+		return []*Issue{issue}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	if len(includes) == 0 {
+		includes = []string{filepath.Dir(file)}
+	}
+	imports, err := p.parseImports(data, p.includePaths(includes))
+	if issue, ok := syntaxIssue(err); ok {
+		return []*Issue{issue}, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return Lint(data, imports...), nil
 }
 
 func (p *service) ParseString(data string) error {
 	var err error
+	p.imports = nil
 	p.data, err = io.ParseString("", data)
 	if err != nil {
 		return err
@@ -88,7 +208,7 @@ func (p *service) ParseString(data string) error {
 }
 
 func (p *service) CreateFile(file, pkg, srv string) error {
-	f, err := os.OpenFile(file, os.O_CREATE|os.O_TRUNC, 0777)
+	f, err := os.OpenFile(file, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
 	if err != nil {
 		return errors.Wrapf(err, "failed to open file '%s'", file)
 	}
@@ -101,27 +221,13 @@ func (p *service) CreateFile(file, pkg, srv string) error {
 package ` + pkg + `;
 option go_package = ".;` + pkg + `";
 
-import "google/protobuf/descriptor.proto";
 import "googleapis/google/api/annotations.proto";
-
-extend google.protobuf.ServiceOptions {
-  optional Config config = 10000;
-}
-extend google.protobuf.MethodOptions {
-  optional bool httpCompress = 10001;
-}
-
-message Config {
-  string HttpPrefix = 1;
-  bool HttpCompress = 2;
-  string WebSocketPath = 3;
-  uint32 WebSocketMaxMessageSize = 4;
-}
+import "hawk/options.proto";
 
 service ` + srv + ` {
-	option (config) = {
-		HttpPrefix: "/api/` + pkg + `"
-		HttpCompress: false
+	option (hawk.v1.service) = {
+		http_prefix: "/api/` + pkg + `"
+		http_compress: false
 	};
 }
 `)
@@ -146,25 +252,50 @@ func (p *service) parseConfig() ProtocConfig {
 	return config
 }
 
+// CompileProto compiles the file and the imported files generated into the same Go package. hawk/options.proto is
+// found after the include paths.
 func (p *service) CompileProto(file, out string, imports ...string) error {
-	config := p.parseConfig()
-	if len(config.Imports) > 0 {
-		imports = append(imports, config.Imports...)
+	include, err := os.MkdirTemp("", "hawk-include-")
+	if err != nil {
+		return errors.Wrap(err, "failed to create include directory")
+	}
+	defer func() {
+		_ = os.RemoveAll(include)
+	}()
+	err = os.MkdirAll(filepath.Join(include, filepath.Dir(OptionsFile)), 0755)
+	if err == nil {
+		err = os.WriteFile(filepath.Join(include, OptionsFile), []byte(OptionsProto), 0644)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to write '%s'", OptionsFile)
 	}
 
 	args := []string{
 		"--go-grpc_out=" + out,
 		"--go_out=" + out,
 	}
-	for _, i := range imports {
-		args = append(args, "-I="+os.ExpandEnv(i))
+	for _, i := range append(p.includePaths(imports), include) {
+		args = append(args, "-I="+i)
 	}
 	args = append(args, file)
+	if p.definition != nil {
+		for _, f := range p.imports {
+			if p.definition.sameGoPackage(f) && f.Path != "" {
+				args = append(args, f.Path)
+			}
+		}
+	}
 	cmd := exec.Command("protoc", args...)
-	err := cmd.Run()
+	stderr := new(strings.Builder)
+	cmd.Stderr = stderr
+	err = cmd.Run()
 	if err != nil {
 		log.Info("Run: ", cmd.String())
 		log.WithError(err).Error("failed to compile proto file")
+		// the messages of protoc contain the positions, e.g. `sample.proto:12:3: "Foo" is not defined.`
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return errors.New(msg)
+		}
 	}
 	return err
 }
@@ -0,0 +1,455 @@
+package proto
+
+import (
+	"fmt"
+	"github.com/stretchr/testify/suite"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const importingProto = `syntax = "proto3";
+
+package sample;
+option go_package = ".;sample";
+
+import "google/protobuf/descriptor.proto";
+import "common/common.proto";
+
+service Sample {
+	rpc GetUser(common.GetUserRequest) returns (.sample.common.User) {
+		option (google.api.http) = {
+			get: "/users/{id}"
+		};
+	}
+}
+`
+
+const commonProto = `syntax = "proto3";
+
+package sample.common;
+option go_package = ".;sample";
+
+import "common/types.proto";
+
+message GetUserRequest {
+	string id = 1;
+	Role role = 2;
+}
+
+message User {
+	string id = 1;
+}
+`
+
+const typesProto = `syntax = "proto3";
+
+package sample.common;
+option go_package = ".;sample";
+
+enum Role {
+	ROLE_UNKNOWN = 0;
+}
+`
+
+type ServiceTestSuite struct {
+	suite.Suite
+	dir string
+}
+
+func TestServiceTestSuite(t *testing.T) {
+	suite.Run(t, new(ServiceTestSuite))
+}
+
+func (s *ServiceTestSuite) SetupTest() {
+	s.dir = s.T().TempDir()
+	s.write("sample.proto", importingProto)
+	s.write("common/common.proto", commonProto)
+	s.write("common/types.proto", typesProto)
+}
+
+func (s *ServiceTestSuite) write(name, content string) {
+	path := filepath.Join(s.dir, name)
+	s.Require().NoError(os.MkdirAll(filepath.Dir(path), 0755))
+	s.Require().NoError(os.WriteFile(path, []byte(content), 0644))
+}
+
+func (s *ServiceTestSuite) TestParse_Imports() {
+	p := NewService()
+
+	err := p.Parse(filepath.Join(s.dir, "sample.proto"))
+
+	s.Require().NoError(err)
+	def := p.Definition()
+	s.Require().Len(def.Imports(), 2)
+	s.Equal("common/common.proto", def.Imports()[0].Name)
+	s.Equal("sample.common", def.Imports()[0].Package)
+	s.Equal("common/types.proto", def.Imports()[1].Name)
+
+	m := def.Services[0].Methods[0]
+	s.Require().NotNil(m.RequestType)
+	s.Equal("sample.common.GetUserRequest", m.RequestType.FullName)
+	s.Equal("GetUserRequest", m.GoRequest())
+	s.Require().NotNil(m.ResponseType)
+	s.Equal("User", m.GoResponse())
+
+	params := m.HttpBindings[0].Params
+	s.Require().Len(params, 2)
+	s.EqualValues(LocationPath, params[0].Location)
+	s.EqualValues(LocationQuery, params[1].Location)
+	s.Equal(TypeEnum, params[1].Type)
+	s.Require().NotNil(params[1].Symbol)
+	s.Equal("sample.common.Role", params[1].Symbol.FullName)
+}
+
+func (s *ServiceTestSuite) TestParse_IncludePaths() {
+	s.write("sample.proto", `syntax = "proto3";
+package sample;
+import "common.proto";
+service Sample {
+	rpc GetUser(GetUserRequest) returns (User);
+}`)
+	s.write("common/common.proto", `syntax = "proto3";
+package sample;
+message GetUserRequest {}
+message User {}`)
+	p := NewService()
+
+	err := p.Parse(filepath.Join(s.dir, "sample.proto"))
+	s.Error(err)
+
+	err = p.Parse(filepath.Join(s.dir, "sample.proto"), s.dir, filepath.Join(s.dir, "common"))
+	s.NoError(err)
+}
+
+func (s *ServiceTestSuite) TestParse_OtherGoPackage() {
+	s.write("common/types.proto", `syntax = "proto3";
+package sample.types;
+option go_package = "example.com/types;types";
+
+enum Role {
+	ROLE_UNKNOWN = 0;
+}
+`)
+	s.write("common/common.proto", `syntax = "proto3";
+package sample.common;
+option go_package = "example.com/common";
+import "common/types.proto";
+message GetUserRequest {
+	string id = 1;
+	sample.types.Role role = 2;
+}
+message User {}
+`)
+	p := NewService()
+
+	err := p.Parse(filepath.Join(s.dir, "sample.proto"))
+
+	s.Require().NoError(err)
+	m := p.Definition().Services[0].Methods[0]
+	s.Equal("commonpb.GetUserRequest", m.GoRequestType())
+	s.Equal("commonpb.User", m.GoResponseType())
+	s.Equal([]*GoImport{
+		{Path: "example.com/common", Alias: "commonpb"},
+		{Path: "example.com/types", Alias: "typespb"},
+	}, m.GoImports())
+
+	params := m.HttpBindings[0].Params
+	s.Require().Len(params, 2)
+	s.Nil(params[0].GoImport())
+	s.Equal(&GoImport{Path: "example.com/types", Alias: "typespb"}, params[1].GoImport())
+	s.Equal("typespb.Role", params[1].Symbol.GoType())
+}
+
+func (s *ServiceTestSuite) TestParse_GoAliases() {
+	s.write("common/types.proto", `syntax = "proto3";
+package sample.types;
+option go_package = "example.com/v1/common";
+message Role {}
+`)
+	s.write("common/common.proto", `syntax = "proto3";
+package sample.common;
+option go_package = "example.com/common";
+import "common/types.proto";
+message GetUserRequest {
+	string id = 1;
+	sample.types.Role role = 2;
+}
+message User {}
+`)
+	p := NewService()
+
+	s.Require().NoError(p.Parse(filepath.Join(s.dir, "sample.proto")))
+
+	sym, ok := p.Definition().Resolve("sample", "sample.types.Role")
+	s.Require().True(ok)
+	s.Equal("commonpb2.Role", sym.GoType())
+	s.Equal("pb.Request", (&Method{Request: "Request"}).GoRequestType())
+}
+
+func (s *ServiceTestSuite) TestResolve_Scopes() {
+	p := NewService()
+	s.Require().NoError(p.Parse(filepath.Join(s.dir, "sample.proto")))
+	def := p.Definition()
+
+	sym, ok := def.Resolve("sample.common", "Role")
+	s.True(ok)
+	s.Equal("sample.common.Role", sym.FullName)
+
+	_, ok = def.Resolve("sample", "Role")
+	s.False(ok)
+
+	sym, ok = def.Resolve("sample", "common.User")
+	s.True(ok)
+	s.Equal("sample.common.User", sym.FullName)
+
+	_, ok = def.Resolve("sample", ".common.User")
+	s.False(ok)
+}
+
+func (s *ServiceTestSuite) TestParseString_Nested() {
+	p := NewService()
+
+	err := p.ParseString(`syntax = "proto3";
+package sample;
+
+message Order {
+	enum Status {
+		STATUS_UNKNOWN = 0;
+	}
+	message Lookup {
+		string id = 1;
+		Status status = 2;
+	}
+	message Item {
+		message Price {
+			Status status = 1;
+		}
+	}
+}
+
+service Sample {
+	rpc GetOrder(Order.Lookup) returns (Order) {
+		option (google.api.http) = {
+			get: "/orders/{id}"
+		};
+	}
+}`)
+
+	s.Require().NoError(err)
+	def := p.Definition()
+	m := def.Services[0].Methods[0]
+	s.Equal("Order_Lookup", m.GoRequest())
+	s.Equal("Order", m.GoResponse())
+
+	params := m.HttpBindings[0].Params
+	s.Require().Len(params, 2)
+	s.Equal(TypeEnum, params[1].Type)
+	s.Equal("sample.Order.Status", params[1].Symbol.FullName)
+	s.Equal("Order_Status", params[1].Symbol.GoIdent())
+
+	sym, ok := def.Resolve("sample.Order.Item.Price", "Status")
+	s.Require().True(ok)
+	s.Equal("sample.Order.Status", sym.FullName)
+
+	msg, ok := def.Message("Order.Item.Price")
+	s.True(ok)
+	s.Equal("Price", msg.Name)
+	_, ok = def.Enum("Order.Status")
+	s.True(ok)
+}
+
+func (s *ServiceTestSuite) TestGorillaMuxPath() {
+	p := NewService()
+	s.Require().NoError(p.ParseString(`syntax = "proto3";
+service Sample {
+	option (config) = {
+		HttpPrefix: "/api/"
+	};
+	rpc Get(Request) returns (Request) {
+		option (google.api.http) = {
+			get: "/users/{id}:activate"
+			additional_bindings { get: "/v1/{name=shelves/*/books/**}" }
+			additional_bindings { get: "/files/{id:[0-9]{1,3}}/*/a.b/**" }
+			additional_bindings { get: "/**" }
+		};
+	}
+}
+message Request {
+	string id = 1;
+	string name = 2;
+}`))
+
+	bindings := p.Definition().Services[0].Methods[0].HttpBindings
+	s.Require().Len(bindings, 4)
+	s.Equal("/api/users/{id}:activate", bindings[0].GorillaMuxPath())
+	s.Equal(`/api/v1/{name:shelves/[^/]+/books(?:/.*)?}`, bindings[1].GorillaMuxPath())
+	s.Equal(`/api/files/{id:[0-9]{1,3}}/{_wildcard2}/a.b{_wildcards4:(?:/.*)?}`, bindings[2].GorillaMuxPath())
+	s.Equal(`/api{_wildcards0:(?:/.*)?}`, bindings[3].GorillaMuxPath())
+	s.Equal("/api/users/{id}:activate", bindings[0].Template())
+}
+
+func (s *ServiceTestSuite) TestParseString_NestedParams() {
+	p := NewService()
+
+	err := p.ParseString(`syntax = "proto3";
+package sample;
+
+message Org {
+	int64 id = 1;
+	string name = 2;
+	repeated Org children = 3;
+}
+
+message Page {
+	int32 size = 1;
+	map<string, string> labels = 2;
+	Page next = 3;
+}
+
+message Request {
+	Org org = 1;
+	Page page = 2;
+}
+
+service Sample {
+	rpc Get(Request) returns (Request) {
+		option (google.api.http) = {
+			get: "/orgs/{org.id}"
+		};
+	}
+}`)
+
+	s.Require().NoError(err)
+	params := p.Definition().Services[0].Methods[0].HttpBindings[0].Params
+	s.Require().Len(params, 3)
+	s.Equal("org.id", params[0].FieldPath())
+	s.EqualValues(LocationPath, params[0].Location)
+	s.Require().Len(params[0].Parents, 1)
+	s.Equal("sample.Org", params[0].Parents[0].Symbol.FullName)
+	s.Equal("sample.Org", params[0].Scope(p.Definition().Services[0].Methods[0]))
+	s.Equal("org.name", params[1].FieldPath())
+	s.EqualValues(LocationQuery, params[1].Location)
+	s.Equal("page.size", params[2].FieldPath())
+	s.EqualValues(LocationQuery, params[2].Location)
+}
+
+func (s *ServiceTestSuite) TestParseString_NestedParamsInvalid() {
+	for path, msg := range map[string]string{
+		"/orgs/{org.name.id}":     "`org.name` of path parameter `org.name.id` is not a singular message",
+		"/orgs/{orgs.id}":         "`orgs` of path parameter `orgs.id` is not a singular message",
+		"/orgs/{org.unknown}":     "path parameter `org.unknown` not found",
+		"/orgs/{unknown.id}":      "path parameter `unknown.id` not found",
+		"/orgs/{org.children.id}": "`org.children` of path parameter `org.children.id` is not a singular message",
+	} {
+		p := NewService()
+
+		err := p.ParseString(`syntax = "proto3";
+message Org {
+	string name = 1;
+	repeated Org children = 2;
+}
+message Request {
+	Org org = 1;
+	repeated Org orgs = 2;
+}
+service Sample {
+	rpc Get(Request) returns (Request) {
+		option (google.api.http) = {
+			get: "` + path + `"
+		};
+	}
+}`)
+
+		s.Require().Error(err, path)
+		s.Contains(err.Error(), msg, path)
+	}
+}
+
+func (s *ServiceTestSuite) TestParseString_ErrorPosition() {
+	p := NewService()
+
+	err := p.ParseString(`syntax = "proto3";
+message Request {
+	string id = 1;
+}
+service Sample {
+	rpc Get(Request) returns (Request) {
+		option (google.api.http) = {
+			get: "/items/{idx}"
+		};
+	}
+}`)
+
+	s.Require().Error(err)
+	s.Regexp("^8:4: path parameter `idx` not found", err.Error())
+}
+
+func (s *ServiceTestSuite) TestParseString_StreamingHttp() {
+	const definition = `syntax = "proto3";
+message Request {
+	string id = 1;
+}
+service Sample {
+	rpc Watch(%sRequest) returns (stream Request) {
+		option (google.api.http) = {
+			get: "/items/{id}"
+		};
+	}
+}`
+	p := NewService()
+	s.Require().NoError(p.ParseString(fmt.Sprintf(definition, "")))
+	m := p.Definition().Services[0].Methods[0]
+	s.True(m.ResponseStream)
+	s.Len(m.HttpBindings, 1)
+
+	err := NewService().ParseString(fmt.Sprintf(definition, "stream "))
+	s.Require().Error(err)
+	s.Contains(err.Error(), "client and bidirectional streaming methods cannot have `google.api.http` option")
+}
+
+func (s *ServiceTestSuite) TestParseString_Events() {
+	p := NewService()
+	s.Require().NoError(p.ParseString(`syntax = "proto3";
+message Order {
+	string id = 1;
+}
+service Sample {
+	rpc Get(Order) returns (Order);
+	rpc OrderUpdated(Order) returns (Order) {
+		option (hawk.v1.method).web_socket_event = true;
+	}
+}`))
+	svc := p.Definition().Services[0]
+	s.Require().Len(svc.Methods, 1)
+	s.Require().Len(svc.Events, 1)
+	s.Equal("OrderUpdated", svc.Events[0].Name)
+	s.True(svc.Events[0].Event)
+	s.Equal("Order", svc.Events[0].GoRequest())
+
+	invalid := map[string]string{
+		`rpc Updated(stream Order) returns (Order) {
+		option (hawk.v1.method).web_socket_event = true;
+	}`: "events cannot be streamed",
+		`rpc Updated(Order) returns (Order) {
+		option (webSocketEvent) = true;
+	}`: "option `(webSocketEvent)` is not declared",
+		`rpc Updated(Order) returns (Order) {
+		option (hawk.v1.method).web_socket_event = true;
+		option (google.api.http) = {
+			get: "/orders/{id}"
+		};
+	}`: "events cannot be streamed or have `google.api.http` option",
+	}
+	for rpc, msg := range invalid {
+		err := NewService().ParseString(`syntax = "proto3";
+message Order {
+	string id = 1;
+}
+service Sample {
+	` + rpc + `
+}`)
+		s.Require().Error(err, rpc)
+		s.Contains(err.Error(), msg, rpc)
+	}
+}
@@ -0,0 +1,243 @@
+package proto
+
+import (
+	"fmt"
+	"github.com/iancoleman/strcase"
+	"github.com/niiigoo/hawk/proto/io"
+	"strings"
+)
+
+// File is a proto file imported by the definition
+type File struct {
+	// Name is the path of the import statement, e.g. `common/types.proto`
+	Name string
+	// Path is the location of the file on disk
+	Path      string
+	Package   string
+	GoPackage string
+	Proto     *io.Proto
+}
+
+// Symbol is a message or an enum of the definition or one of its imports
+type Symbol struct {
+	// Name is the name of the message or enum relative to the package, e.g. `User.Address` for nested types
+	Name string
+	// FullName is the package-qualified name, e.g. `sample.common.User`
+	FullName string
+	Package  string
+	// File is nil for the symbols of the definition itself
+	File    *File
+	Message *io.Message
+	Enum    *io.Enum
+	// GoPackage is the import path of the Go package containing the type,
+	// empty if the type is part of the Go package of the definition
+	GoPackage string
+	// GoAlias is the name the Go package is imported as, e.g. `commonpb`, empty unless GoPackage is set
+	GoAlias string
+}
+
+// GoImport is the Go package of types declared by imported files of another `go_package`
+type GoImport struct {
+	Path  string
+	Alias string
+}
+
+// Type returns the kind of the symbol
+func (s *Symbol) Type() Type {
+	if s.Enum != nil {
+		return TypeEnum
+	}
+	return TypeMessage
+}
+
+// GoIdent returns the name of the Go type generated by protoc-gen-go (without package),
+// the names of nested types are joined by an underscore, e.g. `User_Address`
+func (s *Symbol) GoIdent() string {
+	parts := strings.Split(s.Name, ".")
+	for i, part := range parts {
+		parts[i] = strcase.ToCamel(part)
+	}
+	return strings.Join(parts, "_")
+}
+
+// GoType returns the Go type qualified by the alias of its package, the Go package of the definition is imported
+// as `pb`, e.g. `pb.User` or `commonpb.Address`
+func (s *Symbol) GoType() string {
+	if s.GoAlias == "" {
+		return "pb." + s.GoIdent()
+	}
+	return s.GoAlias + "." + s.GoIdent()
+}
+
+// GoImport returns the import of the Go package of the symbol, nil if it is part of the Go package of the definition
+func (s *Symbol) GoImport() *GoImport {
+	if s.GoPackage == "" {
+		return nil
+	}
+	return &GoImport{Path: s.GoPackage, Alias: s.GoAlias}
+}
+
+// Nested reports whether the symbol is declared inside of a message
+func (s *Symbol) Nested() bool {
+	return strings.Contains(s.Name, ".")
+}
+
+// fileOptions returns the package and the Go package declared by the file
+func fileOptions(data *io.Proto) (pkg, goPkg string) {
+	for _, entry := range data.Entries {
+		if entry.Package != "" {
+			pkg = entry.Package
+		} else if entry.Option != nil && entry.Option.Name == "go_package" &&
+			entry.Option.Value != nil && entry.Option.Value.String != nil {
+			goPkg = *entry.Option.Value.String
+		}
+	}
+	return pkg, goPkg
+}
+
+// goImportPath strips the package name of the `go_package` option
+func goImportPath(goPkg string) string {
+	if pos := strings.Index(goPkg, ";"); pos >= 0 {
+		return goPkg[:pos]
+	}
+	return goPkg
+}
+
+// addSymbols registers the messages and enums of the file including the nested ones
+func (d Definition) addSymbols(f *File, data *io.Proto) {
+	pkg, goPkg, alias := d.pack, "", ""
+	if f != nil {
+		pkg = f.Package
+		if !d.sameGoPackage(f) {
+			goPkg = goImportPath(f.GoPackage)
+			if goPkg == "" {
+				goPkg = f.Name
+			}
+			alias = d.goAlias(goPkg, f.GoPackage)
+		}
+	}
+
+	template := Symbol{
+		Package:   pkg,
+		File:      f,
+		GoPackage: goPkg,
+		GoAlias:   alias,
+	}
+	for _, entry := range data.Entries {
+		if entry.Message != nil {
+			d.addMessage(template, "", entry.Message)
+		} else if entry.Enum != nil {
+			d.addEnum(template, "", entry.Enum)
+		}
+	}
+}
+
+// addMessage registers the message and its nested types, scope is the name of the enclosing message
+func (d Definition) addMessage(template Symbol, scope string, msg *io.Message) {
+	s := template
+	s.Name = qualify(scope, msg.Name)
+	s.Message = msg
+	d.addSymbol(&s)
+
+	for _, entry := range msg.Entries {
+		if entry.Message != nil {
+			d.addMessage(template, s.Name, entry.Message)
+		} else if entry.Enum != nil {
+			d.addEnum(template, s.Name, entry.Enum)
+		}
+	}
+}
+
+func (d Definition) addEnum(template Symbol, scope string, enum *io.Enum) {
+	s := template
+	s.Name = qualify(scope, enum.Name)
+	s.Enum = enum
+	d.addSymbol(&s)
+}
+
+func (d Definition) addSymbol(s *Symbol) {
+	s.FullName = qualify(s.Package, s.Name)
+	if _, ok := d.symbols[s.FullName]; !ok {
+		d.symbols[s.FullName] = s
+	}
+}
+
+// goAlias returns the alias of the Go package, it is derived from the package name with the suffix `pb` and numbered
+// if another package uses it already, e.g. `commonpb` for `github.com/sample/common;common`
+func (d Definition) goAlias(path, goPkg string) string {
+	if alias, ok := d.goAliases[path]; ok {
+		return alias
+	}
+	name := path[strings.LastIndex(path, "/")+1:]
+	if pos := strings.Index(goPkg, ";"); pos >= 0 {
+		name = goPkg[pos+1:]
+	}
+	name = strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' || r == '_' {
+			return r
+		} else if r >= 'A' && r <= 'Z' {
+			return r - 'A' + 'a'
+		}
+		return -1
+	}, name)
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		name = "x" + name
+	}
+	if !strings.HasSuffix(name, "pb") {
+		name += "pb"
+	}
+
+	alias := name
+	for i := 2; ; i++ {
+		taken := alias == "pb"
+		for _, other := range d.goAliases {
+			taken = taken || other == alias
+		}
+		if !taken {
+			break
+		}
+		alias = fmt.Sprintf("%s%d", name, i)
+	}
+	d.goAliases[path] = alias
+	return alias
+}
+
+// sameGoPackage reports whether the Go types of the file are generated into the package of the definition.
+// Without `go_package` options, the proto packages are compared.
+func (d Definition) sameGoPackage(f *File) bool {
+	if f.GoPackage == "" && d.goPack == "" {
+		return f.Package == d.pack
+	}
+	return goImportPath(f.GoPackage) == goImportPath(d.goPack)
+}
+
+// Resolve looks up the message or enum referenced by name, following the scoping rules of protobuf:
+// the name is searched in the scope (e.g. the full name of a message or the package) and its parents.
+// A leading dot marks a fully qualified name.
+func (d Definition) Resolve(scope, name string) (*Symbol, bool) {
+	if strings.HasPrefix(name, ".") {
+		s, ok := d.symbols[name[1:]]
+		return s, ok
+	}
+	for {
+		if s, ok := d.symbols[qualify(scope, name)]; ok {
+			return s, true
+		}
+		if scope == "" {
+			return nil, false
+		}
+		pos := strings.LastIndex(scope, ".")
+		if pos < 0 {
+			scope = ""
+		} else {
+			scope = scope[:pos]
+		}
+	}
+}
+
+func qualify(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
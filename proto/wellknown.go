@@ -0,0 +1,67 @@
+package proto
+
+import (
+	"github.com/niiigoo/hawk/proto/io"
+	"strings"
+)
+
+// WellKnownType is a message of `google/protobuf` supported as path and query parameter, the value is given in
+// the canonical JSON form of the type (e.g. `1.5s` of a Duration, see protojson)
+type WellKnownType struct {
+	FullName string
+	// GoPackage is the import path of the Go type
+	GoPackage string
+	// GoType is the qualified Go type, e.g. `durationpb.Duration`
+	GoType string
+	// Quoted is true if the JSON form is a string
+	Quoted bool
+}
+
+var wellKnownTypes = map[string]*WellKnownType{}
+
+func init() {
+	for _, t := range []struct {
+		pkg   string
+		types map[string]bool
+	}{
+		{"timestamppb", map[string]bool{"Timestamp": true}},
+		{"durationpb", map[string]bool{"Duration": true}},
+		{"fieldmaskpb", map[string]bool{"FieldMask": true}},
+		{"wrapperspb", map[string]bool{
+			"DoubleValue": false,
+			"FloatValue":  false,
+			"Int64Value":  true,
+			"UInt64Value": true,
+			"Int32Value":  false,
+			"UInt32Value": false,
+			"BoolValue":   false,
+			"StringValue": true,
+			"BytesValue":  true,
+		}},
+	} {
+		for name, quoted := range t.types {
+			wellKnownTypes["google.protobuf."+name] = &WellKnownType{
+				FullName:  "google.protobuf." + name,
+				GoPackage: "google.golang.org/protobuf/types/known/" + t.pkg,
+				GoType:    t.pkg + "." + name,
+				Quoted:    quoted,
+			}
+		}
+	}
+}
+
+// WellKnown returns the well-known type of the parameter, nil for other types
+func (p *Param) WellKnown() *WellKnownType {
+	if p.Field == nil || p.Field.Type.Reference == "" {
+		return nil
+	}
+	if p.Symbol != nil {
+		return wellKnownTypes[p.Symbol.FullName]
+	}
+	return wellKnownTypes[strings.TrimPrefix(p.Field.Type.Reference, ".")]
+}
+
+// StringMap reports whether the parameter is a `map<string, string>`
+func (p *Param) StringMap() bool {
+	return p.Type == TypeMap && p.Field.Type.Map.Key.Scalar == io.String && p.Field.Type.Map.Value.Scalar == io.String
+}